@@ -1,6 +1,7 @@
 package tmdb
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 )
@@ -27,14 +28,22 @@ type AccountDetails struct {
 //
 // https://developers.themoviedb.org/3/account/get-account-details
 func (c *Client) GetAccountDetails() (*AccountDetails, error) {
+	return c.GetAccountDetailsWithContext(context.Background())
+}
+
+// GetAccountDetailsWithContext is like GetAccountDetails but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetAccountDetailsWithContext(
+	ctx context.Context,
+) (*AccountDetails, error) {
 	tmdbURL := fmt.Sprintf(
 		"%s/account?api_key=%s&session_id=%s",
-		baseURL,
+		c.GetBaseURL(),
 		c.apiKey,
 		c.sessionID,
 	)
 	details := AccountDetails{}
-	if err := c.get(tmdbURL, &details); err != nil {
+	if err := c.get(ctx, "GetAccountDetails", tmdbURL, &details); err != nil {
 		return nil, err
 	}
 	return &details, nil
@@ -53,11 +62,21 @@ type AccountCreatedLists struct {
 func (c *Client) GetCreatedLists(
 	id int,
 	urlOptions map[string]string,
+) (*AccountCreatedLists, error) {
+	return c.GetCreatedListsWithContext(context.Background(), id, urlOptions)
+}
+
+// GetCreatedListsWithContext is like GetCreatedLists but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetCreatedListsWithContext(
+	ctx context.Context,
+	id int,
+	urlOptions map[string]string,
 ) (*AccountCreatedLists, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%s%d/lists?api_key=%s&session_id=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		accountURL,
 		id,
 		c.apiKey,
@@ -65,7 +84,7 @@ func (c *Client) GetCreatedLists(
 		options,
 	)
 	createdLists := AccountCreatedLists{}
-	if err := c.get(tmdbURL, &createdLists); err != nil {
+	if err := c.get(ctx, "GetCreatedLists", tmdbURL, &createdLists); err != nil {
 		return nil, err
 	}
 	return &createdLists, nil
@@ -83,11 +102,21 @@ type AccountFavoriteMovies struct {
 func (c *Client) GetFavoriteMovies(
 	id int,
 	urlOptions map[string]string,
+) (*AccountFavoriteMovies, error) {
+	return c.GetFavoriteMoviesWithContext(context.Background(), id, urlOptions)
+}
+
+// GetFavoriteMoviesWithContext is like GetFavoriteMovies but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetFavoriteMoviesWithContext(
+	ctx context.Context,
+	id int,
+	urlOptions map[string]string,
 ) (*AccountFavoriteMovies, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%s%d/favorite/movies?api_key=%s&session_id=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		accountURL,
 		id,
 		c.apiKey,
@@ -95,7 +124,7 @@ func (c *Client) GetFavoriteMovies(
 		options,
 	)
 	favoriteMovies := AccountFavoriteMovies{}
-	if err := c.get(tmdbURL, &favoriteMovies); err != nil {
+	if err := c.get(ctx, "GetFavoriteMovies", tmdbURL, &favoriteMovies); err != nil {
 		return nil, err
 	}
 	return &favoriteMovies, nil
@@ -113,11 +142,21 @@ type AccountFavoriteTVShows struct {
 func (c *Client) GetFavoriteTVShows(
 	id int,
 	urlOptions map[string]string,
+) (*AccountFavoriteTVShows, error) {
+	return c.GetFavoriteTVShowsWithContext(context.Background(), id, urlOptions)
+}
+
+// GetFavoriteTVShowsWithContext is like GetFavoriteTVShows but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetFavoriteTVShowsWithContext(
+	ctx context.Context,
+	id int,
+	urlOptions map[string]string,
 ) (*AccountFavoriteTVShows, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%s%d/favorite/tv?api_key=%s&session_id=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		accountURL,
 		id,
 		c.apiKey,
@@ -125,7 +164,7 @@ func (c *Client) GetFavoriteTVShows(
 		options,
 	)
 	favoriteTVShows := AccountFavoriteTVShows{}
-	if err := c.get(tmdbURL, &favoriteTVShows); err != nil {
+	if err := c.get(ctx, "GetFavoriteTVShows", tmdbURL, &favoriteTVShows); err != nil {
 		return nil, err
 	}
 	return &favoriteTVShows, nil
@@ -146,10 +185,20 @@ type AccountFavorite struct {
 func (c *Client) MarkAsFavorite(
 	id int,
 	title *AccountFavorite,
+) (*Response, error) {
+	return c.MarkAsFavoriteWithContext(context.Background(), id, title)
+}
+
+// MarkAsFavoriteWithContext is like MarkAsFavorite but uses the
+// given context for cancellation and deadlines.
+func (c *Client) MarkAsFavoriteWithContext(
+	ctx context.Context,
+	id int,
+	title *AccountFavorite,
 ) (*Response, error) {
 	tmdbURL := fmt.Sprintf(
 		"%s%s%d/favorite?api_key=%s&session_id=%s",
-		baseURL,
+		c.GetBaseURL(),
 		accountURL,
 		id,
 		c.apiKey,
@@ -157,6 +206,8 @@ func (c *Client) MarkAsFavorite(
 	)
 	markAsFavorite := Response{}
 	if err := c.request(
+		ctx,
+		"MarkAsFavorite",
 		tmdbURL,
 		title,
 		http.MethodPost,
@@ -178,11 +229,21 @@ type AccountRatedMovies struct {
 func (c *Client) GetRatedMovies(
 	id int,
 	urlOptions map[string]string,
+) (*AccountRatedMovies, error) {
+	return c.GetRatedMoviesWithContext(context.Background(), id, urlOptions)
+}
+
+// GetRatedMoviesWithContext is like GetRatedMovies but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetRatedMoviesWithContext(
+	ctx context.Context,
+	id int,
+	urlOptions map[string]string,
 ) (*AccountRatedMovies, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%s%d/rated/movies?api_key=%s&session_id=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		accountURL,
 		id,
 		c.apiKey,
@@ -190,7 +251,7 @@ func (c *Client) GetRatedMovies(
 		options,
 	)
 	ratedMovies := AccountRatedMovies{}
-	if err := c.get(tmdbURL, &ratedMovies); err != nil {
+	if err := c.get(ctx, "GetRatedMovies", tmdbURL, &ratedMovies); err != nil {
 		return nil, err
 	}
 	return &ratedMovies, nil
@@ -207,11 +268,21 @@ type AccountRatedTVShows struct {
 func (c *Client) GetRatedTVShows(
 	id int,
 	urlOptions map[string]string,
+) (*AccountRatedTVShows, error) {
+	return c.GetRatedTVShowsWithContext(context.Background(), id, urlOptions)
+}
+
+// GetRatedTVShowsWithContext is like GetRatedTVShows but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetRatedTVShowsWithContext(
+	ctx context.Context,
+	id int,
+	urlOptions map[string]string,
 ) (*AccountRatedTVShows, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%s%d/rated/tv?api_key=%s&session_id=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		accountURL,
 		id,
 		c.apiKey,
@@ -219,7 +290,7 @@ func (c *Client) GetRatedTVShows(
 		options,
 	)
 	ratedTVShows := AccountRatedTVShows{}
-	if err := c.get(tmdbURL, &ratedTVShows); err != nil {
+	if err := c.get(ctx, "GetRatedTVShows", tmdbURL, &ratedTVShows); err != nil {
 		return nil, err
 	}
 	return &ratedTVShows, nil
@@ -237,11 +308,21 @@ type AccountRatedTVEpisodes struct {
 func (c *Client) GetRatedTVEpisodes(
 	id int,
 	urlOptions map[string]string,
+) (*AccountRatedTVEpisodes, error) {
+	return c.GetRatedTVEpisodesWithContext(context.Background(), id, urlOptions)
+}
+
+// GetRatedTVEpisodesWithContext is like GetRatedTVEpisodes but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetRatedTVEpisodesWithContext(
+	ctx context.Context,
+	id int,
+	urlOptions map[string]string,
 ) (*AccountRatedTVEpisodes, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%s%d/rated/tv/episodes?api_key=%s&session_id=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		accountURL,
 		id,
 		c.apiKey,
@@ -249,7 +330,7 @@ func (c *Client) GetRatedTVEpisodes(
 		options,
 	)
 	ratedTVEpisodes := AccountRatedTVEpisodes{}
-	if err := c.get(tmdbURL, &ratedTVEpisodes); err != nil {
+	if err := c.get(ctx, "GetRatedTVEpisodes", tmdbURL, &ratedTVEpisodes); err != nil {
 		return nil, err
 	}
 	return &ratedTVEpisodes, nil
@@ -266,11 +347,21 @@ type AccountMovieWatchlist struct {
 func (c *Client) GetMovieWatchlist(
 	id int,
 	urlOptions map[string]string,
+) (*AccountMovieWatchlist, error) {
+	return c.GetMovieWatchlistWithContext(context.Background(), id, urlOptions)
+}
+
+// GetMovieWatchlistWithContext is like GetMovieWatchlist but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetMovieWatchlistWithContext(
+	ctx context.Context,
+	id int,
+	urlOptions map[string]string,
 ) (*AccountMovieWatchlist, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%s%d/watchlist/movies?api_key=%s&session_id=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		accountURL,
 		id,
 		c.apiKey,
@@ -278,7 +369,7 @@ func (c *Client) GetMovieWatchlist(
 		options,
 	)
 	movieWatchlist := AccountMovieWatchlist{}
-	if err := c.get(tmdbURL, &movieWatchlist); err != nil {
+	if err := c.get(ctx, "GetMovieWatchlist", tmdbURL, &movieWatchlist); err != nil {
 		return nil, err
 	}
 	return &movieWatchlist, nil
@@ -295,11 +386,21 @@ type AccountTVShowsWatchlist struct {
 func (c *Client) GetTVShowsWatchlist(
 	id int,
 	urlOptions map[string]string,
+) (*AccountTVShowsWatchlist, error) {
+	return c.GetTVShowsWatchlistWithContext(context.Background(), id, urlOptions)
+}
+
+// GetTVShowsWatchlistWithContext is like GetTVShowsWatchlist but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetTVShowsWatchlistWithContext(
+	ctx context.Context,
+	id int,
+	urlOptions map[string]string,
 ) (*AccountTVShowsWatchlist, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%s%d/watchlist/tv?api_key=%s&session_id=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		accountURL,
 		id,
 		c.apiKey,
@@ -307,7 +408,7 @@ func (c *Client) GetTVShowsWatchlist(
 		options,
 	)
 	tvShowsWatchlist := AccountTVShowsWatchlist{}
-	if err := c.get(tmdbURL, &tvShowsWatchlist); err != nil {
+	if err := c.get(ctx, "GetTVShowsWatchlist", tmdbURL, &tvShowsWatchlist); err != nil {
 		return nil, err
 	}
 	return &tvShowsWatchlist, nil
@@ -327,10 +428,20 @@ type AccountWatchlist struct {
 func (c *Client) AddToWatchlist(
 	id int,
 	title *AccountWatchlist,
+) (*Response, error) {
+	return c.AddToWatchlistWithContext(context.Background(), id, title)
+}
+
+// AddToWatchlistWithContext is like AddToWatchlist but uses the
+// given context for cancellation and deadlines.
+func (c *Client) AddToWatchlistWithContext(
+	ctx context.Context,
+	id int,
+	title *AccountWatchlist,
 ) (*Response, error) {
 	tmdbURL := fmt.Sprintf(
 		"%s%s%d/watchlist?api_key=%s&session_id=%s",
-		baseURL,
+		c.GetBaseURL(),
 		accountURL,
 		id,
 		c.apiKey,
@@ -338,6 +449,8 @@ func (c *Client) AddToWatchlist(
 	)
 	addToWatchlist := Response{}
 	if err := c.request(
+		ctx,
+		"AddToWatchlist",
 		tmdbURL,
 		title,
 		http.MethodPost,
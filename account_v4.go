@@ -0,0 +1,630 @@
+package tmdb
+
+import (
+	"context"
+	"fmt"
+	"iter"
+)
+
+// AccountV4Sort type is a sort order of v4 account lists.
+type AccountV4Sort string
+
+// Sort orders accepted by the v4 account endpoints.
+const (
+	AccountV4SortCreatedAtAsc  AccountV4Sort = "created_at.asc"
+	AccountV4SortCreatedAtDesc AccountV4Sort = "created_at.desc"
+)
+
+// AccountV4List type is a struct for a list created by a v4 account.
+// Adult, Featured and Public are returned as 0 or 1.
+type AccountV4List struct {
+	AccountObjectID string  `json:"account_object_id"`
+	Adult           int64   `json:"adult"`
+	AverageRating   float32 `json:"average_rating"`
+	BackdropPath    string  `json:"backdrop_path"`
+	CreatedAt       string  `json:"created_at"`
+	Description     string  `json:"description"`
+	Featured        int64   `json:"featured"`
+	ID              int64   `json:"id"`
+	Iso3166_1       string  `json:"iso_3166_1"`
+	Iso639_1        string  `json:"iso_639_1"`
+	Name            string  `json:"name"`
+	NumberOfItems   int64   `json:"number_of_items"`
+	PosterPath      string  `json:"poster_path"`
+	Public          int64   `json:"public"`
+	SortBy          int64   `json:"sort_by"`
+	UpdatedAt       string  `json:"updated_at"`
+}
+
+// AccountV4Lists type is a struct for v4 account lists JSON response.
+type AccountV4Lists struct {
+	Results []AccountV4List `json:"results"`
+	PaginatedResultsMeta
+}
+
+// AccountV4Rating type is a struct for the rating of an account item.
+type AccountV4Rating struct {
+	CreatedAt string  `json:"created_at"`
+	Value     float32 `json:"value"`
+}
+
+// AccountV4Movie type is a struct for a movie of a v4 account list.
+// The AccountRating is only set by the rated movies.
+type AccountV4Movie struct {
+	AccountMovieResult
+	AccountRating *AccountV4Rating `json:"account_rating,omitempty"`
+}
+
+// AccountV4Movies type is a struct for v4 account movies JSON response.
+type AccountV4Movies struct {
+	Results []AccountV4Movie `json:"results"`
+	PaginatedResultsMeta
+}
+
+// AccountV4TVShow type is a struct for a TV show of a v4 account list.
+// The AccountRating is only set by the rated TV shows.
+type AccountV4TVShow struct {
+	AccountTVShowResult
+	AccountRating *AccountV4Rating `json:"account_rating,omitempty"`
+}
+
+// AccountV4TVShows type is a struct for v4 account TV shows JSON response.
+type AccountV4TVShows struct {
+	Results []AccountV4TVShow `json:"results"`
+	PaginatedResultsMeta
+}
+
+// GetAccountV4Lists get all of the lists you've created.
+//
+// The accountObjectID is returned by CreateAccessTokenV4.
+//
+// https://developers.themoviedb.org/4/account/get-account-lists
+func (c *Client) GetAccountV4Lists(
+	accountObjectID string,
+	urlOptions map[string]string,
+) (*AccountV4Lists, error) {
+	return c.GetAccountV4ListsWithContext(
+		context.Background(),
+		accountObjectID,
+		urlOptions,
+	)
+}
+
+// GetAccountV4ListsWithContext is like GetAccountV4Lists but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetAccountV4ListsWithContext(
+	ctx context.Context,
+	accountObjectID string,
+	urlOptions map[string]string,
+) (*AccountV4Lists, error) {
+	options := c.fmtOptionsV4(urlOptions)
+	tmdbURL := fmt.Sprintf(
+		"%s%s%s/lists%s",
+		c.GetBaseURLV4(),
+		accountURL,
+		accountObjectID,
+		options,
+	)
+	lists := AccountV4Lists{}
+	if err := c.get(ctx, "GetAccountV4Lists", tmdbURL, &lists); err != nil {
+		return nil, err
+	}
+	return &lists, nil
+}
+
+// AllAccountV4Lists returns an iterator over the
+// lists you've created across all of the pages.
+func (c *Client) AllAccountV4Lists(
+	ctx context.Context,
+	accountObjectID string,
+	urlOptions map[string]string,
+	opts PaginateOptions,
+) iter.Seq2[AccountV4List, error] {
+	return paginateOptions(
+		ctx,
+		func(ctx context.Context, urlOptions map[string]string) (*AccountV4Lists, error) {
+			return c.GetAccountV4ListsWithContext(ctx, accountObjectID, urlOptions)
+		},
+		urlOptions,
+		func(lists *AccountV4Lists) []AccountV4List {
+			return lists.Results
+		},
+		opts,
+	)
+}
+
+// GetAccountV4FavoriteMovies get the list of movies you
+// have marked as a favorite.
+//
+// Use the "sort_by" option with an AccountV4Sort
+// to sort the movies.
+//
+// https://developers.themoviedb.org/4/account/get-account-favorite-movies
+func (c *Client) GetAccountV4FavoriteMovies(
+	accountObjectID string,
+	urlOptions map[string]string,
+) (*AccountV4Movies, error) {
+	return c.GetAccountV4FavoriteMoviesWithContext(
+		context.Background(),
+		accountObjectID,
+		urlOptions,
+	)
+}
+
+// GetAccountV4FavoriteMoviesWithContext is like GetAccountV4FavoriteMovies
+// but uses the given context for cancellation and deadlines.
+func (c *Client) GetAccountV4FavoriteMoviesWithContext(
+	ctx context.Context,
+	accountObjectID string,
+	urlOptions map[string]string,
+) (*AccountV4Movies, error) {
+	return c.accountV4Movies(
+		ctx,
+		"GetAccountV4FavoriteMovies",
+		accountObjectID,
+		"movie/favorites",
+		urlOptions,
+	)
+}
+
+// AllAccountV4FavoriteMovies returns an iterator over your
+// favorite movies across all of the pages.
+func (c *Client) AllAccountV4FavoriteMovies(
+	ctx context.Context,
+	accountObjectID string,
+	urlOptions map[string]string,
+	opts PaginateOptions,
+) iter.Seq2[AccountV4Movie, error] {
+	return c.allAccountV4Movies(
+		ctx,
+		c.GetAccountV4FavoriteMoviesWithContext,
+		accountObjectID,
+		urlOptions,
+		opts,
+	)
+}
+
+// GetAccountV4FavoriteTVShows get the list of TV shows you
+// have marked as a favorite.
+//
+// Use the "sort_by" option with an AccountV4Sort
+// to sort the TV shows.
+//
+// https://developers.themoviedb.org/4/account/get-account-favorite-tv-shows
+func (c *Client) GetAccountV4FavoriteTVShows(
+	accountObjectID string,
+	urlOptions map[string]string,
+) (*AccountV4TVShows, error) {
+	return c.GetAccountV4FavoriteTVShowsWithContext(
+		context.Background(),
+		accountObjectID,
+		urlOptions,
+	)
+}
+
+// GetAccountV4FavoriteTVShowsWithContext is like GetAccountV4FavoriteTVShows
+// but uses the given context for cancellation and deadlines.
+func (c *Client) GetAccountV4FavoriteTVShowsWithContext(
+	ctx context.Context,
+	accountObjectID string,
+	urlOptions map[string]string,
+) (*AccountV4TVShows, error) {
+	return c.accountV4TVShows(
+		ctx,
+		"GetAccountV4FavoriteTVShows",
+		accountObjectID,
+		"tv/favorites",
+		urlOptions,
+	)
+}
+
+// AllAccountV4FavoriteTVShows returns an iterator over your
+// favorite TV shows across all of the pages.
+func (c *Client) AllAccountV4FavoriteTVShows(
+	ctx context.Context,
+	accountObjectID string,
+	urlOptions map[string]string,
+	opts PaginateOptions,
+) iter.Seq2[AccountV4TVShow, error] {
+	return c.allAccountV4TVShows(
+		ctx,
+		c.GetAccountV4FavoriteTVShowsWithContext,
+		accountObjectID,
+		urlOptions,
+		opts,
+	)
+}
+
+// GetAccountV4MovieWatchlist get the list of movies you
+// have added to your watchlist.
+//
+// Use the "sort_by" option with an AccountV4Sort
+// to sort the movies.
+//
+// https://developers.themoviedb.org/4/account/get-account-movie-watchlist
+func (c *Client) GetAccountV4MovieWatchlist(
+	accountObjectID string,
+	urlOptions map[string]string,
+) (*AccountV4Movies, error) {
+	return c.GetAccountV4MovieWatchlistWithContext(
+		context.Background(),
+		accountObjectID,
+		urlOptions,
+	)
+}
+
+// GetAccountV4MovieWatchlistWithContext is like GetAccountV4MovieWatchlist
+// but uses the given context for cancellation and deadlines.
+func (c *Client) GetAccountV4MovieWatchlistWithContext(
+	ctx context.Context,
+	accountObjectID string,
+	urlOptions map[string]string,
+) (*AccountV4Movies, error) {
+	return c.accountV4Movies(
+		ctx,
+		"GetAccountV4MovieWatchlist",
+		accountObjectID,
+		"movie/watchlist",
+		urlOptions,
+	)
+}
+
+// AllAccountV4MovieWatchlist returns an iterator over the
+// movies of your watchlist across all of the pages.
+func (c *Client) AllAccountV4MovieWatchlist(
+	ctx context.Context,
+	accountObjectID string,
+	urlOptions map[string]string,
+	opts PaginateOptions,
+) iter.Seq2[AccountV4Movie, error] {
+	return c.allAccountV4Movies(
+		ctx,
+		c.GetAccountV4MovieWatchlistWithContext,
+		accountObjectID,
+		urlOptions,
+		opts,
+	)
+}
+
+// GetAccountV4TVShowWatchlist get the list of TV shows you
+// have added to your watchlist.
+//
+// Use the "sort_by" option with an AccountV4Sort
+// to sort the TV shows.
+//
+// https://developers.themoviedb.org/4/account/get-account-tv-show-watchlist
+func (c *Client) GetAccountV4TVShowWatchlist(
+	accountObjectID string,
+	urlOptions map[string]string,
+) (*AccountV4TVShows, error) {
+	return c.GetAccountV4TVShowWatchlistWithContext(
+		context.Background(),
+		accountObjectID,
+		urlOptions,
+	)
+}
+
+// GetAccountV4TVShowWatchlistWithContext is like GetAccountV4TVShowWatchlist
+// but uses the given context for cancellation and deadlines.
+func (c *Client) GetAccountV4TVShowWatchlistWithContext(
+	ctx context.Context,
+	accountObjectID string,
+	urlOptions map[string]string,
+) (*AccountV4TVShows, error) {
+	return c.accountV4TVShows(
+		ctx,
+		"GetAccountV4TVShowWatchlist",
+		accountObjectID,
+		"tv/watchlist",
+		urlOptions,
+	)
+}
+
+// AllAccountV4TVShowWatchlist returns an iterator over the
+// TV shows of your watchlist across all of the pages.
+func (c *Client) AllAccountV4TVShowWatchlist(
+	ctx context.Context,
+	accountObjectID string,
+	urlOptions map[string]string,
+	opts PaginateOptions,
+) iter.Seq2[AccountV4TVShow, error] {
+	return c.allAccountV4TVShows(
+		ctx,
+		c.GetAccountV4TVShowWatchlistWithContext,
+		accountObjectID,
+		urlOptions,
+		opts,
+	)
+}
+
+// GetAccountV4RatedMovies get the list of movies you have rated.
+//
+// Use the "sort_by" option with an AccountV4Sort
+// to sort the movies.
+//
+// https://developers.themoviedb.org/4/account/get-account-rated-movies
+func (c *Client) GetAccountV4RatedMovies(
+	accountObjectID string,
+	urlOptions map[string]string,
+) (*AccountV4Movies, error) {
+	return c.GetAccountV4RatedMoviesWithContext(
+		context.Background(),
+		accountObjectID,
+		urlOptions,
+	)
+}
+
+// GetAccountV4RatedMoviesWithContext is like GetAccountV4RatedMovies
+// but uses the given context for cancellation and deadlines.
+func (c *Client) GetAccountV4RatedMoviesWithContext(
+	ctx context.Context,
+	accountObjectID string,
+	urlOptions map[string]string,
+) (*AccountV4Movies, error) {
+	return c.accountV4Movies(
+		ctx,
+		"GetAccountV4RatedMovies",
+		accountObjectID,
+		"movie/rated",
+		urlOptions,
+	)
+}
+
+// AllAccountV4RatedMovies returns an iterator over the
+// movies you have rated across all of the pages.
+func (c *Client) AllAccountV4RatedMovies(
+	ctx context.Context,
+	accountObjectID string,
+	urlOptions map[string]string,
+	opts PaginateOptions,
+) iter.Seq2[AccountV4Movie, error] {
+	return c.allAccountV4Movies(
+		ctx,
+		c.GetAccountV4RatedMoviesWithContext,
+		accountObjectID,
+		urlOptions,
+		opts,
+	)
+}
+
+// GetAccountV4RatedTVShows get the list of TV shows you have rated.
+//
+// Use the "sort_by" option with an AccountV4Sort
+// to sort the TV shows.
+//
+// https://developers.themoviedb.org/4/account/get-account-rated-tv-shows
+func (c *Client) GetAccountV4RatedTVShows(
+	accountObjectID string,
+	urlOptions map[string]string,
+) (*AccountV4TVShows, error) {
+	return c.GetAccountV4RatedTVShowsWithContext(
+		context.Background(),
+		accountObjectID,
+		urlOptions,
+	)
+}
+
+// GetAccountV4RatedTVShowsWithContext is like GetAccountV4RatedTVShows
+// but uses the given context for cancellation and deadlines.
+func (c *Client) GetAccountV4RatedTVShowsWithContext(
+	ctx context.Context,
+	accountObjectID string,
+	urlOptions map[string]string,
+) (*AccountV4TVShows, error) {
+	return c.accountV4TVShows(
+		ctx,
+		"GetAccountV4RatedTVShows",
+		accountObjectID,
+		"tv/rated",
+		urlOptions,
+	)
+}
+
+// AllAccountV4RatedTVShows returns an iterator over the
+// TV shows you have rated across all of the pages.
+func (c *Client) AllAccountV4RatedTVShows(
+	ctx context.Context,
+	accountObjectID string,
+	urlOptions map[string]string,
+	opts PaginateOptions,
+) iter.Seq2[AccountV4TVShow, error] {
+	return c.allAccountV4TVShows(
+		ctx,
+		c.GetAccountV4RatedTVShowsWithContext,
+		accountObjectID,
+		urlOptions,
+		opts,
+	)
+}
+
+// GetAccountV4MovieRecommendations get a list of your
+// personalized movie recommendations.
+//
+// https://developers.themoviedb.org/4/account/get-account-movie-recommendations
+func (c *Client) GetAccountV4MovieRecommendations(
+	accountObjectID string,
+	urlOptions map[string]string,
+) (*AccountV4Movies, error) {
+	return c.GetAccountV4MovieRecommendationsWithContext(
+		context.Background(),
+		accountObjectID,
+		urlOptions,
+	)
+}
+
+// GetAccountV4MovieRecommendationsWithContext is like
+// GetAccountV4MovieRecommendations but uses the given
+// context for cancellation and deadlines.
+func (c *Client) GetAccountV4MovieRecommendationsWithContext(
+	ctx context.Context,
+	accountObjectID string,
+	urlOptions map[string]string,
+) (*AccountV4Movies, error) {
+	return c.accountV4Movies(
+		ctx,
+		"GetAccountV4MovieRecommendations",
+		accountObjectID,
+		"movie/recommendations",
+		urlOptions,
+	)
+}
+
+// AllAccountV4MovieRecommendations returns an iterator over your
+// movie recommendations across all of the pages.
+func (c *Client) AllAccountV4MovieRecommendations(
+	ctx context.Context,
+	accountObjectID string,
+	urlOptions map[string]string,
+	opts PaginateOptions,
+) iter.Seq2[AccountV4Movie, error] {
+	return c.allAccountV4Movies(
+		ctx,
+		c.GetAccountV4MovieRecommendationsWithContext,
+		accountObjectID,
+		urlOptions,
+		opts,
+	)
+}
+
+// GetAccountV4TVShowRecommendations get a list of your
+// personalized TV show recommendations.
+//
+// https://developers.themoviedb.org/4/account/get-account-tv-show-recommendations
+func (c *Client) GetAccountV4TVShowRecommendations(
+	accountObjectID string,
+	urlOptions map[string]string,
+) (*AccountV4TVShows, error) {
+	return c.GetAccountV4TVShowRecommendationsWithContext(
+		context.Background(),
+		accountObjectID,
+		urlOptions,
+	)
+}
+
+// GetAccountV4TVShowRecommendationsWithContext is like
+// GetAccountV4TVShowRecommendations but uses the given
+// context for cancellation and deadlines.
+func (c *Client) GetAccountV4TVShowRecommendationsWithContext(
+	ctx context.Context,
+	accountObjectID string,
+	urlOptions map[string]string,
+) (*AccountV4TVShows, error) {
+	return c.accountV4TVShows(
+		ctx,
+		"GetAccountV4TVShowRecommendations",
+		accountObjectID,
+		"tv/recommendations",
+		urlOptions,
+	)
+}
+
+// AllAccountV4TVShowRecommendations returns an iterator over your
+// TV show recommendations across all of the pages.
+func (c *Client) AllAccountV4TVShowRecommendations(
+	ctx context.Context,
+	accountObjectID string,
+	urlOptions map[string]string,
+	opts PaginateOptions,
+) iter.Seq2[AccountV4TVShow, error] {
+	return c.allAccountV4TVShows(
+		ctx,
+		c.GetAccountV4TVShowRecommendationsWithContext,
+		accountObjectID,
+		urlOptions,
+		opts,
+	)
+}
+
+// accountV4Fetcher is the signature shared by the
+// WithContext variants of the v4 account endpoints.
+type accountV4Fetcher[P Paginated] func(
+	ctx context.Context,
+	accountObjectID string,
+	urlOptions map[string]string,
+) (P, error)
+
+func (c *Client) accountV4Movies(
+	ctx context.Context,
+	endpoint string,
+	accountObjectID string,
+	path string,
+	urlOptions map[string]string,
+) (*AccountV4Movies, error) {
+	options := c.fmtOptionsV4(urlOptions)
+	tmdbURL := fmt.Sprintf(
+		"%s%s%s/%s%s",
+		c.GetBaseURLV4(),
+		accountURL,
+		accountObjectID,
+		path,
+		options,
+	)
+	movies := AccountV4Movies{}
+	if err := c.get(ctx, endpoint, tmdbURL, &movies); err != nil {
+		return nil, err
+	}
+	return &movies, nil
+}
+
+func (c *Client) allAccountV4Movies(
+	ctx context.Context,
+	fetch accountV4Fetcher[*AccountV4Movies],
+	accountObjectID string,
+	urlOptions map[string]string,
+	opts PaginateOptions,
+) iter.Seq2[AccountV4Movie, error] {
+	return paginateOptions(
+		ctx,
+		func(ctx context.Context, urlOptions map[string]string) (*AccountV4Movies, error) {
+			return fetch(ctx, accountObjectID, urlOptions)
+		},
+		urlOptions,
+		func(movies *AccountV4Movies) []AccountV4Movie {
+			return movies.Results
+		},
+		opts,
+	)
+}
+
+func (c *Client) accountV4TVShows(
+	ctx context.Context,
+	endpoint string,
+	accountObjectID string,
+	path string,
+	urlOptions map[string]string,
+) (*AccountV4TVShows, error) {
+	options := c.fmtOptionsV4(urlOptions)
+	tmdbURL := fmt.Sprintf(
+		"%s%s%s/%s%s",
+		c.GetBaseURLV4(),
+		accountURL,
+		accountObjectID,
+		path,
+		options,
+	)
+	tvShows := AccountV4TVShows{}
+	if err := c.get(ctx, endpoint, tmdbURL, &tvShows); err != nil {
+		return nil, err
+	}
+	return &tvShows, nil
+}
+
+func (c *Client) allAccountV4TVShows(
+	ctx context.Context,
+	fetch accountV4Fetcher[*AccountV4TVShows],
+	accountObjectID string,
+	urlOptions map[string]string,
+	opts PaginateOptions,
+) iter.Seq2[AccountV4TVShow, error] {
+	return paginateOptions(
+		ctx,
+		func(ctx context.Context, urlOptions map[string]string) (*AccountV4TVShows, error) {
+			return fetch(ctx, accountObjectID, urlOptions)
+		},
+		urlOptions,
+		func(tvShows *AccountV4TVShows) []AccountV4TVShow {
+			return tvShows.Results
+		},
+		opts,
+	)
+}
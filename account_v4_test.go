@@ -0,0 +1,117 @@
+package tmdb
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+)
+
+const accountObjectID = "4bc8892a017a3c0f92000002"
+
+func accountV4Routes(w http.ResponseWriter, r *http.Request) {
+	page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+	page = max(page, 1)
+	meta := `"page":` + strconv.Itoa(page) + `,"total_pages":3,"total_results":3`
+	switch r.URL.Path {
+	case "/4/account/" + accountObjectID + "/lists":
+		w.Write([]byte(`{` + meta + `,"results":[{"id":` + strconv.Itoa(page) + `,"name":"List","public":1,"number_of_items":2}]}`))
+	case "/4/account/" + accountObjectID + "/movie/rated":
+		w.Write([]byte(`{` + meta + `,"results":[{"id":` + strconv.Itoa(page) + `,"title":"Bumblebee","account_rating":{"created_at":"2026-10-17T12:00:00.000Z","value":8}}]}`))
+	case "/4/account/" + accountObjectID + "/tv/watchlist":
+		w.Write([]byte(`{` + meta + `,"results":[{"id":` + strconv.Itoa(page) + `,"name":"Show"}]}`))
+	default:
+		w.Write([]byte(`{` + meta + `,"results":[]}`))
+	}
+}
+
+func (suite *TMBDTestSuite) TestGetAccountV4Lists() {
+	var requests []string
+	c, closer := suite.newV4Client("user", "user", &requests, accountV4Routes)
+	defer closer()
+	lists, err := c.GetAccountV4Lists(accountObjectID, nil)
+	suite.Nil(err)
+	suite.Equal("List", lists.Results[0].Name)
+	suite.Equal(int64(1), lists.Results[0].Public)
+	suite.Equal(int64(3), lists.TotalPages)
+}
+
+func (suite *TMBDTestSuite) TestGetAccountV4RatedMovies() {
+	var requests []string
+	c, closer := suite.newV4Client("user", "user", &requests, accountV4Routes)
+	defer closer()
+	options := map[string]string{"sort_by": string(AccountV4SortCreatedAtDesc)}
+	movies, err := c.GetAccountV4RatedMovies(accountObjectID, options)
+	suite.Nil(err)
+	suite.Equal("Bumblebee", movies.Results[0].Title)
+	suite.Equal(float32(8), movies.Results[0].AccountRating.Value)
+	suite.Equal(
+		"GET /4/account/"+accountObjectID+"/movie/rated?sort_by=created_at.desc",
+		requests[0],
+	)
+}
+
+func (suite *TMBDTestSuite) TestAccountV4Endpoints() {
+	var requests []string
+	c, closer := suite.newV4Client("user", "user", &requests, accountV4Routes)
+	defer closer()
+	_, err := c.GetAccountV4FavoriteMovies(accountObjectID, nil)
+	suite.Nil(err)
+	_, err = c.GetAccountV4FavoriteTVShows(accountObjectID, nil)
+	suite.Nil(err)
+	_, err = c.GetAccountV4MovieWatchlist(accountObjectID, nil)
+	suite.Nil(err)
+	tvShows, err := c.GetAccountV4TVShowWatchlist(accountObjectID, nil)
+	suite.Nil(err)
+	suite.Nil(tvShows.Results[0].AccountRating)
+	_, err = c.GetAccountV4RatedTVShows(accountObjectID, nil)
+	suite.Nil(err)
+	_, err = c.GetAccountV4MovieRecommendations(accountObjectID, nil)
+	suite.Nil(err)
+	_, err = c.GetAccountV4TVShowRecommendations(accountObjectID, nil)
+	suite.Nil(err)
+	prefix := "GET /4/account/" + accountObjectID
+	suite.Equal([]string{
+		prefix + "/movie/favorites",
+		prefix + "/tv/favorites",
+		prefix + "/movie/watchlist",
+		prefix + "/tv/watchlist",
+		prefix + "/tv/rated",
+		prefix + "/movie/recommendations",
+		prefix + "/tv/recommendations",
+	}, requests)
+}
+
+func (suite *TMBDTestSuite) TestAllAccountV4() {
+	var requests []string
+	c, closer := suite.newV4Client("user", "user", &requests, accountV4Routes)
+	defer closer()
+	ctx := context.Background()
+	ids := []int64{}
+	for list, err := range c.AllAccountV4Lists(ctx, accountObjectID, nil, PaginateOptions{}) {
+		suite.Nil(err)
+		ids = append(ids, list.ID)
+	}
+	suite.Equal([]int64{1, 2, 3}, ids)
+	ids = ids[:0]
+	options := map[string]string{"sort_by": string(AccountV4SortCreatedAtAsc)}
+	for tvShow, err := range c.AllAccountV4TVShowWatchlist(ctx, accountObjectID, options, PaginateOptions{MaxItems: 2}) {
+		suite.Nil(err)
+		ids = append(ids, tvShow.ID)
+	}
+	suite.Equal([]int64{1, 2}, ids)
+	last, _ := url.Parse(strings.TrimPrefix(requests[len(requests)-1], "GET "))
+	suite.Equal("page=2&sort_by=created_at.asc", last.Query().Encode())
+	suite.Len(options, 1)
+}
+
+func (suite *TMBDTestSuite) TestAccountV4Unauthorized() {
+	var requests []string
+	c, closer := suite.newV4Client("read", "user", &requests, accountV4Routes)
+	defer closer()
+	for _, err := range c.AllAccountV4RatedTVShows(context.Background(), accountObjectID, nil, PaginateOptions{}) {
+		suite.Contains(err.Error(), "code: 3")
+	}
+	suite.Len(requests, 1)
+}
@@ -1,7 +1,10 @@
 package tmdb
 
 import (
+	"context"
 	"fmt"
+	"net/http"
+	"net/url"
 )
 
 // RequestToken type is a struct for request token JSON response.
@@ -17,14 +20,22 @@ type RequestToken struct {
 //
 // https://developers.themoviedb.org/3/authentication/create-guest-session
 func (c *Client) CreateGuestSession() (*RequestToken, error) {
+	return c.CreateGuestSessionWithContext(context.Background())
+}
+
+// CreateGuestSessionWithContext is like CreateGuestSession but uses the
+// given context for cancellation and deadlines.
+func (c *Client) CreateGuestSessionWithContext(
+	ctx context.Context,
+) (*RequestToken, error) {
 	tmdbURL := fmt.Sprintf(
 		"%s%sguest_session/new?api_key=%s",
-		baseURL,
+		c.GetBaseURL(),
 		authenticationURL,
 		c.apiKey,
 	)
 	requestToken := RequestToken{}
-	if err := c.get(tmdbURL, &requestToken); err != nil {
+	if err := c.get(ctx, "CreateGuestSession", tmdbURL, &requestToken); err != nil {
 		return nil, err
 	}
 	return &requestToken, nil
@@ -35,14 +46,22 @@ func (c *Client) CreateGuestSession() (*RequestToken, error) {
 //
 // https://developers.themoviedb.org/3/authentication/create-request-token
 func (c *Client) CreateRequestToken() (*RequestToken, error) {
+	return c.CreateRequestTokenWithContext(context.Background())
+}
+
+// CreateRequestTokenWithContext is like CreateRequestToken but uses the
+// given context for cancellation and deadlines.
+func (c *Client) CreateRequestTokenWithContext(
+	ctx context.Context,
+) (*RequestToken, error) {
 	tmdbURL := fmt.Sprintf(
 		"%s%stoken/new?api_key=%s",
-		baseURL,
+		c.GetBaseURL(),
 		authenticationURL,
 		c.apiKey,
 	)
 	requestToken := RequestToken{}
-	if err := c.get(tmdbURL, &requestToken); err != nil {
+	if err := c.get(ctx, "CreateRequestToken", tmdbURL, &requestToken); err != nil {
 		return nil, err
 	}
 	return &requestToken, nil
@@ -72,22 +91,38 @@ type SessionWithLogin struct {
 // once a user has validated the request token.
 //
 // https://developers.themoviedb.org/3/authentication/create-session
-// func (c *Client) CreateSession(rt string) (*Session, error) {
-// 	tmdbURL := fmt.Sprintf("%s%ssession/new?api_key=%s", baseURL, authenticationURL, c.apiKey)
-// 	requestToken := struct {
-// 		RequestToken string `json:"request_token"`
-// 	}{rt}
-// 	j, err := json.Marshal(requestToken)
-// 	if err != nil {
-// 		return nil, err
-// 	}
-// 	a := Session{}
-// 	err = c.post(tmdbURL, j, a)
-// 	if err != nil {
-// 		return nil, err
-// 	}
-// 	return &a, nil
-// }
+func (c *Client) CreateSession(rt string) (*Session, error) {
+	return c.CreateSessionWithContext(context.Background(), rt)
+}
+
+// CreateSessionWithContext is like CreateSession but uses the
+// given context for cancellation and deadlines.
+func (c *Client) CreateSessionWithContext(
+	ctx context.Context,
+	rt string,
+) (*Session, error) {
+	tmdbURL := fmt.Sprintf(
+		"%s%ssession/new?api_key=%s",
+		c.GetBaseURL(),
+		authenticationURL,
+		c.apiKey,
+	)
+	requestToken := struct {
+		RequestToken string `json:"request_token"`
+	}{rt}
+	session := Session{}
+	if err := c.request(
+		ctx,
+		"CreateSession",
+		tmdbURL,
+		requestToken,
+		http.MethodPost,
+		&session,
+	); err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
 
 // CreateSessionWithLogin creates a new session id using login.
 //
@@ -97,20 +132,40 @@ type SessionWithLogin struct {
 // If you decide to use this method please use HTTPS.
 //
 // https://developers.themoviedb.org/3/authentication/validate-request-token
-// func (c *Client) CreateSessionWithLogin(u, p, rt string) (*RequestToken, error) {
-// 	tmdbURL := fmt.Sprintf("%s%stoken/validate_with_login?api_key=%s", baseURL, authenticationURL, c.apiKey)
-// 	loginSession := SessionWithLogin{
-// 		Username:     u,
-// 		Password:     p,
-// 		RequestToken: rt,
-// 	}
-// 	a := RequestToken{}
-// 	err := c.post(tmdbURL, &loginSession, &a)
-// 	if err != nil {
-// 		return nil, err
-// 	}
-// 	return &a, nil
-// }
+func (c *Client) CreateSessionWithLogin(u, p, rt string) (*RequestToken, error) {
+	return c.CreateSessionWithLoginWithContext(context.Background(), u, p, rt)
+}
+
+// CreateSessionWithLoginWithContext is like CreateSessionWithLogin
+// but uses the given context for cancellation and deadlines.
+func (c *Client) CreateSessionWithLoginWithContext(
+	ctx context.Context,
+	u, p, rt string,
+) (*RequestToken, error) {
+	tmdbURL := fmt.Sprintf(
+		"%s%stoken/validate_with_login?api_key=%s",
+		c.GetBaseURL(),
+		authenticationURL,
+		c.apiKey,
+	)
+	loginSession := SessionWithLogin{
+		Username:     u,
+		Password:     p,
+		RequestToken: rt,
+	}
+	requestToken := RequestToken{}
+	if err := c.request(
+		ctx,
+		"CreateSessionWithLogin",
+		tmdbURL,
+		&loginSession,
+		http.MethodPost,
+		&requestToken,
+	); err != nil {
+		return nil, err
+	}
+	return &requestToken, nil
+}
 
 // CreateSessionFromV4 creates a new session id.
 //
@@ -119,15 +174,88 @@ type SessionWithLogin struct {
 // Your standard "read token" will not validate to create a session ID.
 //
 // https://developers.themoviedb.org/3/authentication/create-session-from-v4-access-token
-// func (c *Client) CreateSessionFromV4(at string) (*Session, error) {
-// 	tmdbURL := fmt.Sprintf("%s%ssession/convert/4?api_key=%s", baseURL, authenticationURL, c.apiKey)
-// 	accessToken := AccessToken{AccessToken: at}
-// 	a := Session{}
-// 	err := c.post(tmdbURL, &accessToken, &a)
-// 	if err != nil {
-// 		return nil, err
-// 	}
-// 	return &a, nil
-// }
-
-// TODO: Delete Session (DELETE Request)
+func (c *Client) CreateSessionFromV4(at string) (*Session, error) {
+	return c.CreateSessionFromV4WithContext(context.Background(), at)
+}
+
+// CreateSessionFromV4WithContext is like CreateSessionFromV4 but
+// uses the given context for cancellation and deadlines.
+func (c *Client) CreateSessionFromV4WithContext(
+	ctx context.Context,
+	at string,
+) (*Session, error) {
+	tmdbURL := fmt.Sprintf(
+		"%s%ssession/convert/4?api_key=%s",
+		c.GetBaseURL(),
+		authenticationURL,
+		c.apiKey,
+	)
+	accessToken := AccessToken{AccessToken: at}
+	session := Session{}
+	if err := c.request(
+		ctx,
+		"CreateSessionFromV4",
+		tmdbURL,
+		&accessToken,
+		http.MethodPost,
+		&session,
+	); err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
+// DeleteSession deletes a session id.
+//
+// If you would like to delete (or "logout") from a session,
+// call this method with a valid session ID.
+//
+// https://developers.themoviedb.org/3/authentication/delete-session
+func (c *Client) DeleteSession(sid string) (*Session, error) {
+	return c.DeleteSessionWithContext(context.Background(), sid)
+}
+
+// DeleteSessionWithContext is like DeleteSession but uses the
+// given context for cancellation and deadlines.
+func (c *Client) DeleteSessionWithContext(
+	ctx context.Context,
+	sid string,
+) (*Session, error) {
+	tmdbURL := fmt.Sprintf(
+		"%s%ssession?api_key=%s",
+		c.GetBaseURL(),
+		authenticationURL,
+		c.apiKey,
+	)
+	sessionID := struct {
+		SessionID string `json:"session_id"`
+	}{sid}
+	session := Session{}
+	if err := c.request(
+		ctx,
+		"DeleteSession",
+		tmdbURL,
+		sessionID,
+		http.MethodDelete,
+		&session,
+	); err != nil {
+		return nil, err
+	}
+	if c.sessionID == sid {
+		c.sessionID = ""
+	}
+	return &session, nil
+}
+
+// GetApprovalURL returns the url where the user approves a
+// request token. If redirectTo is not empty, the user is sent
+// back to it after the approval.
+//
+// https://developers.themoviedb.org/3/authentication/how-do-i-generate-a-session-id
+func (c *Client) GetApprovalURL(requestToken, redirectTo string) string {
+	approvalURL := c.GetPermissionURL() + url.PathEscape(requestToken)
+	if redirectTo != "" {
+		approvalURL += "?redirect_to=" + url.QueryEscape(redirectTo)
+	}
+	return approvalURL
+}
@@ -1,5 +1,13 @@
 package tmdb
 
+import (
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+
+	json "github.com/goccy/go-json"
+)
+
 func (suite *TMBDTestSuite) TestCreateGuestSession() {
 	rt, err := suite.client.CreateGuestSession()
 	suite.Nil(err)
@@ -24,18 +32,94 @@ func (suite *TMBDTestSuite) TestCreateRequestTokenFail() {
 	suite.Equal("code: 7 | success: false | message: Invalid API key: You must be granted a valid key.", err.Error())
 }
 
-// TODO: How to validate a token that needs a browser?
-// func (suite *TMBDTestSuite) TestCreateSession() {
-// }
+func (suite *TMBDTestSuite) newAuthServer() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body := map[string]string{}
+		json.NewDecoder(r.Body).Decode(&body)
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/authentication/token/new":
+			w.Write([]byte(`{"success":true,"expires_at":"2026-10-17 12:00:00 UTC","request_token":"rt"}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/authentication/token/validate_with_login":
+			if body["username"] != "user" || body["password"] != "pass" {
+				w.WriteHeader(http.StatusUnauthorized)
+				w.Write([]byte(`{"success":false,"status_code":30,"status_message":"Invalid username and/or password: You did not provide a valid login."}`))
+				return
+			}
+			w.Write([]byte(`{"success":true,"expires_at":"2026-10-17 12:00:00 UTC","request_token":"` + body["request_token"] + `"}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/authentication/session/new":
+			if body["request_token"] != "rt" {
+				w.WriteHeader(http.StatusUnauthorized)
+				w.Write([]byte(`{"success":false,"status_code":17,"status_message":"Session denied."}`))
+				return
+			}
+			w.Write([]byte(`{"success":true,"session_id":"sid"}`))
+		case r.Method == http.MethodPost && r.URL.Path == "/authentication/session/convert/4":
+			w.Write([]byte(`{"success":true,"session_id":"sid-` + body["access_token"] + `"}`))
+		case r.Method == http.MethodDelete && r.URL.Path == "/authentication/session":
+			w.Write([]byte(`{"success":` + strconv.FormatBool(body["session_id"] == "sid") + `}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func (suite *TMBDTestSuite) TestSessionLifecycle() {
+	ts := suite.newAuthServer()
+	defer ts.Close()
+	c, _ := Init(apiKey)
+	c.SetCustomBaseURL(ts.URL)
+	rt, err := c.CreateRequestToken()
+	suite.Nil(err)
+	suite.Equal(
+		"https://www.themoviedb.org/authenticate/rt?redirect_to=https%3A%2F%2Fexample.com%2Fcallback%3Fa%3Db",
+		c.GetApprovalURL(rt.RequestToken, "https://example.com/callback?a=b"),
+	)
+	session, err := c.CreateSession(rt.RequestToken)
+	suite.Nil(err)
+	suite.True(session.Success)
+	suite.Nil(c.SetSessionID(session.SessionID))
+	deleted, err := c.DeleteSession(session.SessionID)
+	suite.Nil(err)
+	suite.True(deleted.Success)
+	suite.Empty(c.sessionID)
+}
+
+func (suite *TMBDTestSuite) TestCreateSessionDenied() {
+	ts := suite.newAuthServer()
+	defer ts.Close()
+	c, _ := Init(apiKey)
+	c.SetCustomBaseURL(ts.URL)
+	session, err := c.CreateSession("kpaishQpkpfVmbi")
+	suite.Nil(session)
+	suite.Equal("code: 17 | success: false | message: Session denied.", err.Error())
+}
+
+func (suite *TMBDTestSuite) TestCreateSessionWithLogin() {
+	ts := suite.newAuthServer()
+	defer ts.Close()
+	c, _ := Init(apiKey)
+	c.SetCustomBaseURL(ts.URL)
+	rt, err := c.CreateSessionWithLogin("user", "pass", "rt")
+	suite.Nil(err)
+	suite.True(rt.Success)
+	suite.Equal("rt", rt.RequestToken)
+	_, err = c.CreateSessionWithLogin("user", "wrong", "rt")
+	suite.Contains(err.Error(), "Invalid username and/or password")
+}
 
-// func (suite *TMBDTestSuite) TestCreateSessionDenied() {
-// 	session, err := suite.CreateSession("kpaishQpkpfVmbi")
-// 	suite.Nil(session)
-// 	suite.Equal("Session denied.", err.Error())
-// }
+func (suite *TMBDTestSuite) TestCreateSessionFromV4() {
+	ts := suite.newAuthServer()
+	defer ts.Close()
+	c, _ := Init(apiKey)
+	c.SetCustomBaseURL(ts.URL)
+	session, err := c.CreateSessionFromV4("at")
+	suite.Nil(err)
+	suite.Equal("sid-at", session.SessionID)
+}
 
-// func (suite *TMBDTestSuite) TestCreateSessionFail() {
-// 	suite.client.apiKey = ""
-// 	_, err := suite.CreateRequestToken()
-// 	suite.Equal("code: 7 | success: false | message: Invalid API key: You must be granted a valid key.", err.Error())
-// }
+func (suite *TMBDTestSuite) TestGetApprovalURL() {
+	c, _ := Init(apiKey)
+	suite.Equal("https://www.themoviedb.org/authenticate/rt", c.GetApprovalURL("rt", ""))
+	c.SetPermissionURL("http://localhost:3000/authenticate/")
+	suite.Equal("http://localhost:3000/authenticate/rt", c.GetApprovalURL("rt", ""))
+}
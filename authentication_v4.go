@@ -0,0 +1,152 @@
+package tmdb
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// RequestTokenV4 type is a struct for v4 request token JSON response.
+type RequestTokenV4 struct {
+	StatusMessage string `json:"status_message"`
+	RequestToken  string `json:"request_token"`
+	Success       bool   `json:"success"`
+	StatusCode    int    `json:"status_code"`
+}
+
+// AccessTokenV4 type is a struct for v4 access token JSON response.
+type AccessTokenV4 struct {
+	StatusMessage string `json:"status_message"`
+	AccessToken   string `json:"access_token"`
+	Success       bool   `json:"success"`
+	StatusCode    int    `json:"status_code"`
+	// AccountObjectID is the account_object_id used by v4 account calls.
+	AccountObjectID string `json:"account_id"`
+}
+
+// CreateRequestTokenV4 creates a temporary request token
+// that can be approved by a user on the TMDb website.
+//
+// The client must be initialized with InitV4 using the
+// read access token. If redirectTo is not empty, the user
+// is sent back to it after the approval.
+//
+// https://developers.themoviedb.org/4/auth/create-request-token
+func (c *Client) CreateRequestTokenV4(redirectTo string) (*RequestTokenV4, error) {
+	return c.CreateRequestTokenV4WithContext(context.Background(), redirectTo)
+}
+
+// CreateRequestTokenV4WithContext is like CreateRequestTokenV4 but
+// uses the given context for cancellation and deadlines.
+func (c *Client) CreateRequestTokenV4WithContext(
+	ctx context.Context,
+	redirectTo string,
+) (*RequestTokenV4, error) {
+	tmdbURL := fmt.Sprintf(
+		"%s%srequest_token",
+		c.GetBaseURLV4(),
+		authV4URL,
+	)
+	body := struct {
+		RedirectTo string `json:"redirect_to,omitempty"`
+	}{redirectTo}
+	requestToken := RequestTokenV4{}
+	if err := c.request(
+		ctx,
+		"CreateRequestTokenV4",
+		tmdbURL,
+		body,
+		http.MethodPost,
+		&requestToken,
+	); err != nil {
+		return nil, err
+	}
+	return &requestToken, nil
+}
+
+// CreateAccessTokenV4 exchanges a request token approved by
+// the user for a user access token and account_object_id.
+//
+// Use SetBearerToken with the returned access token to
+// make the following v4 requests on behalf of the user.
+//
+// https://developers.themoviedb.org/4/auth/create-access-token
+func (c *Client) CreateAccessTokenV4(requestToken string) (*AccessTokenV4, error) {
+	return c.CreateAccessTokenV4WithContext(context.Background(), requestToken)
+}
+
+// CreateAccessTokenV4WithContext is like CreateAccessTokenV4 but
+// uses the given context for cancellation and deadlines.
+func (c *Client) CreateAccessTokenV4WithContext(
+	ctx context.Context,
+	requestToken string,
+) (*AccessTokenV4, error) {
+	tmdbURL := fmt.Sprintf(
+		"%s%saccess_token",
+		c.GetBaseURLV4(),
+		authV4URL,
+	)
+	body := struct {
+		RequestToken string `json:"request_token"`
+	}{requestToken}
+	accessToken := AccessTokenV4{}
+	if err := c.request(
+		ctx,
+		"CreateAccessTokenV4",
+		tmdbURL,
+		body,
+		http.MethodPost,
+		&accessToken,
+	); err != nil {
+		return nil, err
+	}
+	return &accessToken, nil
+}
+
+// DeleteAccessTokenV4 revokes a user access token ("logout").
+//
+// If the client is using the revoked token as its bearer
+// token, it is cleared from the client.
+//
+// https://developers.themoviedb.org/4/auth/delete-access-token
+func (c *Client) DeleteAccessTokenV4(accessToken string) (*Response, error) {
+	return c.DeleteAccessTokenV4WithContext(context.Background(), accessToken)
+}
+
+// DeleteAccessTokenV4WithContext is like DeleteAccessTokenV4 but
+// uses the given context for cancellation and deadlines.
+func (c *Client) DeleteAccessTokenV4WithContext(
+	ctx context.Context,
+	accessToken string,
+) (*Response, error) {
+	tmdbURL := fmt.Sprintf(
+		"%s%saccess_token",
+		c.GetBaseURLV4(),
+		authV4URL,
+	)
+	body := AccessToken{AccessToken: accessToken}
+	response := Response{}
+	if err := c.request(
+		ctx,
+		"DeleteAccessTokenV4",
+		tmdbURL,
+		body,
+		http.MethodDelete,
+		&response,
+	); err != nil {
+		return nil, err
+	}
+	if c.bearerToken == accessToken {
+		c.bearerToken = ""
+	}
+	return &response, nil
+}
+
+// GetApprovalURLV4 returns the url where the user
+// approves a v4 request token.
+//
+// https://developers.themoviedb.org/4/auth/user-authorization-1
+func (c *Client) GetApprovalURLV4(requestToken string) string {
+	return c.GetPermissionURLV4() + "?request_token=" + url.QueryEscape(requestToken)
+}
@@ -0,0 +1,116 @@
+package tmdb
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+
+	json "github.com/goccy/go-json"
+)
+
+// v4Unauthorized is the v4 error of a missing or invalid bearer token.
+const v4Unauthorized = `{"status_message":"Authentication failed: You do not have permissions to access the service.","success":false,"status_code":3}`
+
+// newV4Client starts a fake v4 server answering with routes and
+// returns a client authenticated with bearerToken pointing to it,
+// along with the func closing the server.
+//
+// Each request is recorded in requests, when not nil, as its method
+// and uri followed by its canonical JSON body, if any. Requests with
+// an api key are rejected, and so are the ones without requiredToken
+// as bearer token, unless it is empty.
+func (suite *TMBDTestSuite) newV4Client(
+	bearerToken string,
+	requiredToken string,
+	requests *[]string,
+	routes http.HandlerFunc,
+) (*Client, func()) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		r.Body = io.NopCloser(bytes.NewReader(body))
+		if requests != nil {
+			request := r.Method + " " + r.URL.RequestURI()
+			var decoded any
+			if json.Unmarshal(body, &decoded) == nil {
+				canonical, _ := json.Marshal(decoded)
+				request += " " + string(canonical)
+			}
+			*requests = append(*requests, request)
+		}
+		switch {
+		case r.URL.Query().Has("api_key"):
+			w.WriteHeader(http.StatusBadRequest)
+		case requiredToken != "" && r.Header.Get("Authorization") != "Bearer "+requiredToken:
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(v4Unauthorized))
+		default:
+			routes(w, r)
+		}
+	}))
+	c, _ := InitV4(bearerToken)
+	c.SetCustomBaseURL(ts.URL)
+	return c, ts.Close
+}
+
+func authV4Routes(w http.ResponseWriter, r *http.Request) {
+	body := map[string]string{}
+	json.NewDecoder(r.Body).Decode(&body)
+	auth := r.Header.Get("Authorization")
+	switch {
+	case r.Method == http.MethodPost && r.URL.Path == "/4/auth/request_token" && auth == "Bearer read":
+		w.Write([]byte(`{"status_message":"Success.","request_token":"rt-` + body["redirect_to"] + `","success":true,"status_code":1}`))
+	case r.Method == http.MethodPost && r.URL.Path == "/4/auth/access_token" && body["request_token"] == "rt":
+		w.Write([]byte(`{"status_message":"Success.","access_token":"user","success":true,"status_code":1,"account_id":"4bc8892a017a3c0f92000002"}`))
+	case r.Method == http.MethodDelete && r.URL.Path == "/4/auth/access_token" && auth == "Bearer user":
+		w.Write([]byte(`{"status_message":"The item/record was deleted successfully.","success":true,"status_code":13}`))
+	default:
+		w.WriteHeader(http.StatusUnauthorized)
+		w.Write([]byte(v4Unauthorized))
+	}
+}
+
+func (suite *TMBDTestSuite) TestAccessTokenV4Lifecycle() {
+	c, closer := suite.newV4Client("read", "", nil, authV4Routes)
+	defer closer()
+	rt, err := c.CreateRequestTokenV4("cb")
+	suite.Nil(err)
+	suite.Equal("rt-cb", rt.RequestToken)
+	suite.Equal(
+		"https://www.themoviedb.org/auth/access?request_token=rt-cb",
+		c.GetApprovalURLV4(rt.RequestToken),
+	)
+	at, err := c.CreateAccessTokenV4("rt")
+	suite.Nil(err)
+	suite.Equal("user", at.AccessToken)
+	suite.Equal("4bc8892a017a3c0f92000002", at.AccountObjectID)
+	suite.Nil(c.SetBearerToken(at.AccessToken))
+	res, err := c.DeleteAccessTokenV4(at.AccessToken)
+	suite.Nil(err)
+	suite.Equal(13, res.StatusCode)
+	suite.Empty(c.bearerToken)
+}
+
+func (suite *TMBDTestSuite) TestCreateAccessTokenV4Fail() {
+	c, closer := suite.newV4Client("read", "", nil, authV4Routes)
+	defer closer()
+	_, err := c.CreateAccessTokenV4("unapproved")
+	suite.Contains(err.Error(), "code: 3")
+}
+
+func (suite *TMBDTestSuite) TestSetBearerTokenFail() {
+	c, _ := InitV4("read")
+	suite.NotNil(c.SetBearerToken(""))
+	suite.Equal("read", c.bearerToken)
+}
+
+func (suite *TMBDTestSuite) TestGetBaseURLV4() {
+	c, _ := InitV4("read")
+	suite.Equal("https://api.themoviedb.org/4", c.GetBaseURLV4())
+	c.SetAlternateBaseURL()
+	suite.Equal("https://api.tmdb.org/4", c.GetBaseURLV4())
+	c.SetCustomBaseURL("http://localhost:3000")
+	suite.Equal("http://localhost:3000/4", c.GetBaseURLV4())
+	c.SetCustomBaseURLV4("http://localhost:4000")
+	suite.Equal("http://localhost:4000", c.GetBaseURLV4())
+}
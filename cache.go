@@ -0,0 +1,330 @@
+package tmdb
+
+import (
+	"container/list"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	json "github.com/goccy/go-json"
+)
+
+// Cache type is an interface for storing API responses.
+//
+// Entries are returned even when expired, it is up to the
+// client to decide if they are still fresh.
+type Cache interface {
+	Get(key string) (*CacheEntry, bool)
+	Set(key string, entry *CacheEntry)
+	Delete(key string)
+}
+
+// CacheEntry type is a struct for a cached API response.
+//
+// ETag and LastModified are the validators returned by the API,
+// used to revalidate expired entries with conditional requests.
+type CacheEntry struct {
+	Body         []byte    `json:"body"`
+	Expires      time.Time `json:"expires"`
+	ETag         string    `json:"etag,omitempty"`
+	LastModified string    `json:"last_modified,omitempty"`
+}
+
+// Fresh reports whether the entry has not expired yet.
+func (e *CacheEntry) Fresh() bool {
+	return time.Now().Before(e.Expires)
+}
+
+// CacheFamily type is a group of endpoints sharing
+// the same cache time to live.
+type CacheFamily string
+
+// Cache families.
+const (
+	CacheFamilyConfiguration  CacheFamily = "configuration"
+	CacheFamilyGenres         CacheFamily = "genres"
+	CacheFamilyCertifications CacheFamily = "certifications"
+	CacheFamilyWatchProviders CacheFamily = "watch_providers"
+	CacheFamilyTrending       CacheFamily = "trending"
+	CacheFamilyPopular        CacheFamily = "popular"
+	CacheFamilySearch         CacheFamily = "search"
+	CacheFamilyChanges        CacheFamily = "changes"
+	CacheFamilyDefault        CacheFamily = "default"
+)
+
+// DefaultCacheTTLs are the time to live of each cache family.
+// Rarely changing data is kept longer than charts and searches.
+var DefaultCacheTTLs = map[CacheFamily]time.Duration{
+	CacheFamilyConfiguration:  time.Hour * 24,
+	CacheFamilyGenres:         time.Hour * 24,
+	CacheFamilyCertifications: time.Hour * 24,
+	CacheFamilyWatchProviders: time.Hour * 24,
+	CacheFamilyTrending:       time.Minute * 15,
+	CacheFamilyPopular:        time.Minute * 15,
+	CacheFamilySearch:         time.Minute * 15,
+	CacheFamilyChanges:        time.Minute * 5,
+	CacheFamilyDefault:        time.Hour,
+}
+
+// popularSuffixes are the chart endpoints of movies and TV shows.
+var popularSuffixes = []string{
+	"/popular",
+	"/top_rated",
+	"/now_playing",
+	"/upcoming",
+	"/airing_today",
+	"/on_the_air",
+	"/latest",
+}
+
+// cacheFamily returns the family of an endpoint path.
+func cacheFamily(path string) CacheFamily {
+	switch {
+	case strings.Contains(path, "/configuration"):
+		return CacheFamilyConfiguration
+	case strings.Contains(path, "/genre/"):
+		return CacheFamilyGenres
+	case strings.Contains(path, "/certification/"):
+		return CacheFamilyCertifications
+	case strings.Contains(path, "/watch/providers/"):
+		return CacheFamilyWatchProviders
+	case strings.Contains(path, "/trending/"):
+		return CacheFamilyTrending
+	case strings.Contains(path, "/search/"),
+		strings.Contains(path, "/discover/"):
+		return CacheFamilySearch
+	case strings.HasSuffix(path, "/changes"):
+		return CacheFamilyChanges
+	}
+	for _, suffix := range popularSuffixes {
+		if strings.HasSuffix(path, suffix) {
+			return CacheFamilyPopular
+		}
+	}
+	return CacheFamilyDefault
+}
+
+// setConditionalHeaders makes req a conditional request
+// using the validators of the entry.
+func (e *CacheEntry) setConditionalHeaders(req *http.Request) {
+	if e.ETag != "" {
+		req.Header.Set("If-None-Match", e.ETag)
+	}
+	if e.LastModified != "" {
+		req.Header.Set("If-Modified-Since", e.LastModified)
+	}
+}
+
+// newCacheEntry creates an entry for a response body,
+// or returns false if the response must not be stored.
+func newCacheEntry(res *http.Response, body []byte, ttl time.Duration) (*CacheEntry, bool) {
+	for _, directive := range strings.Split(res.Header.Get("Cache-Control"), ",") {
+		if strings.TrimSpace(directive) == "no-store" {
+			return nil, false
+		}
+	}
+	return &CacheEntry{
+		Body:         body,
+		Expires:      time.Now().Add(ttl),
+		ETag:         res.Header.Get("ETag"),
+		LastModified: res.Header.Get("Last-Modified"),
+	}, true
+}
+
+// SetCache sets the cache used to store GET responses.
+func (c *Client) SetCache(cache Cache) {
+	c.cache = cache
+}
+
+// SetCacheTTL overrides the time to live of a cache family.
+// A zero ttl disables caching for the family.
+func (c *Client) SetCacheTTL(family CacheFamily, ttl time.Duration) {
+	if c.cacheTTLs == nil {
+		c.cacheTTLs = make(map[CacheFamily]time.Duration)
+	}
+	c.cacheTTLs[family] = ttl
+}
+
+// cacheTTL returns the time to live of an endpoint path.
+func (c *Client) cacheTTL(path string) time.Duration {
+	family := cacheFamily(path)
+	if ttl, ok := c.cacheTTLs[family]; ok {
+		return ttl
+	}
+	return DefaultCacheTTLs[family]
+}
+
+// cacheKey returns the canonical url of a request without the
+// api key, or false when the response must not be cached.
+//
+// Authentication and authenticated account calls, identified by
+// a session id or by their path, are never cached. Neither are
+// lists, which are edited by their owners and can be private.
+func cacheKey(u *url.URL) (string, bool) {
+	query := u.Query()
+	if query.Has("session_id") ||
+		query.Has("guest_session_id") ||
+		strings.Contains(u.Path, authenticationURL) ||
+		strings.Contains(u.Path, listURL) ||
+		strings.Contains(u.Path, "account") ||
+		strings.Contains(u.Path, guestSessionURL) {
+		return "", false
+	}
+	query.Del("api_key")
+	key := url.URL{
+		Scheme:   u.Scheme,
+		Host:     u.Host,
+		Path:     u.Path,
+		RawQuery: query.Encode(),
+	}
+	return key.String(), true
+}
+
+// LRUCache type is an in-memory Cache that evicts the least
+// recently used entries once its capacity is reached.
+//
+// An LRUCache is safe for concurrent use by multiple goroutines.
+type LRUCache struct {
+	mu       sync.Mutex
+	capacity int
+	order    *list.List
+	items    map[string]*list.Element
+}
+
+type lruItem struct {
+	key   string
+	entry *CacheEntry
+}
+
+// NewLRUCache creates an in-memory cache holding up to capacity entries.
+func NewLRUCache(capacity int) (*LRUCache, error) {
+	if capacity < 1 {
+		return nil, errors.New("capacity must be greater than zero")
+	}
+	return &LRUCache{
+		capacity: capacity,
+		order:    list.New(),
+		items:    make(map[string]*list.Element),
+	}, nil
+}
+
+// Get returns the entry stored for key.
+func (l *LRUCache) Get(key string) (*CacheEntry, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	element, ok := l.items[key]
+	if !ok {
+		return nil, false
+	}
+	l.order.MoveToFront(element)
+	return element.Value.(*lruItem).entry, true
+}
+
+// Set stores the entry for key, evicting the least
+// recently used entry if the cache is full.
+func (l *LRUCache) Set(key string, entry *CacheEntry) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if element, ok := l.items[key]; ok {
+		element.Value.(*lruItem).entry = entry
+		l.order.MoveToFront(element)
+		return
+	}
+	l.items[key] = l.order.PushFront(&lruItem{key: key, entry: entry})
+	if l.order.Len() > l.capacity {
+		oldest := l.order.Back()
+		l.order.Remove(oldest)
+		delete(l.items, oldest.Value.(*lruItem).key)
+	}
+}
+
+// Delete removes the entry stored for key.
+func (l *LRUCache) Delete(key string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if element, ok := l.items[key]; ok {
+		l.order.Remove(element)
+		delete(l.items, key)
+	}
+}
+
+// Len returns the number of entries in the cache.
+func (l *LRUCache) Len() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.order.Len()
+}
+
+// FileCache type is a Cache that stores each entry as
+// a JSON file inside a directory.
+type FileCache struct {
+	dir string
+}
+
+// NewFileCache creates a filesystem cache in dir,
+// creating the directory if needed.
+func NewFileCache(dir string) (*FileCache, error) {
+	if dir == "" {
+		return nil, errors.New("cache directory is empty")
+	}
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+	return &FileCache{dir: dir}, nil
+}
+
+// path returns the file of a key.
+func (f *FileCache) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(f.dir, hex.EncodeToString(sum[:])+".json")
+}
+
+// Get returns the entry stored for key.
+func (f *FileCache) Get(key string) (*CacheEntry, bool) {
+	b, err := os.ReadFile(f.path(key))
+	if err != nil {
+		return nil, false
+	}
+	entry := CacheEntry{}
+	if err := json.Unmarshal(b, &entry); err != nil {
+		return nil, false
+	}
+	return &entry, true
+}
+
+// Set stores the entry for key. The file is written to a
+// temporary file first, so readers never see partial entries.
+func (f *FileCache) Set(key string, entry *CacheEntry) {
+	b, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+	tmp, err := os.CreateTemp(f.dir, "tmp-*")
+	if err != nil {
+		return
+	}
+	if _, err := tmp.Write(b); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return
+	}
+	if err := os.Rename(tmp.Name(), f.path(key)); err != nil {
+		os.Remove(tmp.Name())
+	}
+}
+
+// Delete removes the entry stored for key.
+func (f *FileCache) Delete(key string) {
+	os.Remove(f.path(key))
+}
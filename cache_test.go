@@ -0,0 +1,225 @@
+package tmdb
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"time"
+)
+
+func (suite *TMBDTestSuite) newCountingServer(hits *int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(hits, 1)
+		w.Write([]byte(`{"id":424783,"title":"Bumblebee","genres":[{"id":28,"name":"Action"}]}`))
+	}))
+}
+
+func (suite *TMBDTestSuite) TestLRUCache() {
+	_, err := NewLRUCache(0)
+	suite.EqualError(err, "capacity must be greater than zero")
+	cache, _ := NewLRUCache(2)
+	cache.Set("a", &CacheEntry{Body: []byte("a")})
+	cache.Set("b", &CacheEntry{Body: []byte("b")})
+	_, ok := cache.Get("a")
+	suite.True(ok)
+	cache.Set("c", &CacheEntry{Body: []byte("c")})
+	_, ok = cache.Get("b")
+	suite.False(ok)
+	entry, ok := cache.Get("a")
+	suite.True(ok)
+	suite.Equal("a", string(entry.Body))
+	cache.Set("a", &CacheEntry{Body: []byte("a2")})
+	entry, _ = cache.Get("a")
+	suite.Equal("a2", string(entry.Body))
+	cache.Delete("a")
+	suite.Equal(1, cache.Len())
+}
+
+func (suite *TMBDTestSuite) TestFileCache() {
+	_, err := NewFileCache("")
+	suite.EqualError(err, "cache directory is empty")
+	cache, err := NewFileCache(suite.T().TempDir())
+	suite.Nil(err)
+	_, ok := cache.Get("a")
+	suite.False(ok)
+	expires := time.Now().Add(time.Hour).Truncate(time.Second)
+	cache.Set("a", &CacheEntry{Body: []byte(`{"id":1}`), Expires: expires})
+	entry, ok := cache.Get("a")
+	suite.True(ok)
+	suite.Equal(`{"id":1}`, string(entry.Body))
+	suite.True(entry.Expires.Equal(expires))
+	cache.Delete("a")
+	_, ok = cache.Get("a")
+	suite.False(ok)
+}
+
+func (suite *TMBDTestSuite) TestCacheFamily() {
+	suite.Equal(CacheFamilyConfiguration, cacheFamily("/3/configuration"))
+	suite.Equal(CacheFamilyConfiguration, cacheFamily("/3/configuration/jobs"))
+	suite.Equal(CacheFamilyGenres, cacheFamily("/3/genre/movie/list"))
+	suite.Equal(CacheFamilyCertifications, cacheFamily("/3/certification/tv/list"))
+	suite.Equal(CacheFamilyTrending, cacheFamily("/3/trending/movie/week"))
+	suite.Equal(CacheFamilyPopular, cacheFamily("/3/movie/popular"))
+	suite.Equal(CacheFamilySearch, cacheFamily("/3/search/multi"))
+	suite.Equal(CacheFamilyChanges, cacheFamily("/3/movie/changes"))
+	suite.Equal(CacheFamilyDefault, cacheFamily("/3/movie/424783"))
+}
+
+func (suite *TMBDTestSuite) TestCacheKey() {
+	u, _ := url.Parse("https://api.themoviedb.org/3/movie/1?language=pt-BR&api_key=secret&append_to_response=credits")
+	key, ok := cacheKey(u)
+	suite.True(ok)
+	suite.Equal("https://api.themoviedb.org/3/movie/1?append_to_response=credits&language=pt-BR", key)
+	u, _ = url.Parse("https://api.themoviedb.org/3/movie/1/account_states?api_key=secret")
+	_, ok = cacheKey(u)
+	suite.False(ok)
+	u, _ = url.Parse("https://api.themoviedb.org/3/movie/1?api_key=secret&session_id=sid")
+	_, ok = cacheKey(u)
+	suite.False(ok)
+	u, _ = url.Parse("https://api.themoviedb.org/3/authentication/token/new?api_key=secret")
+	_, ok = cacheKey(u)
+	suite.False(ok)
+	u, _ = url.Parse("https://api.themoviedb.org/3/list/1?api_key=secret")
+	_, ok = cacheKey(u)
+	suite.False(ok)
+}
+
+func (suite *TMBDTestSuite) TestClientCache() {
+	var hits int32
+	ts := suite.newCountingServer(&hits)
+	defer ts.Close()
+	cache, _ := NewLRUCache(10)
+	c, _ := Init(apiKey)
+	c.SetCustomBaseURL(ts.URL)
+	c.SetCache(cache)
+	for range 3 {
+		movie, err := c.GetMovieDetails(bumblebeeID, nil)
+		suite.Nil(err)
+		suite.Equal("Bumblebee", movie.Title)
+	}
+	suite.Equal(int32(1), hits)
+	_, err := c.GetMovieDetails(bumblebeeID, map[string]string{"language": "pt-BR"})
+	suite.Nil(err)
+	suite.Equal(int32(2), hits)
+
+	// A different api key shares the cached response.
+	other, _ := Init("other")
+	other.SetCustomBaseURL(ts.URL)
+	other.SetCache(cache)
+	_, err = other.GetMovieDetails(bumblebeeID, nil)
+	suite.Nil(err)
+	suite.Equal(int32(2), hits)
+}
+
+func (suite *TMBDTestSuite) TestClientCacheSkipsAuthenticationAndLists() {
+	var hits int32
+	ts := suite.newCountingServer(&hits)
+	defer ts.Close()
+	cache, _ := NewLRUCache(10)
+	c, _ := Init(apiKey)
+	c.SetCustomBaseURL(ts.URL)
+	c.SetCache(cache)
+	for range 2 {
+		_, err := c.CreateRequestToken()
+		suite.Nil(err)
+		_, err = c.GetListDetails(1, nil)
+		suite.Nil(err)
+	}
+	suite.Equal(int32(4), hits)
+	suite.Equal(0, cache.Len())
+}
+
+func (suite *TMBDTestSuite) TestClientCacheBypass() {
+	var hits int32
+	ts := suite.newCountingServer(&hits)
+	defer ts.Close()
+	cache, _ := NewLRUCache(10)
+	c, _ := Init(apiKey)
+	c.SetCustomBaseURL(ts.URL)
+	c.SetSessionID(sessionID)
+	c.SetCache(cache)
+	c.SetCacheTTL(CacheFamilyGenres, 0)
+	for range 2 {
+		_, err := c.GetMovieAccountStates(bumblebeeID, nil)
+		suite.Nil(err)
+		_, err = c.GetGenreMovieList(nil)
+		suite.Nil(err)
+	}
+	suite.Equal(int32(4), hits)
+	suite.Equal(0, cache.Len())
+}
+
+func (suite *TMBDTestSuite) TestClientCacheExpired() {
+	var hits int32
+	ts := suite.newCountingServer(&hits)
+	defer ts.Close()
+	cache, _ := NewLRUCache(10)
+	c, _ := Init(apiKey)
+	c.SetCustomBaseURL(ts.URL)
+	c.SetCache(cache)
+	c.SetCacheTTL(CacheFamilyDefault, time.Nanosecond)
+	for range 2 {
+		_, err := c.GetMovieDetails(bumblebeeID, nil)
+		suite.Nil(err)
+	}
+	suite.Equal(int32(2), hits)
+}
+
+func (suite *TMBDTestSuite) TestClientCacheConditionalRequest() {
+	var hits, notModified int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			atomic.AddInt32(&notModified, 1)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		w.Write([]byte(`{"id":424783,"title":"Bumblebee"}`))
+	}))
+	defer ts.Close()
+	cache, _ := NewLRUCache(10)
+	c, _ := Init(apiKey)
+	c.SetCustomBaseURL(ts.URL)
+	c.SetCache(cache)
+	c.SetCacheTTL(CacheFamilyDefault, time.Nanosecond)
+	for range 3 {
+		movie, err := c.GetMovieDetails(bumblebeeID, nil)
+		suite.Nil(err)
+		suite.Equal("Bumblebee", movie.Title)
+	}
+	suite.Equal(int32(3), hits)
+	suite.Equal(int32(2), notModified)
+	key, _ := cacheKey(mustParseURL(ts.URL + "/movie/424783?api_key=" + apiKey))
+	entry, ok := cache.Get(key)
+	suite.True(ok)
+	suite.Equal(`"v1"`, entry.ETag)
+}
+
+func (suite *TMBDTestSuite) TestClientCacheNoStore() {
+	var hits int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		w.Header().Set("Cache-Control", "private, no-store")
+		w.Write([]byte(`{"id":424783}`))
+	}))
+	defer ts.Close()
+	cache, _ := NewLRUCache(10)
+	c, _ := Init(apiKey)
+	c.SetCustomBaseURL(ts.URL)
+	c.SetCache(cache)
+	for range 2 {
+		_, err := c.GetMovieDetails(bumblebeeID, nil)
+		suite.Nil(err)
+	}
+	suite.Equal(int32(2), hits)
+}
+
+func mustParseURL(raw string) *url.URL {
+	u, err := url.Parse(raw)
+	if err != nil {
+		panic(err)
+	}
+	return u
+}
@@ -1,6 +1,9 @@
 package tmdb
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 // Certification type is a struct for a single certification JSON response.
 type Certification struct {
@@ -21,15 +24,26 @@ type Certifications struct {
 func (c *Client) GetCertificationMovie() (
 	*Certifications,
 	error,
+) {
+	return c.GetCertificationMovieWithContext(context.Background())
+}
+
+// GetCertificationMovieWithContext is like GetCertificationMovie but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetCertificationMovieWithContext(
+	ctx context.Context,
+) (
+	*Certifications,
+	error,
 ) {
 	tmdbURL := fmt.Sprintf(
 		"%s/certification%slist?api_key=%s",
-		baseURL,
+		c.GetBaseURL(),
 		movieURL,
 		c.apiKey,
 	)
 	certificationMovie := Certifications{}
-	if err := c.get(tmdbURL, &certificationMovie); err != nil {
+	if err := c.get(ctx, "GetCertificationMovie", tmdbURL, &certificationMovie); err != nil {
 		return nil, err
 	}
 	return &certificationMovie, nil
@@ -42,15 +56,26 @@ func (c *Client) GetCertificationMovie() (
 func (c *Client) GetCertificationTV() (
 	*Certifications,
 	error,
+) {
+	return c.GetCertificationTVWithContext(context.Background())
+}
+
+// GetCertificationTVWithContext is like GetCertificationTV but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetCertificationTVWithContext(
+	ctx context.Context,
+) (
+	*Certifications,
+	error,
 ) {
 	tmdbURL := fmt.Sprintf(
 		"%s/certification%slist?api_key=%s",
-		baseURL,
+		c.GetBaseURL(),
 		tvURL,
 		c.apiKey,
 	)
 	certificationTV := Certifications{}
-	if err := c.get(tmdbURL, &certificationTV); err != nil {
+	if err := c.get(ctx, "GetCertificationTV", tmdbURL, &certificationTV); err != nil {
 		return nil, err
 	}
 	return &certificationTV, nil
@@ -0,0 +1,243 @@
+package tmdb
+
+import (
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	json "github.com/goccy/go-json"
+)
+
+// ChangeAction type is the action of a change item.
+type ChangeAction string
+
+// Actions of the change items.
+const (
+	ChangeActionAdded   ChangeAction = "added"
+	ChangeActionUpdated ChangeAction = "updated"
+	ChangeActionDeleted ChangeAction = "deleted"
+	ChangeActionCreated ChangeAction = "created"
+)
+
+// changeTimeLayout is the layout of the change item times.
+const changeTimeLayout = "2006-01-02 15:04:05 MST"
+
+// ChangeTime type is the time of a change item, which
+// TMDb formats like "2019-01-10 08:27:43 UTC".
+type ChangeTime struct {
+	time.Time
+}
+
+// UnmarshalJSON parses the TMDb time format, falling back to RFC 3339.
+func (t *ChangeTime) UnmarshalJSON(data []byte) error {
+	var value string
+	if err := json.Unmarshal(data, &value); err != nil {
+		return fmt.Errorf("could not decode change time: %s", data)
+	}
+	if value == "" {
+		t.Time = time.Time{}
+		return nil
+	}
+	parsed, err := time.Parse(changeTimeLayout, value)
+	if err != nil {
+		if parsed, err = time.Parse(time.RFC3339, value); err != nil {
+			return fmt.Errorf("could not parse change time: %s", value)
+		}
+	}
+	t.Time = parsed.UTC()
+	return nil
+}
+
+// MarshalJSON formats the time the same way TMDb does.
+func (t ChangeTime) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
+		return []byte(`""`), nil
+	}
+	return json.Marshal(t.UTC().Format(changeTimeLayout))
+}
+
+// ChangeItem type is a struct for an item of a change.
+//
+// Value and OriginalValue hold the raw JSON, use
+// DecodeValue and DecodeOriginalValue to decode them
+// with the decoder registered for the Key.
+type ChangeItem struct {
+	// Key is the key of the change the item belongs to.
+	Key           string          `json:"-"`
+	ID            string          `json:"id"`
+	Action        ChangeAction    `json:"action"`
+	Time          ChangeTime      `json:"time"`
+	Iso639_1      string          `json:"iso_639_1,omitempty"`
+	Iso3166_1     string          `json:"iso_3166_1,omitempty"`
+	Value         json.RawMessage `json:"value,omitempty"`
+	OriginalValue json.RawMessage `json:"original_value,omitempty"`
+}
+
+// DecodeValue decodes the value of the item.
+// See DecodeChangeValue.
+func (i ChangeItem) DecodeValue() (any, error) {
+	return DecodeChangeValue(i.Key, i.Value)
+}
+
+// DecodeOriginalValue decodes the original value of the item.
+// See DecodeChangeValue.
+func (i ChangeItem) DecodeOriginalValue() (any, error) {
+	return DecodeChangeValue(i.Key, i.OriginalValue)
+}
+
+// Change type is a struct for the changed items of a key.
+type Change struct {
+	Key   string       `json:"key"`
+	Items []ChangeItem `json:"items"`
+}
+
+// UnmarshalJSON decodes the change and sets the Key of its items.
+func (c *Change) UnmarshalJSON(data []byte) error {
+	type change Change
+	var decoded change
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		return err
+	}
+	for i := range decoded.Items {
+		decoded.Items[i].Key = decoded.Key
+	}
+	*c = Change(decoded)
+	return nil
+}
+
+// ChangeImage type is the value of an images change.
+type ChangeImage struct {
+	FilePath string `json:"file_path"`
+	Iso639_1 string `json:"iso_639_1"`
+}
+
+// ChangeCast type is the value of a cast change.
+type ChangeCast struct {
+	CastID    int64  `json:"cast_id"`
+	Character string `json:"character"`
+	CreditID  string `json:"credit_id"`
+	Order     int64  `json:"order"`
+	PersonID  int64  `json:"person_id"`
+}
+
+// ChangeCrew type is the value of a crew change.
+type ChangeCrew struct {
+	CreditID   string `json:"credit_id"`
+	Department string `json:"department"`
+	Job        string `json:"job"`
+	PersonID   int64  `json:"person_id"`
+}
+
+// ChangeReleaseDate type is the value of a release_dates change.
+type ChangeReleaseDate struct {
+	Certification string `json:"certification"`
+	Iso639_1      string `json:"iso_639_1"`
+	Note          string `json:"note"`
+	ReleaseDate   string `json:"release_date"`
+	Type          int    `json:"type"`
+}
+
+// ChangeEpisode type is the value of a season episode change.
+type ChangeEpisode struct {
+	EpisodeID     int64 `json:"episode_id"`
+	EpisodeNumber int   `json:"episode_number"`
+}
+
+// ChangeSeason type is the value of a TV show season change.
+type ChangeSeason struct {
+	SeasonID     int64 `json:"season_id"`
+	SeasonNumber int   `json:"season_number"`
+}
+
+// ChangeValueDecoder decodes the raw value of a change item.
+type ChangeValueDecoder func(raw json.RawMessage) (any, error)
+
+// DecodeChangeValueAs returns a ChangeValueDecoder decoding the raw value as a T.
+func DecodeChangeValueAs[T any]() ChangeValueDecoder {
+	return func(raw json.RawMessage) (any, error) {
+		var value T
+		if err := json.Unmarshal(raw, &value); err != nil {
+			return nil, fmt.Errorf("could not decode change value: %w", err)
+		}
+		return value, nil
+	}
+}
+
+var (
+	changeValueDecodersMu sync.RWMutex
+	changeValueDecoders   = map[string]ChangeValueDecoder{
+		"adult":                DecodeChangeValueAs[bool](),
+		"biography":            DecodeChangeValueAs[string](),
+		"birthday":             DecodeChangeValueAs[string](),
+		"budget":               DecodeChangeValueAs[int64](),
+		"cast":                 DecodeChangeValueAs[ChangeCast](),
+		"crew":                 DecodeChangeValueAs[ChangeCrew](),
+		"deathday":             DecodeChangeValueAs[string](),
+		"episode":              DecodeChangeValueAs[ChangeEpisode](),
+		"genres":               DecodeChangeValueAs[Genre](),
+		"homepage":             DecodeChangeValueAs[string](),
+		"images":               DecodeChangeValueAs[map[string]ChangeImage](),
+		"imdb_id":              DecodeChangeValueAs[string](),
+		"name":                 DecodeChangeValueAs[string](),
+		"original_name":        DecodeChangeValueAs[string](),
+		"original_title":       DecodeChangeValueAs[string](),
+		"overview":             DecodeChangeValueAs[string](),
+		"place_of_birth":       DecodeChangeValueAs[string](),
+		"production_companies": DecodeChangeValueAs[ProductionCompany](),
+		"release_dates":        DecodeChangeValueAs[ChangeReleaseDate](),
+		"revenue":              DecodeChangeValueAs[int64](),
+		"runtime":              DecodeChangeValueAs[int64](),
+		"season":               DecodeChangeValueAs[ChangeSeason](),
+		"status":               DecodeChangeValueAs[string](),
+		"tagline":              DecodeChangeValueAs[string](),
+		"title":                DecodeChangeValueAs[string](),
+		"type":                 DecodeChangeValueAs[string](),
+		"video":                DecodeChangeValueAs[bool](),
+	}
+)
+
+// RegisterChangeValueDecoder registers the decoder of a change
+// key, replacing the built-in or previously registered one.
+// A nil decoder removes it, so the values fall back to raw JSON.
+func RegisterChangeValueDecoder(key string, decoder ChangeValueDecoder) {
+	changeValueDecodersMu.Lock()
+	defer changeValueDecodersMu.Unlock()
+	if decoder == nil {
+		delete(changeValueDecoders, key)
+		return
+	}
+	changeValueDecoders[key] = decoder
+}
+
+// DecodeChangeValue decodes a change value with the decoder registered
+// for key. Values of unknown keys are returned as json.RawMessage and
+// empty values as nil.
+func DecodeChangeValue(key string, raw json.RawMessage) (any, error) {
+	if len(raw) == 0 {
+		return nil, nil
+	}
+	changeValueDecodersMu.RLock()
+	decoder, ok := changeValueDecoders[key]
+	changeValueDecodersMu.RUnlock()
+	if !ok {
+		return raw, nil
+	}
+	return decoder(raw)
+}
+
+// UndecodedChangeKeys returns the change keys of the configuration,
+// as returned by GetConfigurationAPI, that have no registered decoder
+// and whose values are returned as raw JSON.
+func UndecodedChangeKeys(config *ConfigurationAPI) []string {
+	changeValueDecodersMu.RLock()
+	defer changeValueDecodersMu.RUnlock()
+	keys := []string{}
+	for _, key := range config.ChangeKeys {
+		if _, ok := changeValueDecoders[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	slices.Sort(keys)
+	return keys
+}
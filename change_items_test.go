@@ -0,0 +1,82 @@
+package tmdb
+
+import (
+	"time"
+
+	json "github.com/goccy/go-json"
+)
+
+const movieChangesJSON = `{"changes":[
+	{"key":"overview","items":[{"id":"5c3c5f0e0e0a2646f4a2a7ec","action":"updated","time":"2019-01-14 10:12:30 UTC","iso_639_1":"de","value":"Neu","original_value":"Alt"}]},
+	{"key":"images","items":[{"id":"5c3b30b2c3a36845e39b6be7","action":"added","time":"2019-01-13 12:25:22 UTC","value":{"poster":{"file_path":"/poster.jpg","iso_639_1":"en"}}}]},
+	{"key":"cast","items":[{"id":"5c3a3f1a0e0a2646f09a9ba0","action":"deleted","time":"2019-01-12 19:12:58 UTC","original_value":{"person_id":1,"character":"Charlie","order":0,"credit_id":"52fe","cast_id":2}}]},
+	{"key":"release_dates","items":[{"id":"5c39e1a8c3a36845e3937a30","action":"created","time":"2019-01-12 12:34:48 UTC","iso_3166_1":"BR","value":{"certification":"10","iso_639_1":"","note":"","release_date":"2018-12-25T00:00:00.000Z","type":3}}]},
+	{"key":"trailers","items":[{"id":"5c39","action":"added","time":"2019-01-11 00:00:00 UTC","value":{"source":"abc"}}]}
+]}`
+
+func (suite *TMBDTestSuite) TestChangeItemsDecoding() {
+	changes := MovieChanges{}
+	suite.Nil(json.Unmarshal([]byte(movieChangesJSON), &changes))
+	suite.Len(changes.Changes, 5)
+
+	overview := changes.Changes[0].Items[0]
+	suite.Equal("overview", overview.Key)
+	suite.Equal(ChangeActionUpdated, overview.Action)
+	suite.Equal(time.Date(2019, 1, 14, 10, 12, 30, 0, time.UTC), overview.Time.Time)
+	value, err := overview.DecodeValue()
+	suite.Nil(err)
+	suite.Equal("Neu", value)
+	value, err = overview.DecodeOriginalValue()
+	suite.Nil(err)
+	suite.Equal("Alt", value)
+
+	images := changes.Changes[1].Items[0]
+	value, err = images.DecodeValue()
+	suite.Nil(err)
+	suite.Equal("/poster.jpg", value.(map[string]ChangeImage)["poster"].FilePath)
+	value, err = images.DecodeOriginalValue()
+	suite.Nil(err)
+	suite.Nil(value)
+
+	cast := changes.Changes[2].Items[0]
+	suite.Equal(ChangeActionDeleted, cast.Action)
+	value, err = cast.DecodeOriginalValue()
+	suite.Nil(err)
+	suite.Equal("Charlie", value.(ChangeCast).Character)
+
+	releaseDate := changes.Changes[3].Items[0]
+	suite.Equal(ChangeActionCreated, releaseDate.Action)
+	value, err = releaseDate.DecodeValue()
+	suite.Nil(err)
+	suite.Equal(3, value.(ChangeReleaseDate).Type)
+
+	unknown := changes.Changes[4].Items[0]
+	value, err = unknown.DecodeValue()
+	suite.Nil(err)
+	suite.Equal(json.RawMessage(`{"source":"abc"}`), value)
+}
+
+func (suite *TMBDTestSuite) TestRegisterChangeValueDecoder() {
+	type trailer struct {
+		Source string `json:"source"`
+	}
+	RegisterChangeValueDecoder("trailers", DecodeChangeValueAs[trailer]())
+	defer RegisterChangeValueDecoder("trailers", nil)
+	value, err := DecodeChangeValue("trailers", json.RawMessage(`{"source":"abc"}`))
+	suite.Nil(err)
+	suite.Equal(trailer{Source: "abc"}, value)
+	_, err = DecodeChangeValue("runtime", json.RawMessage(`"long"`))
+	suite.NotNil(err)
+	config := &ConfigurationAPI{ChangeKeys: []string{"overview", "trailers", "videos", "alternative_titles"}}
+	suite.Equal([]string{"alternative_titles", "videos"}, UndecodedChangeKeys(config))
+}
+
+func (suite *TMBDTestSuite) TestChangeTime() {
+	var changeTime ChangeTime
+	suite.Nil(json.Unmarshal([]byte(`"2019-01-14T10:12:30Z"`), &changeTime))
+	suite.Equal(time.Date(2019, 1, 14, 10, 12, 30, 0, time.UTC), changeTime.Time)
+	data, err := json.Marshal(changeTime)
+	suite.Nil(err)
+	suite.Equal(`"2019-01-14 10:12:30 UTC"`, string(data))
+	suite.NotNil(json.Unmarshal([]byte(`"yesterday"`), &changeTime))
+}
@@ -1,6 +1,9 @@
 package tmdb
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 // ChangesMovie type is a struct for movie changes JSON response.
 type ChangesMovie struct {
@@ -18,17 +21,26 @@ type ChangesMovie struct {
 // https://developers.themoviedb.org/3/changes/get-movie-change-list
 func (c *Client) GetChangesMovie(
 	urlOptions map[string]string,
+) (*ChangesMovie, error) {
+	return c.GetChangesMovieWithContext(context.Background(), urlOptions)
+}
+
+// GetChangesMovieWithContext is like GetChangesMovie but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetChangesMovieWithContext(
+	ctx context.Context,
+	urlOptions map[string]string,
 ) (*ChangesMovie, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%schanges?api_key=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		movieURL,
 		c.apiKey,
 		options,
 	)
 	changesMovies := ChangesMovie{}
-	if err := c.get(tmdbURL, &changesMovies); err != nil {
+	if err := c.get(ctx, "GetChangesMovie", tmdbURL, &changesMovies); err != nil {
 		return nil, err
 	}
 	return &changesMovies, nil
@@ -49,17 +61,26 @@ type ChangesTV struct {
 // https://developers.themoviedb.org/3/changes/get-tv-change-list
 func (c *Client) GetChangesTV(
 	urlOptions map[string]string,
+) (*ChangesTV, error) {
+	return c.GetChangesTVWithContext(context.Background(), urlOptions)
+}
+
+// GetChangesTVWithContext is like GetChangesTV but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetChangesTVWithContext(
+	ctx context.Context,
+	urlOptions map[string]string,
 ) (*ChangesTV, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%schanges?api_key=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		tvURL,
 		c.apiKey,
 		options,
 	)
 	changesTV := ChangesTV{}
-	if err := c.get(tmdbURL, &changesTV); err != nil {
+	if err := c.get(ctx, "GetChangesTV", tmdbURL, &changesTV); err != nil {
 		return nil, err
 	}
 	return &changesTV, nil
@@ -80,17 +101,26 @@ type ChangesPerson struct {
 // https://developers.themoviedb.org/3/changes/get-person-change-list
 func (c *Client) GetChangesPerson(
 	urlOptions map[string]string,
+) (*ChangesPerson, error) {
+	return c.GetChangesPersonWithContext(context.Background(), urlOptions)
+}
+
+// GetChangesPersonWithContext is like GetChangesPerson but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetChangesPersonWithContext(
+	ctx context.Context,
+	urlOptions map[string]string,
 ) (*ChangesPerson, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%schanges?api_key=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		personURL,
 		c.apiKey,
 		options,
 	)
 	changesPerson := ChangesPerson{}
-	if err := c.get(tmdbURL, &changesPerson); err != nil {
+	if err := c.get(ctx, "GetChangesPerson", tmdbURL, &changesPerson); err != nil {
 		return nil, err
 	}
 	return &changesPerson, nil
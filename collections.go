@@ -1,6 +1,9 @@
 package tmdb
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 // CollectionDetails type is a struct for details JSON response.
 type CollectionDetails struct {
@@ -33,14 +36,24 @@ type CollectionDetails struct {
 func (c *Client) GetCollectionDetails(
 	id int,
 	urlOptions map[string]string,
+) (*CollectionDetails, error) {
+	return c.GetCollectionDetailsWithContext(context.Background(), id, urlOptions)
+}
+
+// GetCollectionDetailsWithContext is like GetCollectionDetails but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetCollectionDetailsWithContext(
+	ctx context.Context,
+	id int,
+	urlOptions map[string]string,
 ) (*CollectionDetails, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%s%d?api_key=%s%s",
-		baseURL, collectionURL, id, c.apiKey, options,
+		c.GetBaseURL(), collectionURL, id, c.apiKey, options,
 	)
 	collectionDetails := CollectionDetails{}
-	if err := c.get(tmdbURL, &collectionDetails); err != nil {
+	if err := c.get(ctx, "GetCollectionDetails", tmdbURL, &collectionDetails); err != nil {
 		return nil, err
 	}
 	return &collectionDetails, nil
@@ -65,14 +78,24 @@ type CollectionImages struct {
 func (c *Client) GetCollectionImages(
 	id int,
 	urlOptions map[string]string,
+) (*CollectionImages, error) {
+	return c.GetCollectionImagesWithContext(context.Background(), id, urlOptions)
+}
+
+// GetCollectionImagesWithContext is like GetCollectionImages but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetCollectionImagesWithContext(
+	ctx context.Context,
+	id int,
+	urlOptions map[string]string,
 ) (*CollectionImages, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%s%d/images?api_key=%s%s",
-		baseURL, collectionURL, id, c.apiKey, options,
+		c.GetBaseURL(), collectionURL, id, c.apiKey, options,
 	)
 	collectionImages := CollectionImages{}
-	if err := c.get(tmdbURL, &collectionImages); err != nil {
+	if err := c.get(ctx, "GetCollectionImages", tmdbURL, &collectionImages); err != nil {
 		return nil, err
 	}
 	return &collectionImages, nil
@@ -90,14 +113,28 @@ type CollectionTranslations struct {
 func (c *Client) GetCollectionTranslations(
 	id int,
 	urlOptions map[string]string,
+) (*CollectionTranslations, error) {
+	return c.GetCollectionTranslationsWithContext(
+		context.Background(),
+		id,
+		urlOptions,
+	)
+}
+
+// GetCollectionTranslationsWithContext is like GetCollectionTranslations but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetCollectionTranslationsWithContext(
+	ctx context.Context,
+	id int,
+	urlOptions map[string]string,
 ) (*CollectionTranslations, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%s%d/translations?api_key=%s%s",
-		baseURL, collectionURL, id, c.apiKey, options,
+		c.GetBaseURL(), collectionURL, id, c.apiKey, options,
 	)
 	collectionTranslations := CollectionTranslations{}
-	if err := c.get(tmdbURL, &collectionTranslations); err != nil {
+	if err := c.get(ctx, "GetCollectionTranslations", tmdbURL, &collectionTranslations); err != nil {
 		return nil, err
 	}
 	return &collectionTranslations, nil
@@ -1,6 +1,7 @@
 package tmdb
 
 import (
+	"context"
 	"fmt"
 )
 
@@ -25,16 +26,25 @@ type CompanyDetails struct {
 // https://developers.themoviedb.org/3/companies/get-company-details
 func (c *Client) GetCompanyDetails(
 	id int,
+) (*CompanyDetails, error) {
+	return c.GetCompanyDetailsWithContext(context.Background(), id)
+}
+
+// GetCompanyDetailsWithContext is like GetCompanyDetails but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetCompanyDetailsWithContext(
+	ctx context.Context,
+	id int,
 ) (*CompanyDetails, error) {
 	tmdbURL := fmt.Sprintf(
 		"%s%s%d?api_key=%s",
-		baseURL,
+		c.GetBaseURL(),
 		companyURL,
 		id,
 		c.apiKey,
 	)
 	companyDetails := CompanyDetails{}
-	if err := c.get(tmdbURL, &companyDetails); err != nil {
+	if err := c.get(ctx, "GetCompanyDetails", tmdbURL, &companyDetails); err != nil {
 		return nil, err
 	}
 	return &companyDetails, nil
@@ -52,16 +62,25 @@ type CompanyAlternativeNames struct {
 // https://developers.themoviedb.org/3/companies/get-company-alternative-names
 func (c *Client) GetCompanyAlternativeNames(
 	id int,
+) (*CompanyAlternativeNames, error) {
+	return c.GetCompanyAlternativeNamesWithContext(context.Background(), id)
+}
+
+// GetCompanyAlternativeNamesWithContext is like GetCompanyAlternativeNames but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetCompanyAlternativeNamesWithContext(
+	ctx context.Context,
+	id int,
 ) (*CompanyAlternativeNames, error) {
 	tmdbURL := fmt.Sprintf(
 		"%s%s%d/alternative_names?api_key=%s",
-		baseURL,
+		c.GetBaseURL(),
 		companyURL,
 		id,
 		c.apiKey,
 	)
 	companyAlternativeNames := CompanyAlternativeNames{}
-	if err := c.get(tmdbURL, &companyAlternativeNames); err != nil {
+	if err := c.get(ctx, "GetCompanyAlternativeNames", tmdbURL, &companyAlternativeNames); err != nil {
 		return nil, err
 	}
 	return &companyAlternativeNames, nil
@@ -93,16 +112,25 @@ type CompanyImages struct {
 // https://developers.themoviedb.org/3/companies/get-company-images
 func (c *Client) GetCompanyImages(
 	id int,
+) (*CompanyImages, error) {
+	return c.GetCompanyImagesWithContext(context.Background(), id)
+}
+
+// GetCompanyImagesWithContext is like GetCompanyImages but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetCompanyImagesWithContext(
+	ctx context.Context,
+	id int,
 ) (*CompanyImages, error) {
 	tmdbURL := fmt.Sprintf(
 		"%s%s%d/images?api_key=%s",
-		baseURL,
+		c.GetBaseURL(),
 		companyURL,
 		id,
 		c.apiKey,
 	)
 	companyImages := CompanyImages{}
-	if err := c.get(tmdbURL, &companyImages); err != nil {
+	if err := c.get(ctx, "GetCompanyImages", tmdbURL, &companyImages); err != nil {
 		return nil, err
 	}
 	return &companyImages, nil
@@ -1,6 +1,9 @@
 package tmdb
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 // ConfigurationAPI type is a struct for api configuration JSON response.
 type ConfigurationAPI struct {
@@ -39,13 +42,21 @@ type ConfigurationAPI struct {
 //
 // https://developers.themoviedb.org/3/configuration/get-api-configuration
 func (c *Client) GetConfigurationAPI() (*ConfigurationAPI, error) {
+	return c.GetConfigurationAPIWithContext(context.Background())
+}
+
+// GetConfigurationAPIWithContext is like GetConfigurationAPI but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetConfigurationAPIWithContext(
+	ctx context.Context,
+) (*ConfigurationAPI, error) {
 	tmdbURL := fmt.Sprintf(
 		"%s/configuration?api_key=%s",
-		baseURL,
+		c.GetBaseURL(),
 		c.apiKey,
 	)
 	configurationAPI := ConfigurationAPI{}
-	if err := c.get(tmdbURL, &configurationAPI); err != nil {
+	if err := c.get(ctx, "GetConfigurationAPI", tmdbURL, &configurationAPI); err != nil {
 		return nil, err
 	}
 	return &configurationAPI, nil
@@ -65,15 +76,26 @@ type ConfigurationCountries []struct {
 func (c *Client) GetConfigurationCountries() (
 	*ConfigurationCountries,
 	error,
+) {
+	return c.GetConfigurationCountriesWithContext(context.Background())
+}
+
+// GetConfigurationCountriesWithContext is like GetConfigurationCountries but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetConfigurationCountriesWithContext(
+	ctx context.Context,
+) (
+	*ConfigurationCountries,
+	error,
 ) {
 	tmdbURL := fmt.Sprintf(
 		"%s%scountries?api_key=%s",
-		baseURL,
+		c.GetBaseURL(),
 		configurationURL,
 		c.apiKey,
 	)
 	configurationCountries := ConfigurationCountries{}
-	if err := c.get(tmdbURL, &configurationCountries); err != nil {
+	if err := c.get(ctx, "GetConfigurationCountries", tmdbURL, &configurationCountries); err != nil {
 		return nil, err
 	}
 	return &configurationCountries, nil
@@ -89,14 +111,22 @@ type ConfigurationJobs []struct {
 //
 // https://developers.themoviedb.org/3/configuration/get-jobs
 func (c *Client) GetConfigurationJobs() (*ConfigurationJobs, error) {
+	return c.GetConfigurationJobsWithContext(context.Background())
+}
+
+// GetConfigurationJobsWithContext is like GetConfigurationJobs but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetConfigurationJobsWithContext(
+	ctx context.Context,
+) (*ConfigurationJobs, error) {
 	tmdbURL := fmt.Sprintf(
 		"%s%sjobs?api_key=%s",
-		baseURL,
+		c.GetBaseURL(),
 		configurationURL,
 		c.apiKey,
 	)
 	configurationJobs := ConfigurationJobs{}
-	if err := c.get(tmdbURL, &configurationJobs); err != nil {
+	if err := c.get(ctx, "GetConfigurationJobs", tmdbURL, &configurationJobs); err != nil {
 		return nil, err
 	}
 	return &configurationJobs, nil
@@ -116,15 +146,26 @@ type ConfigurationLanguages []struct {
 func (c *Client) GetConfigurationLanguages() (
 	*ConfigurationLanguages,
 	error,
+) {
+	return c.GetConfigurationLanguagesWithContext(context.Background())
+}
+
+// GetConfigurationLanguagesWithContext is like GetConfigurationLanguages but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetConfigurationLanguagesWithContext(
+	ctx context.Context,
+) (
+	*ConfigurationLanguages,
+	error,
 ) {
 	tmdbURL := fmt.Sprintf(
 		"%s%slanguages?api_key=%s",
-		baseURL,
+		c.GetBaseURL(),
 		configurationURL,
 		c.apiKey,
 	)
 	configurationLanguages := ConfigurationLanguages{}
-	if err := c.get(tmdbURL, &configurationLanguages); err != nil {
+	if err := c.get(ctx, "GetConfigurationLanguages", tmdbURL, &configurationLanguages); err != nil {
 		return nil, err
 	}
 	return &configurationLanguages, nil
@@ -159,13 +200,24 @@ type ConfigurationPrimaryTranslations []string
 func (c *Client) GetConfigurationPrimaryTranslations() (
 	*ConfigurationPrimaryTranslations,
 	error,
+) {
+	return c.GetConfigurationPrimaryTranslationsWithContext(context.Background())
+}
+
+// GetConfigurationPrimaryTranslationsWithContext is like GetConfigurationPrimaryTranslations but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetConfigurationPrimaryTranslationsWithContext(
+	ctx context.Context,
+) (
+	*ConfigurationPrimaryTranslations,
+	error,
 ) {
 	tmdbURL := fmt.Sprintf(
 		"%s%sprimary_translations?api_key=%s",
-		baseURL, configurationURL, c.apiKey,
+		c.GetBaseURL(), configurationURL, c.apiKey,
 	)
 	configurationPrimaryTranslations := ConfigurationPrimaryTranslations{}
-	if err := c.get(tmdbURL, &configurationPrimaryTranslations); err != nil {
+	if err := c.get(ctx, "GetConfigurationPrimaryTranslations", tmdbURL, &configurationPrimaryTranslations); err != nil {
 		return nil, err
 	}
 	return &configurationPrimaryTranslations, nil
@@ -185,15 +237,26 @@ type ConfigurationTimezones []struct {
 func (c *Client) GetConfigurationTimezones() (
 	*ConfigurationTimezones,
 	error,
+) {
+	return c.GetConfigurationTimezonesWithContext(context.Background())
+}
+
+// GetConfigurationTimezonesWithContext is like GetConfigurationTimezones but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetConfigurationTimezonesWithContext(
+	ctx context.Context,
+) (
+	*ConfigurationTimezones,
+	error,
 ) {
 	tmdbURL := fmt.Sprintf(
 		"%s%stimezones?api_key=%s",
-		baseURL,
+		c.GetBaseURL(),
 		configurationURL,
 		c.apiKey,
 	)
 	configurationTimeZones := ConfigurationTimezones{}
-	if err := c.get(tmdbURL, &configurationTimeZones); err != nil {
+	if err := c.get(ctx, "GetConfigurationTimezones", tmdbURL, &configurationTimeZones); err != nil {
 		return nil, err
 	}
 	return &configurationTimeZones, nil
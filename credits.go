@@ -1,6 +1,7 @@
 package tmdb
 
 import (
+	"context"
 	"fmt"
 )
 
@@ -76,16 +77,25 @@ type CreditsDetails struct {
 // https://developers.themoviedb.org/3/credits/get-credit-details
 func (c *Client) GetCreditDetails(
 	id string,
+) (*CreditsDetails, error) {
+	return c.GetCreditDetailsWithContext(context.Background(), id)
+}
+
+// GetCreditDetailsWithContext is like GetCreditDetails but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetCreditDetailsWithContext(
+	ctx context.Context,
+	id string,
 ) (*CreditsDetails, error) {
 	tmdbURL := fmt.Sprintf(
 		"%s%s%s?api_key=%s",
-		baseURL,
+		c.GetBaseURL(),
 		creditURL,
 		id,
 		c.apiKey,
 	)
 	creditsDetails := CreditsDetails{}
-	if err := c.get(tmdbURL, &creditsDetails); err != nil {
+	if err := c.get(ctx, "GetCreditDetails", tmdbURL, &creditsDetails); err != nil {
 		return nil, err
 	}
 	return &creditsDetails, nil
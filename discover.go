@@ -1,6 +1,9 @@
 package tmdb
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 // DiscoverMovie type is a struct for movie JSON response.
 type DiscoverMovie struct {
@@ -34,17 +37,26 @@ type DiscoverMovie struct {
 // https://developers.themoviedb.org/3/discover/movie-discover
 func (c *Client) GetDiscoverMovie(
 	urlOptions map[string]string,
+) (*DiscoverMovie, error) {
+	return c.GetDiscoverMovieWithContext(context.Background(), urlOptions)
+}
+
+// GetDiscoverMovieWithContext is like GetDiscoverMovie but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetDiscoverMovieWithContext(
+	ctx context.Context,
+	urlOptions map[string]string,
 ) (*DiscoverMovie, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%smovie?api_key=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		discoverURL,
 		c.apiKey,
 		options,
 	)
 	discoverMovie := DiscoverMovie{}
-	if err := c.get(tmdbURL, &discoverMovie); err != nil {
+	if err := c.get(ctx, "GetDiscoverMovie", tmdbURL, &discoverMovie); err != nil {
 		return nil, err
 	}
 	return &discoverMovie, nil
@@ -68,17 +80,26 @@ type DiscoverTV struct {
 // https://developers.themoviedb.org/3/discover/tv-discover
 func (c *Client) GetDiscoverTV(
 	urlOptions map[string]string,
+) (*DiscoverTV, error) {
+	return c.GetDiscoverTVWithContext(context.Background(), urlOptions)
+}
+
+// GetDiscoverTVWithContext is like GetDiscoverTV but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetDiscoverTVWithContext(
+	ctx context.Context,
+	urlOptions map[string]string,
 ) (*DiscoverTV, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%stv?api_key=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		discoverURL,
 		c.apiKey,
 		options,
 	)
 	discoverTV := DiscoverTV{}
-	if err := c.get(tmdbURL, &discoverTV); err != nil {
+	if err := c.get(ctx, "GetDiscoverTV", tmdbURL, &discoverTV); err != nil {
 		return nil, err
 	}
 	return &discoverTV, nil
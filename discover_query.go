@@ -0,0 +1,635 @@
+package tmdb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DiscoverFilter type is a boolean expression of ids
+// for the with_* parameters of discover.
+type DiscoverFilter struct {
+	ids       []int64
+	separator string
+}
+
+// AllOf returns a filter matching results that have every id.
+func AllOf(ids ...int64) DiscoverFilter {
+	return DiscoverFilter{ids: ids, separator: ","}
+}
+
+// AnyOf returns a filter matching results that have at least one id.
+func AnyOf(ids ...int64) DiscoverFilter {
+	return DiscoverFilter{ids: ids, separator: "|"}
+}
+
+// String returns the filter in the TMDb syntax, where
+// comma means AND and pipe means OR.
+func (f DiscoverFilter) String() string {
+	values := make([]string, len(f.ids))
+	for i, id := range f.ids {
+		values[i] = strconv.FormatInt(id, 10)
+	}
+	return strings.Join(values, f.separator)
+}
+
+// DiscoverMovieSort type is a sort order of movie discover results.
+type DiscoverMovieSort string
+
+// Movie discover sort orders.
+const (
+	DiscoverMovieSortPopularityAsc          DiscoverMovieSort = "popularity.asc"
+	DiscoverMovieSortPopularityDesc         DiscoverMovieSort = "popularity.desc"
+	DiscoverMovieSortReleaseDateAsc         DiscoverMovieSort = "release_date.asc"
+	DiscoverMovieSortReleaseDateDesc        DiscoverMovieSort = "release_date.desc"
+	DiscoverMovieSortRevenueAsc             DiscoverMovieSort = "revenue.asc"
+	DiscoverMovieSortRevenueDesc            DiscoverMovieSort = "revenue.desc"
+	DiscoverMovieSortPrimaryReleaseDateAsc  DiscoverMovieSort = "primary_release_date.asc"
+	DiscoverMovieSortPrimaryReleaseDateDesc DiscoverMovieSort = "primary_release_date.desc"
+	DiscoverMovieSortOriginalTitleAsc       DiscoverMovieSort = "original_title.asc"
+	DiscoverMovieSortOriginalTitleDesc      DiscoverMovieSort = "original_title.desc"
+	DiscoverMovieSortVoteAverageAsc         DiscoverMovieSort = "vote_average.asc"
+	DiscoverMovieSortVoteAverageDesc        DiscoverMovieSort = "vote_average.desc"
+	DiscoverMovieSortVoteCountAsc           DiscoverMovieSort = "vote_count.asc"
+	DiscoverMovieSortVoteCountDesc          DiscoverMovieSort = "vote_count.desc"
+)
+
+// DiscoverTVSort type is a sort order of TV discover results.
+type DiscoverTVSort string
+
+// TV discover sort orders.
+const (
+	DiscoverTVSortPopularityAsc    DiscoverTVSort = "popularity.asc"
+	DiscoverTVSortPopularityDesc   DiscoverTVSort = "popularity.desc"
+	DiscoverTVSortFirstAirDateAsc  DiscoverTVSort = "first_air_date.asc"
+	DiscoverTVSortFirstAirDateDesc DiscoverTVSort = "first_air_date.desc"
+	DiscoverTVSortNameAsc          DiscoverTVSort = "name.asc"
+	DiscoverTVSortNameDesc         DiscoverTVSort = "name.desc"
+	DiscoverTVSortOriginalNameAsc  DiscoverTVSort = "original_name.asc"
+	DiscoverTVSortOriginalNameDesc DiscoverTVSort = "original_name.desc"
+	DiscoverTVSortVoteAverageAsc   DiscoverTVSort = "vote_average.asc"
+	DiscoverTVSortVoteAverageDesc  DiscoverTVSort = "vote_average.desc"
+	DiscoverTVSortVoteCountAsc     DiscoverTVSort = "vote_count.asc"
+	DiscoverTVSortVoteCountDesc    DiscoverTVSort = "vote_count.desc"
+)
+
+// discoverParams holds the parameters shared by
+// the movie and TV discover queries.
+type discoverParams map[string]string
+
+func (p discoverParams) set(key, value string) {
+	p[key] = value
+}
+
+func (p discoverParams) setFilter(key string, filter DiscoverFilter) {
+	p[key] = filter.String()
+}
+
+func (p discoverParams) setDate(key string, date time.Time) {
+	p[key] = date.Format(time.DateOnly)
+}
+
+func (p discoverParams) setFloat(key string, value float64) {
+	p[key] = strconv.FormatFloat(value, 'f', -1, 64)
+}
+
+func (p discoverParams) setInt(key string, value int) {
+	p[key] = strconv.Itoa(value)
+}
+
+// discoverRanges are the range parameters checked by validate.
+var discoverRanges = []string{
+	"primary_release_date",
+	"release_date",
+	"air_date",
+	"first_air_date",
+	"vote_average",
+	"vote_count",
+	"with_runtime",
+}
+
+// validate checks the coupled parameters of a query.
+func (p discoverParams) validate() error {
+	var errs []error
+	for _, key := range discoverRanges {
+		gte, okGTE := p[key+".gte"]
+		lte, okLTE := p[key+".lte"]
+		if !okGTE || !okLTE {
+			continue
+		}
+		// Dates use the ISO 8601 layout, so they
+		// can be compared as strings.
+		a, b, numeric := parseRange(gte, lte)
+		if (numeric && a > b) || (!numeric && gte > lte) {
+			errs = append(errs, fmt.Errorf(
+				"%s.gte (%s) is greater than %s.lte (%s)", key, gte, key, lte,
+			))
+		}
+	}
+	if _, ok := p["watch_region"]; !ok {
+		for _, key := range []string{"with_watch_providers", "with_watch_monetization_types"} {
+			if _, ok := p[key]; ok {
+				errs = append(errs, fmt.Errorf("%s requires watch_region", key))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func parseRange(gte, lte string) (float64, float64, bool) {
+	a, errA := strconv.ParseFloat(gte, 64)
+	b, errB := strconv.ParseFloat(lte, 64)
+	return a, b, errA == nil && errB == nil
+}
+
+// options returns a copy of the parameters.
+func (p discoverParams) options() map[string]string {
+	options := make(map[string]string, len(p))
+	for key, value := range p {
+		options[key] = value
+	}
+	return options
+}
+
+// DiscoverMovieQuery type is a builder for the
+// parameters of GetDiscoverMovie.
+//
+//	query := tmdb.NewDiscoverMovieQuery().
+//		SortBy(tmdb.DiscoverMovieSortPopularityDesc).
+//		WithGenres(tmdb.AllOf(28, 12)).
+//		PrimaryReleaseDateGTE(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)).
+//		Certification("US", "PG-13")
+//	movies, err := tmdbClient.GetDiscoverMovieQuery(query)
+type DiscoverMovieQuery struct {
+	params discoverParams
+}
+
+// NewDiscoverMovieQuery creates an empty movie discover query.
+func NewDiscoverMovieQuery() *DiscoverMovieQuery {
+	return &DiscoverMovieQuery{params: discoverParams{}}
+}
+
+// Options validates the query and returns it
+// as the urlOptions of GetDiscoverMovie.
+func (q *DiscoverMovieQuery) Options() (map[string]string, error) {
+	var errs []error
+	if err := q.params.validate(); err != nil {
+		errs = append(errs, err)
+	}
+	if _, ok := q.params["certification_country"]; !ok {
+		for _, key := range []string{
+			"certification",
+			"certification.gte",
+			"certification.lte",
+		} {
+			if _, ok := q.params[key]; ok {
+				errs = append(errs, fmt.Errorf("%s requires certification_country", key))
+			}
+		}
+	}
+	if err := errors.Join(errs...); err != nil {
+		return nil, fmt.Errorf("invalid discover query: %w", err)
+	}
+	return q.params.options(), nil
+}
+
+// SortBy sets the sort order of the results.
+func (q *DiscoverMovieQuery) SortBy(sort DiscoverMovieSort) *DiscoverMovieQuery {
+	q.params.set("sort_by", string(sort))
+	return q
+}
+
+// Language sets the language of the results.
+func (q *DiscoverMovieQuery) Language(language string) *DiscoverMovieQuery {
+	q.params.set("language", language)
+	return q
+}
+
+// Region sets the region used for the release dates.
+func (q *DiscoverMovieQuery) Region(region string) *DiscoverMovieQuery {
+	q.params.set("region", region)
+	return q
+}
+
+// Page sets the page of the results.
+func (q *DiscoverMovieQuery) Page(page int) *DiscoverMovieQuery {
+	q.params.setInt("page", page)
+	return q
+}
+
+// IncludeAdult sets whether adult movies are included.
+func (q *DiscoverMovieQuery) IncludeAdult(include bool) *DiscoverMovieQuery {
+	q.params.set("include_adult", strconv.FormatBool(include))
+	return q
+}
+
+// IncludeVideo sets whether video only results are included.
+func (q *DiscoverMovieQuery) IncludeVideo(include bool) *DiscoverMovieQuery {
+	q.params.set("include_video", strconv.FormatBool(include))
+	return q
+}
+
+// Certification filters the movies by a certification
+// of the given country, e.g. "US" and "R".
+func (q *DiscoverMovieQuery) Certification(country, certification string) *DiscoverMovieQuery {
+	q.params.set("certification_country", country)
+	q.params.set("certification", certification)
+	return q
+}
+
+// CertificationLTE filters the movies by a certification lower
+// than or equal to the given one in the given country.
+func (q *DiscoverMovieQuery) CertificationLTE(country, certification string) *DiscoverMovieQuery {
+	q.params.set("certification_country", country)
+	q.params.set("certification.lte", certification)
+	return q
+}
+
+// CertificationGTE filters the movies by a certification greater
+// than or equal to the given one in the given country.
+func (q *DiscoverMovieQuery) CertificationGTE(country, certification string) *DiscoverMovieQuery {
+	q.params.set("certification_country", country)
+	q.params.set("certification.gte", certification)
+	return q
+}
+
+// PrimaryReleaseYear filters the movies by primary release year.
+func (q *DiscoverMovieQuery) PrimaryReleaseYear(year int) *DiscoverMovieQuery {
+	q.params.setInt("primary_release_year", year)
+	return q
+}
+
+// PrimaryReleaseDateGTE filters the movies released on or after date.
+func (q *DiscoverMovieQuery) PrimaryReleaseDateGTE(date time.Time) *DiscoverMovieQuery {
+	q.params.setDate("primary_release_date.gte", date)
+	return q
+}
+
+// PrimaryReleaseDateLTE filters the movies released on or before date.
+func (q *DiscoverMovieQuery) PrimaryReleaseDateLTE(date time.Time) *DiscoverMovieQuery {
+	q.params.setDate("primary_release_date.lte", date)
+	return q
+}
+
+// ReleaseDateGTE filters the movies with a regional
+// release on or after date.
+func (q *DiscoverMovieQuery) ReleaseDateGTE(date time.Time) *DiscoverMovieQuery {
+	q.params.setDate("release_date.gte", date)
+	return q
+}
+
+// ReleaseDateLTE filters the movies with a regional
+// release on or before date.
+func (q *DiscoverMovieQuery) ReleaseDateLTE(date time.Time) *DiscoverMovieQuery {
+	q.params.setDate("release_date.lte", date)
+	return q
+}
+
+// WithReleaseType filters the movies by release type,
+// e.g. AnyOf(2, 3) for limited or theatrical releases.
+func (q *DiscoverMovieQuery) WithReleaseType(filter DiscoverFilter) *DiscoverMovieQuery {
+	q.params.setFilter("with_release_type", filter)
+	return q
+}
+
+// Year filters the movies by any release date year.
+func (q *DiscoverMovieQuery) Year(year int) *DiscoverMovieQuery {
+	q.params.setInt("year", year)
+	return q
+}
+
+// VoteAverageGTE filters the movies by a minimum vote average.
+func (q *DiscoverMovieQuery) VoteAverageGTE(average float64) *DiscoverMovieQuery {
+	q.params.setFloat("vote_average.gte", average)
+	return q
+}
+
+// VoteAverageLTE filters the movies by a maximum vote average.
+func (q *DiscoverMovieQuery) VoteAverageLTE(average float64) *DiscoverMovieQuery {
+	q.params.setFloat("vote_average.lte", average)
+	return q
+}
+
+// VoteCountGTE filters the movies by a minimum vote count.
+func (q *DiscoverMovieQuery) VoteCountGTE(count int) *DiscoverMovieQuery {
+	q.params.setInt("vote_count.gte", count)
+	return q
+}
+
+// VoteCountLTE filters the movies by a maximum vote count.
+func (q *DiscoverMovieQuery) VoteCountLTE(count int) *DiscoverMovieQuery {
+	q.params.setInt("vote_count.lte", count)
+	return q
+}
+
+// WithRuntimeGTE filters the movies by a minimum runtime in minutes.
+func (q *DiscoverMovieQuery) WithRuntimeGTE(minutes int) *DiscoverMovieQuery {
+	q.params.setInt("with_runtime.gte", minutes)
+	return q
+}
+
+// WithRuntimeLTE filters the movies by a maximum runtime in minutes.
+func (q *DiscoverMovieQuery) WithRuntimeLTE(minutes int) *DiscoverMovieQuery {
+	q.params.setInt("with_runtime.lte", minutes)
+	return q
+}
+
+// WithCast filters the movies by cast members.
+func (q *DiscoverMovieQuery) WithCast(filter DiscoverFilter) *DiscoverMovieQuery {
+	q.params.setFilter("with_cast", filter)
+	return q
+}
+
+// WithCrew filters the movies by crew members.
+func (q *DiscoverMovieQuery) WithCrew(filter DiscoverFilter) *DiscoverMovieQuery {
+	q.params.setFilter("with_crew", filter)
+	return q
+}
+
+// WithPeople filters the movies by cast or crew members.
+func (q *DiscoverMovieQuery) WithPeople(filter DiscoverFilter) *DiscoverMovieQuery {
+	q.params.setFilter("with_people", filter)
+	return q
+}
+
+// WithCompanies filters the movies by production companies.
+func (q *DiscoverMovieQuery) WithCompanies(filter DiscoverFilter) *DiscoverMovieQuery {
+	q.params.setFilter("with_companies", filter)
+	return q
+}
+
+// WithGenres filters the movies by genres.
+func (q *DiscoverMovieQuery) WithGenres(filter DiscoverFilter) *DiscoverMovieQuery {
+	q.params.setFilter("with_genres", filter)
+	return q
+}
+
+// WithoutGenres excludes the movies of the genres.
+func (q *DiscoverMovieQuery) WithoutGenres(filter DiscoverFilter) *DiscoverMovieQuery {
+	q.params.setFilter("without_genres", filter)
+	return q
+}
+
+// WithKeywords filters the movies by keywords.
+func (q *DiscoverMovieQuery) WithKeywords(filter DiscoverFilter) *DiscoverMovieQuery {
+	q.params.setFilter("with_keywords", filter)
+	return q
+}
+
+// WithoutKeywords excludes the movies with the keywords.
+func (q *DiscoverMovieQuery) WithoutKeywords(filter DiscoverFilter) *DiscoverMovieQuery {
+	q.params.setFilter("without_keywords", filter)
+	return q
+}
+
+// WithOriginalLanguage filters the movies by original language.
+func (q *DiscoverMovieQuery) WithOriginalLanguage(language string) *DiscoverMovieQuery {
+	q.params.set("with_original_language", language)
+	return q
+}
+
+// WithWatchProviders filters the movies by watch
+// providers available in the given region.
+func (q *DiscoverMovieQuery) WithWatchProviders(region string, filter DiscoverFilter) *DiscoverMovieQuery {
+	q.params.set("watch_region", region)
+	q.params.setFilter("with_watch_providers", filter)
+	return q
+}
+
+// Set sets a raw parameter not covered by the builder.
+func (q *DiscoverMovieQuery) Set(key, value string) *DiscoverMovieQuery {
+	q.params.set(key, value)
+	return q
+}
+
+// GetDiscoverMovieQuery validates the query and discovers
+// movies with it. See GetDiscoverMovie.
+func (c *Client) GetDiscoverMovieQuery(
+	query *DiscoverMovieQuery,
+) (*DiscoverMovie, error) {
+	return c.GetDiscoverMovieQueryWithContext(context.Background(), query)
+}
+
+// GetDiscoverMovieQueryWithContext is like GetDiscoverMovieQuery
+// but uses the given context for cancellation and deadlines.
+func (c *Client) GetDiscoverMovieQueryWithContext(
+	ctx context.Context,
+	query *DiscoverMovieQuery,
+) (*DiscoverMovie, error) {
+	options, err := query.Options()
+	if err != nil {
+		return nil, err
+	}
+	return c.GetDiscoverMovieWithContext(ctx, options)
+}
+
+// DiscoverTVQuery type is a builder for the
+// parameters of GetDiscoverTV.
+//
+//	query := tmdb.NewDiscoverTVQuery().
+//		SortBy(tmdb.DiscoverTVSortVoteAverageDesc).
+//		WithGenres(tmdb.AnyOf(18, 80)).
+//		VoteCountGTE(100)
+//	shows, err := tmdbClient.GetDiscoverTVQuery(query)
+type DiscoverTVQuery struct {
+	params discoverParams
+}
+
+// NewDiscoverTVQuery creates an empty TV discover query.
+func NewDiscoverTVQuery() *DiscoverTVQuery {
+	return &DiscoverTVQuery{params: discoverParams{}}
+}
+
+// Options validates the query and returns it
+// as the urlOptions of GetDiscoverTV.
+func (q *DiscoverTVQuery) Options() (map[string]string, error) {
+	if err := q.params.validate(); err != nil {
+		return nil, fmt.Errorf("invalid discover query: %w", err)
+	}
+	return q.params.options(), nil
+}
+
+// SortBy sets the sort order of the results.
+func (q *DiscoverTVQuery) SortBy(sort DiscoverTVSort) *DiscoverTVQuery {
+	q.params.set("sort_by", string(sort))
+	return q
+}
+
+// Language sets the language of the results.
+func (q *DiscoverTVQuery) Language(language string) *DiscoverTVQuery {
+	q.params.set("language", language)
+	return q
+}
+
+// Page sets the page of the results.
+func (q *DiscoverTVQuery) Page(page int) *DiscoverTVQuery {
+	q.params.setInt("page", page)
+	return q
+}
+
+// IncludeAdult sets whether adult TV shows are included.
+func (q *DiscoverTVQuery) IncludeAdult(include bool) *DiscoverTVQuery {
+	q.params.set("include_adult", strconv.FormatBool(include))
+	return q
+}
+
+// IncludeNullFirstAirDates sets whether TV shows
+// without a first air date are included.
+func (q *DiscoverTVQuery) IncludeNullFirstAirDates(include bool) *DiscoverTVQuery {
+	q.params.set("include_null_first_air_dates", strconv.FormatBool(include))
+	return q
+}
+
+// Timezone sets the timezone used by the air date filters.
+func (q *DiscoverTVQuery) Timezone(timezone string) *DiscoverTVQuery {
+	q.params.set("timezone", timezone)
+	return q
+}
+
+// AirDateGTE filters the TV shows with an episode aired on or after date.
+func (q *DiscoverTVQuery) AirDateGTE(date time.Time) *DiscoverTVQuery {
+	q.params.setDate("air_date.gte", date)
+	return q
+}
+
+// AirDateLTE filters the TV shows with an episode aired on or before date.
+func (q *DiscoverTVQuery) AirDateLTE(date time.Time) *DiscoverTVQuery {
+	q.params.setDate("air_date.lte", date)
+	return q
+}
+
+// FirstAirDateGTE filters the TV shows first aired on or after date.
+func (q *DiscoverTVQuery) FirstAirDateGTE(date time.Time) *DiscoverTVQuery {
+	q.params.setDate("first_air_date.gte", date)
+	return q
+}
+
+// FirstAirDateLTE filters the TV shows first aired on or before date.
+func (q *DiscoverTVQuery) FirstAirDateLTE(date time.Time) *DiscoverTVQuery {
+	q.params.setDate("first_air_date.lte", date)
+	return q
+}
+
+// FirstAirDateYear filters the TV shows by first air date year.
+func (q *DiscoverTVQuery) FirstAirDateYear(year int) *DiscoverTVQuery {
+	q.params.setInt("first_air_date_year", year)
+	return q
+}
+
+// VoteAverageGTE filters the TV shows by a minimum vote average.
+func (q *DiscoverTVQuery) VoteAverageGTE(average float64) *DiscoverTVQuery {
+	q.params.setFloat("vote_average.gte", average)
+	return q
+}
+
+// VoteAverageLTE filters the TV shows by a maximum vote average.
+func (q *DiscoverTVQuery) VoteAverageLTE(average float64) *DiscoverTVQuery {
+	q.params.setFloat("vote_average.lte", average)
+	return q
+}
+
+// VoteCountGTE filters the TV shows by a minimum vote count.
+func (q *DiscoverTVQuery) VoteCountGTE(count int) *DiscoverTVQuery {
+	q.params.setInt("vote_count.gte", count)
+	return q
+}
+
+// VoteCountLTE filters the TV shows by a maximum vote count.
+func (q *DiscoverTVQuery) VoteCountLTE(count int) *DiscoverTVQuery {
+	q.params.setInt("vote_count.lte", count)
+	return q
+}
+
+// WithRuntimeGTE filters the TV shows by a minimum
+// episode runtime in minutes.
+func (q *DiscoverTVQuery) WithRuntimeGTE(minutes int) *DiscoverTVQuery {
+	q.params.setInt("with_runtime.gte", minutes)
+	return q
+}
+
+// WithRuntimeLTE filters the TV shows by a maximum
+// episode runtime in minutes.
+func (q *DiscoverTVQuery) WithRuntimeLTE(minutes int) *DiscoverTVQuery {
+	q.params.setInt("with_runtime.lte", minutes)
+	return q
+}
+
+// WithNetworks filters the TV shows by networks.
+func (q *DiscoverTVQuery) WithNetworks(filter DiscoverFilter) *DiscoverTVQuery {
+	q.params.setFilter("with_networks", filter)
+	return q
+}
+
+// WithCompanies filters the TV shows by production companies.
+func (q *DiscoverTVQuery) WithCompanies(filter DiscoverFilter) *DiscoverTVQuery {
+	q.params.setFilter("with_companies", filter)
+	return q
+}
+
+// WithGenres filters the TV shows by genres.
+func (q *DiscoverTVQuery) WithGenres(filter DiscoverFilter) *DiscoverTVQuery {
+	q.params.setFilter("with_genres", filter)
+	return q
+}
+
+// WithoutGenres excludes the TV shows of the genres.
+func (q *DiscoverTVQuery) WithoutGenres(filter DiscoverFilter) *DiscoverTVQuery {
+	q.params.setFilter("without_genres", filter)
+	return q
+}
+
+// WithKeywords filters the TV shows by keywords.
+func (q *DiscoverTVQuery) WithKeywords(filter DiscoverFilter) *DiscoverTVQuery {
+	q.params.setFilter("with_keywords", filter)
+	return q
+}
+
+// WithoutKeywords excludes the TV shows with the keywords.
+func (q *DiscoverTVQuery) WithoutKeywords(filter DiscoverFilter) *DiscoverTVQuery {
+	q.params.setFilter("without_keywords", filter)
+	return q
+}
+
+// WithOriginalLanguage filters the TV shows by original language.
+func (q *DiscoverTVQuery) WithOriginalLanguage(language string) *DiscoverTVQuery {
+	q.params.set("with_original_language", language)
+	return q
+}
+
+// WithWatchProviders filters the TV shows by watch
+// providers available in the given region.
+func (q *DiscoverTVQuery) WithWatchProviders(region string, filter DiscoverFilter) *DiscoverTVQuery {
+	q.params.set("watch_region", region)
+	q.params.setFilter("with_watch_providers", filter)
+	return q
+}
+
+// Set sets a raw parameter not covered by the builder.
+func (q *DiscoverTVQuery) Set(key, value string) *DiscoverTVQuery {
+	q.params.set(key, value)
+	return q
+}
+
+// GetDiscoverTVQuery validates the query and discovers
+// TV shows with it. See GetDiscoverTV.
+func (c *Client) GetDiscoverTVQuery(
+	query *DiscoverTVQuery,
+) (*DiscoverTV, error) {
+	return c.GetDiscoverTVQueryWithContext(context.Background(), query)
+}
+
+// GetDiscoverTVQueryWithContext is like GetDiscoverTVQuery
+// but uses the given context for cancellation and deadlines.
+func (c *Client) GetDiscoverTVQueryWithContext(
+	ctx context.Context,
+	query *DiscoverTVQuery,
+) (*DiscoverTV, error) {
+	options, err := query.Options()
+	if err != nil {
+		return nil, err
+	}
+	return c.GetDiscoverTVWithContext(ctx, options)
+}
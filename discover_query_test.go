@@ -0,0 +1,113 @@
+package tmdb
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"time"
+)
+
+func (suite *TMBDTestSuite) TestDiscoverFilter() {
+	suite.Equal("28,12", AllOf(28, 12).String())
+	suite.Equal("28|12", AnyOf(28, 12).String())
+	suite.Equal("", AnyOf().String())
+}
+
+func (suite *TMBDTestSuite) TestDiscoverMovieQuery() {
+	options, err := NewDiscoverMovieQuery().
+		SortBy(DiscoverMovieSortPopularityDesc).
+		WithGenres(AllOf(28, 12)).
+		WithKeywords(AnyOf(9715, 9717)).
+		WithCompanies(AnyOf(420)).
+		WithWatchProviders("BR", AnyOf(8, 337)).
+		PrimaryReleaseDateGTE(time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)).
+		PrimaryReleaseDateLTE(time.Date(2019, 12, 31, 0, 0, 0, 0, time.UTC)).
+		VoteAverageGTE(6.5).
+		VoteCountGTE(100).
+		CertificationLTE("US", "PG-13").
+		Page(2).
+		Options()
+	suite.Nil(err)
+	suite.Equal(map[string]string{
+		"sort_by":                  "popularity.desc",
+		"with_genres":              "28,12",
+		"with_keywords":            "9715|9717",
+		"with_companies":           "420",
+		"watch_region":             "BR",
+		"with_watch_providers":     "8|337",
+		"primary_release_date.gte": "2019-01-01",
+		"primary_release_date.lte": "2019-12-31",
+		"vote_average.gte":         "6.5",
+		"vote_count.gte":           "100",
+		"certification_country":    "US",
+		"certification.lte":        "PG-13",
+		"page":                     "2",
+	}, options)
+}
+
+func (suite *TMBDTestSuite) TestDiscoverMovieQueryInvalid() {
+	_, err := NewDiscoverMovieQuery().
+		Set("certification", "R").
+		Set("with_watch_providers", "8").
+		VoteAverageGTE(8).
+		VoteAverageLTE(5).
+		ReleaseDateGTE(time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)).
+		ReleaseDateLTE(time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)).
+		Options()
+	suite.Error(err)
+	suite.Contains(err.Error(), "certification requires certification_country")
+	suite.Contains(err.Error(), "with_watch_providers requires watch_region")
+	suite.Contains(err.Error(), "vote_average.gte (8) is greater than vote_average.lte (5)")
+	suite.Contains(err.Error(), "release_date.gte (2020-01-01) is greater than release_date.lte (2019-01-01)")
+}
+
+func (suite *TMBDTestSuite) TestDiscoverTVQuery() {
+	options, err := NewDiscoverTVQuery().
+		SortBy(DiscoverTVSortVoteAverageDesc).
+		WithGenres(AnyOf(18, 80)).
+		WithNetworks(AllOf(213)).
+		FirstAirDateYear(2019).
+		Timezone("America/Sao_Paulo").
+		Options()
+	suite.Nil(err)
+	suite.Equal("vote_average.desc", options["sort_by"])
+	suite.Equal("18|80", options["with_genres"])
+	suite.Equal("213", options["with_networks"])
+	suite.Equal("2019", options["first_air_date_year"])
+
+	_, err = NewDiscoverTVQuery().VoteCountGTE(10).VoteCountLTE(1).Options()
+	suite.Error(err)
+}
+
+func (suite *TMBDTestSuite) TestGetDiscoverMovieQuery() {
+	var query string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query().Get("with_genres")
+		w.Write([]byte(`{"page":1,"results":[{"id":1,"title":"Movie"}]}`))
+	}))
+	defer ts.Close()
+	c, _ := Init(apiKey)
+	c.SetCustomBaseURL(ts.URL)
+	movies, err := c.GetDiscoverMovieQuery(NewDiscoverMovieQuery().WithGenres(AnyOf(28, 12)))
+	suite.Nil(err)
+	suite.Equal("28|12", query)
+	suite.Equal("Movie", movies.Results[0].Title)
+
+	query = ""
+	_, err = c.GetDiscoverMovieQuery(NewDiscoverMovieQuery().Set("certification", "R"))
+	suite.Error(err)
+	suite.Empty(query)
+}
+
+func (suite *TMBDTestSuite) TestGetDiscoverTVQuery() {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"page":1,"results":[{"id":1,"name":"Show"}]}`))
+	}))
+	defer ts.Close()
+	c, _ := Init(apiKey)
+	c.SetCustomBaseURL(ts.URL)
+	shows, err := c.GetDiscoverTVQuery(NewDiscoverTVQuery().WithGenres(AnyOf(18)))
+	suite.Nil(err)
+	suite.Equal("Show", shows.Results[0].Name)
+	_, err = c.GetDiscoverTVQuery(NewDiscoverTVQuery().Set("with_watch_providers", "8"))
+	suite.Error(err)
+}
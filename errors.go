@@ -0,0 +1,169 @@
+package tmdb
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	json "github.com/goccy/go-json"
+)
+
+// Sentinel errors matched by APIError with errors.Is.
+var (
+	// ErrNotFound is the error of an unknown resource.
+	ErrNotFound = errors.New("tmdb: resource not found")
+	// ErrUnauthorized is the error of an invalid api key,
+	// bearer token or session.
+	ErrUnauthorized = errors.New("tmdb: unauthorized")
+	// ErrRateLimited is the error of a request over the rate limit.
+	ErrRateLimited = errors.New("tmdb: rate limited")
+	// ErrInvalidID is the error of an invalid id.
+	ErrInvalidID = errors.New("tmdb: invalid id")
+	// ErrSessionDenied is the error of a session creation
+	// from a request token that was not approved.
+	ErrSessionDenied = errors.New("tmdb: session denied")
+)
+
+// APIError type is an error response of the TMDb API.
+//
+// Use errors.As to read it, or errors.Is with the sentinel
+// errors to classify it:
+//
+//	var apiErr *tmdb.APIError
+//	if errors.As(err, &apiErr) {
+//		fmt.Println(apiErr.HTTPStatus, apiErr.StatusCode, apiErr.URL)
+//	}
+//	if errors.Is(err, tmdb.ErrNotFound) {
+//		...
+//	}
+type APIError struct {
+	// HTTPStatus is the http status code of the response.
+	HTTPStatus int
+	// StatusCode, StatusMessage and Success are the TMDb error.
+	StatusCode    int
+	StatusMessage string
+	Success       bool
+	// Method and URL are the request method and url,
+	// without the api key and the session ids.
+	Method string
+	URL    string
+	// RequestID identifies the request in the TMDb and
+	// CDN logs, when the response has one.
+	RequestID string
+	// RetryAfter is the wait asked by the Retry-After
+	// header, zero when absent.
+	RetryAfter time.Duration
+	// Body is the raw response body when it has no TMDb
+	// status code, like an HTML error page or a JSON
+	// list of validation errors.
+	Body []byte
+}
+
+// requestIDHeaders are the response headers identifying a request.
+var requestIDHeaders = []string{"X-Request-Id", "X-Amz-Cf-Id"}
+
+// newAPIError creates an APIError from a failed response.
+func newAPIError(r *http.Response) *APIError {
+	apiErr := &APIError{HTTPStatus: r.StatusCode}
+	if r.Request != nil && r.Request.URL != nil {
+		apiErr.Method = r.Request.Method
+		apiErr.URL = redactURL(r.Request.URL)
+	}
+	for _, header := range requestIDHeaders {
+		if id := r.Header.Get(header); id != "" {
+			apiErr.RequestID = id
+			break
+		}
+	}
+	if wait, ok := retryAfter(r); ok {
+		apiErr.RetryAfter = wait
+	}
+	return apiErr
+}
+
+// redactURL returns u without the credential query parameters.
+func redactURL(u *url.URL) string {
+	redacted := *u
+	query := redacted.Query()
+	for _, key := range credentialParams {
+		query.Del(key)
+	}
+	redacted.RawQuery = query.Encode()
+	redacted.User = nil
+	return redacted.String()
+}
+
+// Code returns the TMDb status code of the error.
+func (e *APIError) Code() StatusCode {
+	return StatusCode(e.StatusCode)
+}
+
+func (e *APIError) Error() string {
+	switch {
+	case e.StatusCode != 0 || e.StatusMessage != "":
+		return fmt.Sprintf(
+			"code: %d | success: %t | message: %s",
+			e.StatusCode,
+			e.Success,
+			e.StatusMessage,
+		)
+	case len(e.Body) == 0:
+		return fmt.Sprintf(
+			"[%d]: empty body %s",
+			e.HTTPStatus,
+			http.StatusText(e.HTTPStatus),
+		)
+	case json.Valid(e.Body):
+		return fmt.Sprintf(
+			"[%d]: %s %s",
+			e.HTTPStatus,
+			http.StatusText(e.HTTPStatus),
+			e.Body,
+		)
+	default:
+		return fmt.Sprintf(
+			"couldn't decode error: (%d) [%s]",
+			len(e.Body),
+			e.Body,
+		)
+	}
+}
+
+// Is matches the sentinel errors from the http
+// status and the TMDb status code of the error.
+func (e *APIError) Is(target error) bool {
+	code := e.Code()
+	switch target {
+	case ErrNotFound:
+		return e.HTTPStatus == http.StatusNotFound ||
+			code == StatusResourceNotFound ||
+			code == StatusSessionNotFound
+	case ErrUnauthorized:
+		return e.HTTPStatus == http.StatusUnauthorized ||
+			code.Class() == StatusClassAuth
+	case ErrRateLimited:
+		return e.HTTPStatus == http.StatusTooManyRequests ||
+			code.Class() == StatusClassRateLimit
+	case ErrInvalidID:
+		return code == StatusInvalidID || code == StatusIDInvalid
+	case ErrSessionDenied:
+		return code == StatusSessionDenied
+	}
+	return false
+}
+
+// As fills an Error target, so callers matching
+// the Error type keep working.
+func (e *APIError) As(target any) bool {
+	if t, ok := target.(*Error); ok {
+		*t = Error{
+			StatusMessage: e.StatusMessage,
+			Success:       e.Success,
+			StatusCode:    e.StatusCode,
+		}
+		return true
+	}
+	return false
+}
@@ -0,0 +1,139 @@
+package tmdb
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"time"
+)
+
+func (suite *TMBDTestSuite) newErrorServer() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Request-Id", "req-"+r.URL.Path)
+		switch r.URL.Path {
+		case "/movie/1":
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"success":false,"status_code":34,"status_message":"The resource you requested could not be found."}`))
+		case "/movie/0":
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"success":false,"status_code":6,"status_message":"Invalid id: The pre-requisite id is invalid or not found."}`))
+		case "/movie/2":
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"success":false,"status_code":7,"status_message":"Invalid API key: You must be granted a valid key."}`))
+		case "/movie/3":
+			w.Header().Set("Retry-After", "3")
+			w.WriteHeader(http.StatusTooManyRequests)
+			w.Write([]byte(`{"success":false,"status_code":25,"status_message":"Your request count (#) is over the allowed limit of (40)."}`))
+		case "/authentication/session/new":
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"success":false,"status_code":17,"status_message":"Session denied."}`))
+		default:
+			w.WriteHeader(http.StatusBadGateway)
+			w.Write([]byte(`<html>Bad Gateway</html>`))
+		}
+	}))
+}
+
+func (suite *TMBDTestSuite) TestAPIError() {
+	ts := suite.newErrorServer()
+	defer ts.Close()
+	c, _ := Init(apiKey)
+	c.SetCustomBaseURL(ts.URL)
+	c.SetSessionID(sessionID)
+	_, err := c.GetMovieDetails(1, map[string]string{"session_id": sessionID, "language": "en-US"})
+	var apiErr *APIError
+	suite.True(errors.As(err, &apiErr))
+	suite.Equal(http.StatusNotFound, apiErr.HTTPStatus)
+	suite.Equal(34, apiErr.StatusCode)
+	suite.Equal(http.MethodGet, apiErr.Method)
+	suite.Equal("req-/movie/1", apiErr.RequestID)
+	suite.NotContains(apiErr.URL, apiKey)
+	suite.NotContains(apiErr.URL, sessionID)
+	u, _ := url.Parse(apiErr.URL)
+	suite.Equal("/movie/1", u.Path)
+	suite.Equal("en-US", u.Query().Get("language"))
+	suite.True(errors.Is(err, ErrNotFound))
+	suite.False(errors.Is(err, ErrInvalidID))
+	suite.Equal("code: 34 | success: false | message: The resource you requested could not be found.", err.Error())
+
+	var tmdbErr Error
+	suite.True(errors.As(err, &tmdbErr))
+	suite.Equal(34, tmdbErr.StatusCode)
+}
+
+// TestAPIErrorTypeAssertion pins the documented breaking change:
+// the client no longer returns Error values, only errors.As
+// matches an Error.
+func (suite *TMBDTestSuite) TestAPIErrorTypeAssertion() {
+	ts := suite.newErrorServer()
+	defer ts.Close()
+	c, _ := Init(apiKey)
+	c.SetCustomBaseURL(ts.URL)
+	_, err := c.GetMovieDetails(1, nil)
+	_, ok := err.(Error)
+	suite.False(ok)
+	switch err.(type) {
+	case *APIError:
+	default:
+		suite.Fail("expected an *APIError", "got %T", err)
+	}
+	var tmdbErr Error
+	suite.True(errors.As(err, &tmdbErr))
+	suite.Equal(34, tmdbErr.StatusCode)
+	suite.Equal("The resource you requested could not be found.", tmdbErr.StatusMessage)
+}
+
+func (suite *TMBDTestSuite) TestAPIErrorWithoutStatusCode() {
+	body := `{"success":false,"errors":["page must be less than or equal to 500"]}`
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnprocessableEntity)
+		w.Write([]byte(body))
+	}))
+	defer ts.Close()
+	c, _ := Init(apiKey)
+	c.SetCustomBaseURL(ts.URL)
+	_, err := c.GetMoviePopular(map[string]string{"page": "501"})
+	var apiErr *APIError
+	suite.True(errors.As(err, &apiErr))
+	suite.Equal(http.StatusUnprocessableEntity, apiErr.HTTPStatus)
+	suite.Equal(body, string(apiErr.Body))
+	suite.Equal("[422]: Unprocessable Entity "+body, err.Error())
+}
+
+func (suite *TMBDTestSuite) TestAPIErrorSentinels() {
+	ts := suite.newErrorServer()
+	defer ts.Close()
+	c, _ := Init(apiKey)
+	c.SetCustomBaseURL(ts.URL)
+	_, err := c.GetMovieDetails(0, nil)
+	suite.True(errors.Is(err, ErrInvalidID))
+	suite.True(errors.Is(err, ErrNotFound))
+	_, err = c.GetMovieDetails(2, nil)
+	suite.True(errors.Is(err, ErrUnauthorized))
+	_, err = c.GetMovieDetails(3, nil)
+	suite.True(errors.Is(err, ErrRateLimited))
+	var apiErr *APIError
+	suite.True(errors.As(err, &apiErr))
+	suite.Equal(3*time.Second, apiErr.RetryAfter)
+	_, err = c.CreateSession("rt")
+	suite.True(errors.Is(err, ErrSessionDenied))
+	suite.False(errors.Is(err, ErrNotFound))
+	_, err = c.GetMovieDetails(4, nil)
+	suite.True(errors.As(err, &apiErr))
+	suite.Equal(http.StatusBadGateway, apiErr.HTTPStatus)
+	suite.Contains(err.Error(), "couldn't decode error")
+}
+
+func (suite *TMBDTestSuite) TestErrorWrapping() {
+	c, _ := Init(apiKey)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err := c.get(ctx, "", "http://[::1]:namedport", nil)
+	var urlErr *url.Error
+	suite.True(errors.As(err, &urlErr))
+	err = c.request(context.Background(), "", "http://localhost", make(chan int), http.MethodPost, nil)
+	suite.Contains(err.Error(), "failed to encode request body to JSON")
+	suite.NotNil(errors.Unwrap(err))
+}
@@ -1,6 +1,9 @@
 package tmdb
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 // FindByID type is a struct for find JSON response.
 type FindByID struct {
@@ -93,14 +96,24 @@ type FindByID struct {
 func (c *Client) GetFindByID(
 	id string,
 	urlOptions map[string]string,
+) (*FindByID, error) {
+	return c.GetFindByIDWithContext(context.Background(), id, urlOptions)
+}
+
+// GetFindByIDWithContext is like GetFindByID but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetFindByIDWithContext(
+	ctx context.Context,
+	id string,
+	urlOptions map[string]string,
 ) (*FindByID, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s/find/%s?api_key=%s%s",
-		baseURL, id, c.apiKey, options,
+		c.GetBaseURL(), id, c.apiKey, options,
 	)
 	findByID := FindByID{}
-	if err := c.get(tmdbURL, &findByID); err != nil {
+	if err := c.get(ctx, "GetFindByID", tmdbURL, &findByID); err != nil {
 		return nil, err
 	}
 	return &findByID, nil
@@ -1,6 +1,9 @@
 package tmdb
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 // GenreMovieList type is a struct for genres movie list JSON response.
 type GenreMovieList struct {
@@ -12,17 +15,26 @@ type GenreMovieList struct {
 // https://developers.themoviedb.org/3/genres/get-movie-list
 func (c *Client) GetGenreMovieList(
 	urlOptions map[string]string,
+) (*GenreMovieList, error) {
+	return c.GetGenreMovieListWithContext(context.Background(), urlOptions)
+}
+
+// GetGenreMovieListWithContext is like GetGenreMovieList but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetGenreMovieListWithContext(
+	ctx context.Context,
+	urlOptions map[string]string,
 ) (*GenreMovieList, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%smovie/list?api_key=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		genreURL,
 		c.apiKey,
 		options,
 	)
 	genreMovieList := GenreMovieList{}
-	if err := c.get(tmdbURL, &genreMovieList); err != nil {
+	if err := c.get(ctx, "GetGenreMovieList", tmdbURL, &genreMovieList); err != nil {
 		return nil, err
 	}
 	return &genreMovieList, nil
@@ -33,17 +45,26 @@ func (c *Client) GetGenreMovieList(
 // https://developers.themoviedb.org/3/genres/get-tv-list
 func (c *Client) GetGenreTVList(
 	urlOptions map[string]string,
+) (*GenreMovieList, error) {
+	return c.GetGenreTVListWithContext(context.Background(), urlOptions)
+}
+
+// GetGenreTVListWithContext is like GetGenreTVList but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetGenreTVListWithContext(
+	ctx context.Context,
+	urlOptions map[string]string,
 ) (*GenreMovieList, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%stv/list?api_key=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		genreURL,
 		c.apiKey,
 		options,
 	)
 	genreTVList := GenreMovieList{}
-	if err := c.get(tmdbURL, &genreTVList); err != nil {
+	if err := c.get(ctx, "GetGenreTVList", tmdbURL, &genreTVList); err != nil {
 		return nil, err
 	}
 	return &genreTVList, nil
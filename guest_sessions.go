@@ -1,23 +1,26 @@
 package tmdb
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 // GuestSessionRatedMovies type is a struct for rated movies JSON response.
 type GuestSessionRatedMovies struct {
 	Results []struct {
-		Adult            bool    `json:"adult"`
-		BackdropPath     string  `json:"backdrop_path"`
-		GenreIDs         []int64 `json:"genre_ids"`
-		ID               int64   `json:"id"`
-		OriginalLanguage string  `json:"original_language"`
-		OriginalTitle    string  `json:"original_title"`
-		Overview         string  `json:"overview"`
-		ReleaseDate      string  `json:"release_date"`
-		PosterPath       string  `json:"poster_path"`
-		Popularity       float32 `json:"popularity"`
-		Title            string  `json:"title"`
-		Video            bool    `json:"video"`
-		Rating           float32 `json:"rating"`
+		Adult            bool       `json:"adult"`
+		BackdropPath     string     `json:"backdrop_path"`
+		GenreIDs         []int64    `json:"genre_ids"`
+		ID               int64      `json:"id"`
+		OriginalLanguage string     `json:"original_language"`
+		OriginalTitle    string     `json:"original_title"`
+		Overview         string     `json:"overview"`
+		ReleaseDate      string     `json:"release_date"`
+		PosterPath       string     `json:"poster_path"`
+		Popularity       float32    `json:"popularity"`
+		Title            string     `json:"title"`
+		Video            bool       `json:"video"`
+		Rating           RatedValue `json:"rating"`
 		VoteMetrics
 	} `json:"results"`
 	PaginatedResultsMeta
@@ -29,18 +32,32 @@ type GuestSessionRatedMovies struct {
 func (c *Client) GetGuestSessionRatedMovies(
 	id string,
 	urlOptions map[string]string,
+) (*GuestSessionRatedMovies, error) {
+	return c.GetGuestSessionRatedMoviesWithContext(
+		context.Background(),
+		id,
+		urlOptions,
+	)
+}
+
+// GetGuestSessionRatedMoviesWithContext is like GetGuestSessionRatedMovies but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetGuestSessionRatedMoviesWithContext(
+	ctx context.Context,
+	id string,
+	urlOptions map[string]string,
 ) (*GuestSessionRatedMovies, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%s%s/rated/movies?api_key=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		guestSessionURL,
 		id,
 		c.apiKey,
 		options,
 	)
 	guestSessionRatedMovies := GuestSessionRatedMovies{}
-	if err := c.get(tmdbURL, &guestSessionRatedMovies); err != nil {
+	if err := c.get(ctx, "GetGuestSessionRatedMovies", tmdbURL, &guestSessionRatedMovies); err != nil {
 		return nil, err
 	}
 	return &guestSessionRatedMovies, nil
@@ -49,18 +66,18 @@ func (c *Client) GetGuestSessionRatedMovies(
 // GuestSessionRatedTVShows type is a struct for rated tv shows JSON response.
 type GuestSessionRatedTVShows struct {
 	Results []struct {
-		BackdropPath     string   `json:"backdrop_path"`
-		FirstAirDate     string   `json:"first_air_date"`
-		GenreIDs         []int64  `json:"genre_ids"`
-		ID               int64    `json:"id"`
-		OriginalLanguage string   `json:"original_language"`
-		OriginalName     string   `json:"original_name"`
-		Overview         string   `json:"overview"`
-		OriginCountry    []string `json:"origin_country"`
-		PosterPath       string   `json:"poster_path"`
-		Popularity       float32  `json:"popularity"`
-		Name             string   `json:"name"`
-		Rating           float32  `json:"rating"`
+		BackdropPath     string     `json:"backdrop_path"`
+		FirstAirDate     string     `json:"first_air_date"`
+		GenreIDs         []int64    `json:"genre_ids"`
+		ID               int64      `json:"id"`
+		OriginalLanguage string     `json:"original_language"`
+		OriginalName     string     `json:"original_name"`
+		Overview         string     `json:"overview"`
+		OriginCountry    []string   `json:"origin_country"`
+		PosterPath       string     `json:"poster_path"`
+		Popularity       float32    `json:"popularity"`
+		Name             string     `json:"name"`
+		Rating           RatedValue `json:"rating"`
 		VoteMetrics
 	} `json:"results"`
 	PaginatedResultsMeta
@@ -72,18 +89,32 @@ type GuestSessionRatedTVShows struct {
 func (c *Client) GetGuestSessionRatedTVShows(
 	id string,
 	urlOptions map[string]string,
+) (*GuestSessionRatedTVShows, error) {
+	return c.GetGuestSessionRatedTVShowsWithContext(
+		context.Background(),
+		id,
+		urlOptions,
+	)
+}
+
+// GetGuestSessionRatedTVShowsWithContext is like GetGuestSessionRatedTVShows but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetGuestSessionRatedTVShowsWithContext(
+	ctx context.Context,
+	id string,
+	urlOptions map[string]string,
 ) (*GuestSessionRatedTVShows, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%s%s/rated/tv?api_key=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		guestSessionURL,
 		id,
 		c.apiKey,
 		options,
 	)
 	guestSessionRatedTVShows := GuestSessionRatedTVShows{}
-	if err := c.get(tmdbURL, &guestSessionRatedTVShows); err != nil {
+	if err := c.get(ctx, "GetGuestSessionRatedTVShows", tmdbURL, &guestSessionRatedTVShows); err != nil {
 		return nil, err
 	}
 	return &guestSessionRatedTVShows, nil
@@ -92,16 +123,16 @@ func (c *Client) GetGuestSessionRatedTVShows(
 // GuestSessionRatedTVEpisodes type is a struct for rated tv episodes JSON response.
 type GuestSessionRatedTVEpisodes struct {
 	Results []struct {
-		AirDate        string  `json:"air_date"`
-		EpisodeNumber  int     `json:"episode_number"`
-		ID             int64   `json:"id"`
-		Name           string  `json:"name"`
-		Overview       string  `json:"overview"`
-		ProductionCode string  `json:"production_code"`
-		SeasonNumber   int     `json:"season_number"`
-		ShowID         int64   `json:"show_id"`
-		StillPath      string  `json:"still_path"`
-		Rating         float32 `json:"rating"`
+		AirDate        string     `json:"air_date"`
+		EpisodeNumber  int        `json:"episode_number"`
+		ID             int64      `json:"id"`
+		Name           string     `json:"name"`
+		Overview       string     `json:"overview"`
+		ProductionCode string     `json:"production_code"`
+		SeasonNumber   int        `json:"season_number"`
+		ShowID         int64      `json:"show_id"`
+		StillPath      string     `json:"still_path"`
+		Rating         RatedValue `json:"rating"`
 		VoteMetrics
 	} `json:"results"`
 	PaginatedResultsMeta
@@ -113,18 +144,32 @@ type GuestSessionRatedTVEpisodes struct {
 func (c *Client) GetGuestSessionRatedTVEpisodes(
 	id string,
 	urlOptions map[string]string,
+) (*GuestSessionRatedTVEpisodes, error) {
+	return c.GetGuestSessionRatedTVEpisodesWithContext(
+		context.Background(),
+		id,
+		urlOptions,
+	)
+}
+
+// GetGuestSessionRatedTVEpisodesWithContext is like GetGuestSessionRatedTVEpisodes but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetGuestSessionRatedTVEpisodesWithContext(
+	ctx context.Context,
+	id string,
+	urlOptions map[string]string,
 ) (*GuestSessionRatedTVEpisodes, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%s%s/rated/tv/episodes?api_key=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		guestSessionURL,
 		id,
 		c.apiKey,
 		options,
 	)
 	guestSessionRatedTVEpisodes := GuestSessionRatedTVEpisodes{}
-	if err := c.get(tmdbURL, &guestSessionRatedTVEpisodes); err != nil {
+	if err := c.get(ctx, "GetGuestSessionRatedTVEpisodes", tmdbURL, &guestSessionRatedTVEpisodes); err != nil {
 		return nil, err
 	}
 	return &guestSessionRatedTVEpisodes, nil
@@ -65,6 +65,15 @@ func GetImageURL(key string, size string) string {
 	return imageSize[size] + key
 }
 
+// GetImageURL is like the package level GetImageURL but
+// uses the image base url of the client.
+func (c *Client) GetImageURL(key string, size string) string {
+	if _, ok := imageSize[size]; !ok {
+		return key
+	}
+	return c.GetImageBaseURL() + size + key
+}
+
 const videoURL = "https://www.youtube.com/watch?v="
 
 // GetVideoURL accepts one parameter, the key and returns
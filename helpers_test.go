@@ -17,3 +17,13 @@ func TestGetVideoURL(t *testing.T) {
 	generatedURL := GetVideoURL("6ZfuNTqbHE8")
 	assert.Equal(t, generatedURL, url)
 }
+
+func TestClientGetImageURL(t *testing.T) {
+	c := Client{}
+	generatedURL := c.GetImageURL("/bOGkgRGdhrBYJSLpXaxhXVstddV.jpg", W500)
+	assert.Equal(t, "https://image.tmdb.org/t/p/w500/bOGkgRGdhrBYJSLpXaxhXVstddV.jpg", generatedURL)
+	c.SetImageBaseURL("http://localhost:3000/t/p/")
+	generatedURL = c.GetImageURL("/bOGkgRGdhrBYJSLpXaxhXVstddV.jpg", W500)
+	assert.Equal(t, "http://localhost:3000/t/p/w500/bOGkgRGdhrBYJSLpXaxhXVstddV.jpg", generatedURL)
+	assert.Equal(t, "/key.jpg", c.GetImageURL("/key.jpg", "w1"))
+}
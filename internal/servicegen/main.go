@@ -0,0 +1,366 @@
+// Command servicegen generates the service interfaces satisfied
+// by *tmdb.Client and their mocks, from the Client methods of
+// each source file.
+//
+// Run it with go generate from the repository root.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/printer"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// service is an interface generated from the Client
+// methods declared in files.
+type service struct {
+	name  string
+	doc   string
+	files []string
+}
+
+var services = []service{
+	{"AccountService", "the v3 account endpoints", []string{"account.go"}},
+	{"AccountV4Service", "the v4 account endpoints", []string{"account_v4.go"}},
+	{"AuthenticationService", "the v3 and v4 authentication endpoints", []string{"authentication.go", "authentication_v4.go"}},
+	{"CertificationsService", "the certifications endpoints", []string{"certifications.go"}},
+	{"ChangesService", "the changes endpoints", []string{"changes.go"}},
+	{"CollectionsService", "the collections endpoints", []string{"collections.go"}},
+	{"CompaniesService", "the companies endpoints", []string{"companies.go"}},
+	{"ConfigurationService", "the configuration endpoints", []string{"configuration.go"}},
+	{"CreditsService", "the credits endpoints", []string{"credits.go"}},
+	{"DiscoverService", "the discover endpoints", []string{"discover.go", "discover_query.go"}},
+	{"FindService", "the find endpoints", []string{"find.go"}},
+	{"GenresService", "the genres endpoints", []string{"genres.go"}},
+	{"GuestSessionsService", "the guest sessions endpoints", []string{"guest_sessions.go"}},
+	{"KeywordsService", "the keywords endpoints", []string{"keywords.go"}},
+	{"ListsService", "the v3 lists endpoints", []string{"list.go"}},
+	{"ListsV4Service", "the v4 lists endpoints", []string{"list_v4.go"}},
+	{"MoviesService", "the movies endpoints", []string{"movies.go"}},
+	{"NetworksService", "the networks endpoints", []string{"networks.go"}},
+	{"PeopleService", "the people endpoints", []string{"people.go"}},
+	{"ReviewsService", "the reviews endpoints", []string{"reviews.go"}},
+	{"SearchService", "the search endpoints", []string{"search.go"}},
+	{"TrendingService", "the trending endpoints", []string{"trending.go"}},
+	{"TVService", "the TV series and episode groups endpoints", []string{"tv.go", "tv_episode_groups.go"}},
+	{"TVEpisodesService", "the TV episodes endpoints", []string{"tv_episodes.go"}},
+	{"TVSeasonsService", "the TV seasons endpoints", []string{"tv_seasons.go"}},
+	{"WatchProvidersService", "the watch providers endpoints", []string{"providers.go"}},
+}
+
+const header = "// Code generated by servicegen. DO NOT EDIT.\n\n"
+
+func main() {
+	files, err := generate(".")
+	if err != nil {
+		log.Fatal(err)
+	}
+	for name, b := range files {
+		if err := os.WriteFile(name, b, 0o644); err != nil {
+			log.Fatal(err)
+		}
+	}
+}
+
+// method is a Client method of a service.
+type method struct {
+	name    string
+	doc     []string
+	params  []param
+	results []string
+	// seq is the yielded type of a method returning
+	// an iter.Seq2 of it and an error.
+	seq      string
+	variadic bool
+}
+
+type param struct {
+	name string
+	typ  string
+}
+
+// generate returns the content of the generated files in dir.
+func generate(dir string) (map[string][]byte, error) {
+	fset := token.NewFileSet()
+	imports := map[string]string{}
+	methods := map[string][]method{}
+	for _, s := range services {
+		for _, name := range s.files {
+			file, err := parser.ParseFile(fset, filepath.Join(dir, name), nil, parser.ParseComments)
+			if err != nil {
+				return nil, err
+			}
+			for _, spec := range file.Imports {
+				path, _ := strconv.Unquote(spec.Path.Value)
+				local := path[strings.LastIndex(path, "/")+1:]
+				if spec.Name != nil {
+					local = spec.Name.Name
+				}
+				imports[local] = path
+			}
+			for _, decl := range file.Decls {
+				fn, ok := decl.(*ast.FuncDecl)
+				if !ok || !clientMethod(fn) {
+					continue
+				}
+				m, err := newMethod(fset, fn)
+				if err != nil {
+					return nil, err
+				}
+				methods[s.name] = append(methods[s.name], m)
+			}
+		}
+	}
+	var servicesFile, mocksFile bytes.Buffer
+	used := map[string]bool{}
+	for _, s := range services {
+		for _, m := range methods[s.name] {
+			for _, p := range m.params {
+				usePackages(used, p.typ)
+			}
+			for _, r := range m.results {
+				usePackages(used, r)
+			}
+		}
+	}
+	writeHeader(&servicesFile, imports, used, nil)
+	writeHeader(&mocksFile, imports, used, []string{"context", "fmt"})
+	fmt.Fprintf(&servicesFile, "// API is the interface of all the services, satisfied by *Client.\n")
+	fmt.Fprintf(&servicesFile, "type API interface {\n")
+	for _, s := range services {
+		fmt.Fprintf(&servicesFile, "\t%s\n", s.name)
+	}
+	fmt.Fprintf(&servicesFile, "}\n\nvar _ API = (*Client)(nil)\n")
+	for _, s := range services {
+		writeService(&servicesFile, s, methods[s.name])
+		writeMock(&mocksFile, s, methods[s.name])
+	}
+	files := map[string][]byte{}
+	for name, buf := range map[string]*bytes.Buffer{
+		"services_gen.go": &servicesFile,
+		"mocks_gen.go":    &mocksFile,
+	} {
+		b, err := format.Source(buf.Bytes())
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+		files[filepath.Join(dir, name)] = b
+	}
+	return files, nil
+}
+
+// clientMethod reports whether fn is an exported *Client method.
+func clientMethod(fn *ast.FuncDecl) bool {
+	if fn.Recv == nil || len(fn.Recv.List) != 1 || !fn.Name.IsExported() {
+		return false
+	}
+	star, ok := fn.Recv.List[0].Type.(*ast.StarExpr)
+	if !ok {
+		return false
+	}
+	ident, ok := star.X.(*ast.Ident)
+	return ok && ident.Name == "Client"
+}
+
+func newMethod(fset *token.FileSet, fn *ast.FuncDecl) (method, error) {
+	m := method{name: fn.Name.Name}
+	if fn.Doc != nil {
+		// The first paragraph, without the API reference link.
+		for _, line := range strings.Split(strings.TrimSpace(fn.Doc.Text()), "\n") {
+			if strings.TrimSpace(line) == "" {
+				break
+			}
+			m.doc = append(m.doc, line)
+		}
+	}
+	for i, field := range fn.Type.Params.List {
+		typ := node(fset, field.Type)
+		if ellipsis, ok := field.Type.(*ast.Ellipsis); ok {
+			m.variadic = true
+			typ = "..." + node(fset, ellipsis.Elt)
+		}
+		if len(field.Names) == 0 {
+			m.params = append(m.params, param{name: fmt.Sprintf("p%d", i), typ: typ})
+		}
+		for _, name := range field.Names {
+			m.params = append(m.params, param{name: name.Name, typ: typ})
+		}
+	}
+	if fn.Type.Results != nil {
+		for _, field := range fn.Type.Results.List {
+			typ := node(fset, field.Type)
+			for range max(len(field.Names), 1) {
+				m.results = append(m.results, typ)
+			}
+		}
+	}
+	if len(m.results) == 1 {
+		if index, ok := fn.Type.Results.List[0].Type.(*ast.IndexListExpr); ok &&
+			node(fset, index.X) == "iter.Seq2" &&
+			len(index.Indices) == 2 &&
+			node(fset, index.Indices[1]) == "error" {
+			m.seq = node(fset, index.Indices[0])
+		}
+	}
+	return m, nil
+}
+
+func node(fset *token.FileSet, n ast.Node) string {
+	var buf bytes.Buffer
+	printer.Fprint(&buf, fset, n)
+	return buf.String()
+}
+
+// usePackages marks the packages referenced by typ.
+func usePackages(used map[string]bool, typ string) {
+	expr, err := parser.ParseExpr(strings.TrimPrefix(typ, "..."))
+	if err != nil {
+		return
+	}
+	ast.Inspect(expr, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok {
+			if ident, ok := sel.X.(*ast.Ident); ok {
+				used[ident.Name] = true
+			}
+		}
+		return true
+	})
+}
+
+func writeHeader(buf *bytes.Buffer, imports map[string]string, used map[string]bool, extra []string) {
+	buf.WriteString(header)
+	buf.WriteString("package tmdb\n\nimport (\n")
+	var paths []string
+	for local := range used {
+		paths = append(paths, imports[local])
+	}
+	for _, path := range extra {
+		if !slices.Contains(paths, path) {
+			paths = append(paths, path)
+		}
+	}
+	slices.Sort(paths)
+	for _, path := range paths {
+		fmt.Fprintf(buf, "\t%q\n", path)
+	}
+	buf.WriteString(")\n\n")
+}
+
+func (m method) signature() string {
+	params := make([]string, len(m.params))
+	for i, p := range m.params {
+		params[i] = p.name + " " + p.typ
+	}
+	return fmt.Sprintf("(%s) %s", strings.Join(params, ", "), m.resultList())
+}
+
+func (m method) funcType() string {
+	params := make([]string, len(m.params))
+	for i, p := range m.params {
+		params[i] = p.typ
+	}
+	return fmt.Sprintf("func(%s) %s", strings.Join(params, ", "), m.resultList())
+}
+
+func (m method) resultList() string {
+	if len(m.results) <= 1 {
+		return strings.Join(m.results, "")
+	}
+	return "(" + strings.Join(m.results, ", ") + ")"
+}
+
+func (m method) args() string {
+	args := make([]string, len(m.params))
+	for i, p := range m.params {
+		args[i] = p.name
+	}
+	list := strings.Join(args, ", ")
+	if m.variadic {
+		list += "..."
+	}
+	return list
+}
+
+func writeService(buf *bytes.Buffer, s service, methods []method) {
+	fmt.Fprintf(buf, "\n// %s is the interface of %s.\n", s.name, s.doc)
+	fmt.Fprintf(buf, "type %s interface {\n", s.name)
+	for i, m := range methods {
+		if i > 0 {
+			buf.WriteString("\n")
+		}
+		for _, line := range m.doc {
+			fmt.Fprintf(buf, "\t// %s\n", line)
+		}
+		fmt.Fprintf(buf, "\t%s%s\n", m.name, m.signature())
+	}
+	fmt.Fprintf(buf, "}\n\nvar _ %s = (*Client)(nil)\n", s.name)
+}
+
+func writeMock(buf *bytes.Buffer, s service, methods []method) {
+	mock := s.name + "Mock"
+	article := "a"
+	if strings.ContainsRune("AEIOU", rune(s.name[0])) {
+		article = "an"
+	}
+	fmt.Fprintf(buf, "\n// %s type is %s %s recording its calls.\n", mock, article, s.name)
+	fmt.Fprintf(buf, "//\n// Set the Func fields to stub the methods. A method without\n")
+	fmt.Fprintf(buf, "// a Func falls back to the Func of its WithContext variant,\n")
+	fmt.Fprintf(buf, "// then returns zero values and ErrNotStubbed.\n")
+	fmt.Fprintf(buf, "type %s struct {\n\tMockRecorder\n\n", mock)
+	byName := map[string]method{}
+	for _, m := range methods {
+		byName[m.name] = m
+		fmt.Fprintf(buf, "\t%sFunc %s\n", m.name, m.funcType())
+	}
+	fmt.Fprintf(buf, "}\n\nvar _ %s = (*%s)(nil)\n", s.name, mock)
+	for _, m := range methods {
+		fmt.Fprintf(buf, "\n// %s records the call and calls %sFunc.\n", m.name, m.name)
+		fmt.Fprintf(buf, "func (m *%s) %s%s {\n", mock, m.name, m.signature())
+		fmt.Fprintf(buf, "\tm.record(%q", m.name)
+		for _, p := range m.params {
+			fmt.Fprintf(buf, ", %s", p.name)
+		}
+		buf.WriteString(")\n")
+		fmt.Fprintf(buf, "\tif m.%sFunc != nil {\n\t\treturn m.%sFunc(%s)\n\t}\n", m.name, m.name, m.args())
+		if withContext, ok := byName[m.name+"WithContext"]; ok && len(withContext.params) == len(m.params)+1 {
+			args := "context.Background()"
+			if a := m.args(); a != "" {
+				args += ", " + a
+			}
+			fmt.Fprintf(buf, "\tif m.%sFunc != nil {\n\t\treturn m.%sFunc(%s)\n\t}\n", withContext.name, withContext.name, args)
+		}
+		writeZeroReturn(buf, s, m)
+		buf.WriteString("}\n")
+	}
+}
+
+func writeZeroReturn(buf *bytes.Buffer, s service, m method) {
+	errNotStubbed := fmt.Sprintf("fmt.Errorf(\"%%w: %s.%s\", ErrNotStubbed)", s.name, m.name)
+	switch {
+	case m.seq != "":
+		fmt.Fprintf(buf, "\treturn func(yield func(%s, error) bool) {\n", m.seq)
+		fmt.Fprintf(buf, "\t\tvar zero %s\n\t\tyield(zero, %s)\n\t}\n", m.seq, errNotStubbed)
+	case len(m.results) == 0:
+	default:
+		values := make([]string, len(m.results))
+		for i, r := range m.results {
+			if r == "error" {
+				values[i] = errNotStubbed
+				continue
+			}
+			values[i] = fmt.Sprintf("r%d", i)
+			fmt.Fprintf(buf, "\tvar r%d %s\n", i, r)
+		}
+		fmt.Fprintf(buf, "\treturn %s\n", strings.Join(values, ", "))
+	}
+}
@@ -0,0 +1,19 @@
+package main
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGeneratedFilesUpToDate(t *testing.T) {
+	files, err := generate("../..")
+	require.NoError(t, err)
+	for name, b := range files {
+		current, err := os.ReadFile(name)
+		require.NoError(t, err)
+		assert.Equal(t, string(b), string(current), "%s is stale, run go generate", name)
+	}
+}
@@ -1,6 +1,9 @@
 package tmdb
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 // KeywordDetails type is a struct for keyword JSON response.
 type KeywordDetails struct {
@@ -13,16 +16,25 @@ type KeywordDetails struct {
 // https://developers.themoviedb.org/3/keywords/get-keyword-details
 func (c *Client) GetKeywordDetails(
 	id int,
+) (*KeywordDetails, error) {
+	return c.GetKeywordDetailsWithContext(context.Background(), id)
+}
+
+// GetKeywordDetailsWithContext is like GetKeywordDetails but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetKeywordDetailsWithContext(
+	ctx context.Context,
+	id int,
 ) (*KeywordDetails, error) {
 	tmdbURL := fmt.Sprintf(
 		"%s%s%d?api_key=%s",
-		baseURL,
+		c.GetBaseURL(),
 		keywordURL,
 		id,
 		c.apiKey,
 	)
 	keywordDetails := KeywordDetails{}
-	if err := c.get(tmdbURL, &keywordDetails); err != nil {
+	if err := c.get(ctx, "GetKeywordDetails", tmdbURL, &keywordDetails); err != nil {
 		return nil, err
 	}
 	return &keywordDetails, nil
@@ -58,18 +70,28 @@ type KeywordMovies struct {
 func (c *Client) GetKeywordMovies(
 	id int,
 	urlOptions map[string]string,
+) (*KeywordMovies, error) {
+	return c.GetKeywordMoviesWithContext(context.Background(), id, urlOptions)
+}
+
+// GetKeywordMoviesWithContext is like GetKeywordMovies but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetKeywordMoviesWithContext(
+	ctx context.Context,
+	id int,
+	urlOptions map[string]string,
 ) (*KeywordMovies, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%s%d/movies?api_key=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		keywordURL,
 		id,
 		c.apiKey,
 		options,
 	)
 	keywordMovies := KeywordMovies{}
-	if err := c.get(tmdbURL, &keywordMovies); err != nil {
+	if err := c.get(ctx, "GetKeywordMovies", tmdbURL, &keywordMovies); err != nil {
 		return nil, err
 	}
 	return &keywordMovies, nil
@@ -1,6 +1,7 @@
 package tmdb
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 )
@@ -43,18 +44,28 @@ type ListDetails struct {
 func (c *Client) GetListDetails(
 	id int64,
 	urlOptions map[string]string,
+) (*ListDetails, error) {
+	return c.GetListDetailsWithContext(context.Background(), id, urlOptions)
+}
+
+// GetListDetailsWithContext is like GetListDetails but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetListDetailsWithContext(
+	ctx context.Context,
+	id int64,
+	urlOptions map[string]string,
 ) (*ListDetails, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%s%d?api_key=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		listURL,
 		id,
 		c.apiKey,
 		options,
 	)
 	ListDetails := ListDetails{}
-	if err := c.get(tmdbURL, &ListDetails); err != nil {
+	if err := c.get(ctx, "GetListDetails", tmdbURL, &ListDetails); err != nil {
 		return nil, err
 	}
 	return &ListDetails, nil
@@ -72,18 +83,28 @@ type ListItemStatus struct {
 func (c *Client) GetListItemStatus(
 	id int64,
 	urlOptions map[string]string,
+) (*ListItemStatus, error) {
+	return c.GetListItemStatusWithContext(context.Background(), id, urlOptions)
+}
+
+// GetListItemStatusWithContext is like GetListItemStatus but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetListItemStatusWithContext(
+	ctx context.Context,
+	id int64,
+	urlOptions map[string]string,
 ) (*ListItemStatus, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%s%d/item_status?api_key=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		listURL,
 		id,
 		c.apiKey,
 		options,
 	)
 	listItemStatus := ListItemStatus{}
-	if err := c.get(tmdbURL, &listItemStatus); err != nil {
+	if err := c.get(ctx, "GetListItemStatus", tmdbURL, &listItemStatus); err != nil {
 		return nil, err
 	}
 	return &listItemStatus, nil
@@ -108,15 +129,26 @@ type ListCreate struct {
 // https://developers.themoviedb.org/3/lists/create-list
 func (c *Client) CreateList(
 	list *ListCreate,
+) (*ListResponse, error) {
+	return c.CreateListWithContext(context.Background(), list)
+}
+
+// CreateListWithContext is like CreateList but uses the
+// given context for cancellation and deadlines.
+func (c *Client) CreateListWithContext(
+	ctx context.Context,
+	list *ListCreate,
 ) (*ListResponse, error) {
 	tmdbURL := fmt.Sprintf(
 		"%s/list?api_key=%s&session_id=%s",
-		baseURL,
+		c.GetBaseURL(),
 		c.apiKey,
 		c.sessionID,
 	)
 	createList := ListResponse{}
 	if err := c.request(
+		ctx,
+		"CreateList",
 		tmdbURL,
 		list,
 		http.MethodPost,
@@ -138,16 +170,28 @@ type ListMedia struct {
 func (c *Client) AddMovie(
 	listID int,
 	mediaID *ListMedia,
+) (*Response, error) {
+	return c.AddMovieWithContext(context.Background(), listID, mediaID)
+}
+
+// AddMovieWithContext is like AddMovie but uses the
+// given context for cancellation and deadlines.
+func (c *Client) AddMovieWithContext(
+	ctx context.Context,
+	listID int,
+	mediaID *ListMedia,
 ) (*Response, error) {
 	tmdbURL := fmt.Sprintf(
 		"%s/list/%d/add_item?api_key=%s&session_id=%s",
-		baseURL,
+		c.GetBaseURL(),
 		listID,
 		c.apiKey,
 		c.sessionID,
 	)
 	response := Response{}
 	if err := c.request(
+		ctx,
+		"AddMovie",
 		tmdbURL,
 		mediaID,
 		http.MethodPost,
@@ -164,16 +208,28 @@ func (c *Client) AddMovie(
 func (c *Client) RemoveMovie(
 	listID int,
 	mediaID *ListMedia,
+) (*Response, error) {
+	return c.RemoveMovieWithContext(context.Background(), listID, mediaID)
+}
+
+// RemoveMovieWithContext is like RemoveMovie but uses the
+// given context for cancellation and deadlines.
+func (c *Client) RemoveMovieWithContext(
+	ctx context.Context,
+	listID int,
+	mediaID *ListMedia,
 ) (*Response, error) {
 	tmdbURL := fmt.Sprintf(
 		"%s/list/%d/remove_item?api_key=%s&session_id=%s",
-		baseURL,
+		c.GetBaseURL(),
 		listID,
 		c.apiKey,
 		c.sessionID,
 	)
 	response := Response{}
 	if err := c.request(
+		ctx,
+		"RemoveMovie",
 		tmdbURL,
 		mediaID,
 		http.MethodPost,
@@ -190,10 +246,20 @@ func (c *Client) RemoveMovie(
 func (c *Client) ClearList(
 	listID int,
 	confirm bool,
+) (*Response, error) {
+	return c.ClearListWithContext(context.Background(), listID, confirm)
+}
+
+// ClearListWithContext is like ClearList but uses the
+// given context for cancellation and deadlines.
+func (c *Client) ClearListWithContext(
+	ctx context.Context,
+	listID int,
+	confirm bool,
 ) (*Response, error) {
 	tmdbURL := fmt.Sprintf(
 		"%s/list/%d/clear?api_key=%s&session_id=%s&confirm=%t",
-		baseURL,
+		c.GetBaseURL(),
 		listID,
 		c.apiKey,
 		c.sessionID,
@@ -201,6 +267,8 @@ func (c *Client) ClearList(
 	)
 	response := Response{}
 	if err := c.request(
+		ctx,
+		"ClearList",
 		tmdbURL,
 		listID,
 		http.MethodPost,
@@ -216,16 +284,27 @@ func (c *Client) ClearList(
 // https://developers.themoviedb.org/3/lists/delete-list
 func (c *Client) DeleteList(
 	listID int,
+) (*Response, error) {
+	return c.DeleteListWithContext(context.Background(), listID)
+}
+
+// DeleteListWithContext is like DeleteList but uses the
+// given context for cancellation and deadlines.
+func (c *Client) DeleteListWithContext(
+	ctx context.Context,
+	listID int,
 ) (*Response, error) {
 	tmdbURL := fmt.Sprintf(
 		"%s/list/%d?api_key=%s&session_id=%s",
-		baseURL,
+		c.GetBaseURL(),
 		listID,
 		c.apiKey,
 		c.sessionID,
 	)
 	response := Response{}
 	if err := c.request(
+		ctx,
+		"DeleteList",
 		tmdbURL,
 		nil,
 		http.MethodDelete,
@@ -0,0 +1,464 @@
+package tmdb
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+)
+
+// ListV4Sort type is a sort order of v4 list items.
+type ListV4Sort string
+
+// Sort orders accepted by v4 lists.
+const (
+	ListV4SortOriginalOrderAsc       ListV4Sort = "original_order.asc"
+	ListV4SortOriginalOrderDesc      ListV4Sort = "original_order.desc"
+	ListV4SortVoteAverageAsc         ListV4Sort = "vote_average.asc"
+	ListV4SortVoteAverageDesc        ListV4Sort = "vote_average.desc"
+	ListV4SortPrimaryReleaseDateAsc  ListV4Sort = "primary_release_date.asc"
+	ListV4SortPrimaryReleaseDateDesc ListV4Sort = "primary_release_date.desc"
+	ListV4SortTitleAsc               ListV4Sort = "title.asc"
+	ListV4SortTitleDesc              ListV4Sort = "title.desc"
+)
+
+// ListV4Result type is a struct for a movie or TV show of a v4 list.
+type ListV4Result struct {
+	Adult            bool     `json:"adult,omitempty"` // Movie
+	BackdropPath     string   `json:"backdrop_path"`
+	FirstAirDate     string   `json:"first_air_date,omitempty"` // TV
+	GenreIDs         []int64  `json:"genre_ids"`
+	ID               int64    `json:"id"`
+	MediaType        string   `json:"media_type"`
+	Name             string   `json:"name,omitempty"` // TV
+	OriginalLanguage string   `json:"original_language"`
+	OriginalName     string   `json:"original_name,omitempty"`  // TV
+	OriginalTitle    string   `json:"original_title,omitempty"` // Movie
+	OriginCountry    []string `json:"origin_country,omitempty"` // TV
+	Overview         string   `json:"overview"`
+	Popularity       float32  `json:"popularity"`
+	PosterPath       string   `json:"poster_path"`
+	ReleaseDate      string   `json:"release_date,omitempty"` // Movie
+	Title            string   `json:"title,omitempty"`        // Movie
+	Video            bool     `json:"video,omitempty"`        // Movie
+	VoteMetrics
+}
+
+// ListV4Details type is a struct for v4 list details JSON response.
+type ListV4Details struct {
+	AverageRating float32 `json:"average_rating"`
+	BackdropPath  string  `json:"backdrop_path"`
+	// Comments are keyed by "media_type:media_id", e.g. "movie:550".
+	Comments  map[string]string `json:"comments"`
+	CreatedBy struct {
+		AvatarPath   string `json:"avatar_path"`
+		GravatarHash string `json:"gravatar_hash"`
+		ID           string `json:"id"`
+		Name         string `json:"name"`
+		Username     string `json:"username"`
+	} `json:"created_by"`
+	Description string            `json:"description"`
+	ID          int64             `json:"id"`
+	Iso3166_1   string            `json:"iso_3166_1"`
+	Iso639_1    string            `json:"iso_639_1"`
+	Name        string            `json:"name"`
+	ObjectIDs   map[string]string `json:"object_ids"`
+	PosterPath  string            `json:"poster_path"`
+	Public      bool              `json:"public"`
+	Results     []ListV4Result    `json:"results"`
+	Revenue     int64             `json:"revenue"`
+	Runtime     int64             `json:"runtime"`
+	SortBy      string            `json:"sort_by"`
+	PaginatedResultsMeta
+}
+
+// GetListV4Details get the details of a v4 list.
+//
+// Private lists can only be accessed with the user access
+// token of their owner. Use the "sort_by" option with a
+// ListV4Sort to sort the items.
+//
+// https://developers.themoviedb.org/4/list/get-list
+func (c *Client) GetListV4Details(
+	listID int64,
+	urlOptions map[string]string,
+) (*ListV4Details, error) {
+	return c.GetListV4DetailsWithContext(
+		context.Background(),
+		listID,
+		urlOptions,
+	)
+}
+
+// GetListV4DetailsWithContext is like GetListV4Details but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetListV4DetailsWithContext(
+	ctx context.Context,
+	listID int64,
+	urlOptions map[string]string,
+) (*ListV4Details, error) {
+	options := c.fmtOptionsV4(urlOptions)
+	tmdbURL := fmt.Sprintf(
+		"%s%s%d%s",
+		c.GetBaseURLV4(),
+		listURL,
+		listID,
+		options,
+	)
+	listDetails := ListV4Details{}
+	if err := c.get(ctx, "GetListV4Details", tmdbURL, &listDetails); err != nil {
+		return nil, err
+	}
+	return &listDetails, nil
+}
+
+// AllListV4Items returns an iterator over the items of a
+// v4 list across all of its pages.
+func (c *Client) AllListV4Items(
+	ctx context.Context,
+	listID int64,
+	urlOptions map[string]string,
+	opts PaginateOptions,
+) iter.Seq2[ListV4Result, error] {
+	return paginateOptions(
+		ctx,
+		func(ctx context.Context, urlOptions map[string]string) (*ListV4Details, error) {
+			return c.GetListV4DetailsWithContext(ctx, listID, urlOptions)
+		},
+		urlOptions,
+		func(list *ListV4Details) []ListV4Result {
+			return list.Results
+		},
+		opts,
+	)
+}
+
+// ListV4ItemStatus type is a struct for v4 item status JSON response.
+type ListV4ItemStatus struct {
+	StatusMessage string `json:"status_message"`
+	ID            int64  `json:"id"`
+	MediaID       int64  `json:"media_id"`
+	MediaType     string `json:"media_type"`
+	Success       bool   `json:"success"`
+	StatusCode    int    `json:"status_code"`
+}
+
+// GetListV4ItemStatus check if an item is on a v4 list.
+// The mediaType is either "movie" or "tv".
+//
+// https://developers.themoviedb.org/4/list/check-item-status
+func (c *Client) GetListV4ItemStatus(
+	listID int64,
+	mediaID int64,
+	mediaType string,
+) (*ListV4ItemStatus, error) {
+	return c.GetListV4ItemStatusWithContext(
+		context.Background(),
+		listID,
+		mediaID,
+		mediaType,
+	)
+}
+
+// GetListV4ItemStatusWithContext is like GetListV4ItemStatus but
+// uses the given context for cancellation and deadlines.
+func (c *Client) GetListV4ItemStatusWithContext(
+	ctx context.Context,
+	listID int64,
+	mediaID int64,
+	mediaType string,
+) (*ListV4ItemStatus, error) {
+	tmdbURL := fmt.Sprintf(
+		"%s%s%d/item_status?media_id=%d&media_type=%s",
+		c.GetBaseURLV4(),
+		listURL,
+		listID,
+		mediaID,
+		url.QueryEscape(mediaType),
+	)
+	itemStatus := ListV4ItemStatus{}
+	if err := c.get(ctx, "GetListV4ItemStatus", tmdbURL, &itemStatus); err != nil {
+		return nil, err
+	}
+	return &itemStatus, nil
+}
+
+// ListV4Response type is a struct for v4 list JSON response.
+type ListV4Response struct {
+	StatusMessage string `json:"status_message"`
+	ID            int64  `json:"id"`
+	Success       bool   `json:"success"`
+	StatusCode    int    `json:"status_code"`
+}
+
+// ListV4Create type is a struct for v4 list creation JSON request.
+type ListV4Create struct {
+	Name        string `json:"name"`
+	Iso639_1    string `json:"iso_639_1"`
+	Iso3166_1   string `json:"iso_3166_1,omitempty"`
+	Description string `json:"description,omitempty"`
+	Public      bool   `json:"public"`
+}
+
+// CreateListV4 creates a v4 list.
+//
+// https://developers.themoviedb.org/4/list/create-list
+func (c *Client) CreateListV4(
+	list *ListV4Create,
+) (*ListV4Response, error) {
+	return c.CreateListV4WithContext(context.Background(), list)
+}
+
+// CreateListV4WithContext is like CreateListV4 but uses the
+// given context for cancellation and deadlines.
+func (c *Client) CreateListV4WithContext(
+	ctx context.Context,
+	list *ListV4Create,
+) (*ListV4Response, error) {
+	tmdbURL := fmt.Sprintf(
+		"%s/list",
+		c.GetBaseURLV4(),
+	)
+	response := ListV4Response{}
+	if err := c.request(
+		ctx,
+		"CreateListV4",
+		tmdbURL,
+		list,
+		http.MethodPost,
+		&response,
+	); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// ListV4Update type is a struct for v4 list update JSON request.
+// Empty fields are left unchanged.
+type ListV4Update struct {
+	Name        string     `json:"name,omitempty"`
+	Description string     `json:"description,omitempty"`
+	Public      *bool      `json:"public,omitempty"`
+	SortBy      ListV4Sort `json:"sort_by,omitempty"`
+}
+
+// UpdateListV4 updates the details of a v4 list.
+//
+// https://developers.themoviedb.org/4/list/update-list
+func (c *Client) UpdateListV4(
+	listID int64,
+	list *ListV4Update,
+) (*ListV4Response, error) {
+	return c.UpdateListV4WithContext(context.Background(), listID, list)
+}
+
+// UpdateListV4WithContext is like UpdateListV4 but uses the
+// given context for cancellation and deadlines.
+func (c *Client) UpdateListV4WithContext(
+	ctx context.Context,
+	listID int64,
+	list *ListV4Update,
+) (*ListV4Response, error) {
+	tmdbURL := fmt.Sprintf(
+		"%s%s%d",
+		c.GetBaseURLV4(),
+		listURL,
+		listID,
+	)
+	response := ListV4Response{}
+	if err := c.request(
+		ctx,
+		"UpdateListV4",
+		tmdbURL,
+		list,
+		http.MethodPut,
+		&response,
+	); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// ListV4ClearResponse type is a struct for v4 list clear JSON response.
+type ListV4ClearResponse struct {
+	ListV4Response
+	ItemsDeleted int64 `json:"items_deleted"`
+}
+
+// ClearListV4 clear all of the items from a v4 list.
+//
+// https://developers.themoviedb.org/4/list/clear-list
+func (c *Client) ClearListV4(
+	listID int64,
+) (*ListV4ClearResponse, error) {
+	return c.ClearListV4WithContext(context.Background(), listID)
+}
+
+// ClearListV4WithContext is like ClearListV4 but uses the
+// given context for cancellation and deadlines.
+func (c *Client) ClearListV4WithContext(
+	ctx context.Context,
+	listID int64,
+) (*ListV4ClearResponse, error) {
+	tmdbURL := fmt.Sprintf(
+		"%s%s%d/clear",
+		c.GetBaseURLV4(),
+		listURL,
+		listID,
+	)
+	response := ListV4ClearResponse{}
+	if err := c.get(ctx, "ClearListV4", tmdbURL, &response); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// DeleteListV4 deletes a v4 list.
+//
+// https://developers.themoviedb.org/4/list/delete-list
+func (c *Client) DeleteListV4(
+	listID int64,
+) (*ListV4Response, error) {
+	return c.DeleteListV4WithContext(context.Background(), listID)
+}
+
+// DeleteListV4WithContext is like DeleteListV4 but uses the
+// given context for cancellation and deadlines.
+func (c *Client) DeleteListV4WithContext(
+	ctx context.Context,
+	listID int64,
+) (*ListV4Response, error) {
+	tmdbURL := fmt.Sprintf(
+		"%s%s%d",
+		c.GetBaseURLV4(),
+		listURL,
+		listID,
+	)
+	response := ListV4Response{}
+	if err := c.request(
+		ctx,
+		"DeleteListV4",
+		tmdbURL,
+		nil,
+		http.MethodDelete,
+		&response,
+	); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// ListV4Item type is a struct for a v4 list item JSON request.
+// The MediaType is either "movie" or "tv". The Comment is
+// only used by UpdateListV4Items.
+type ListV4Item struct {
+	MediaType string `json:"media_type"`
+	MediaID   int64  `json:"media_id"`
+	Comment   string `json:"comment,omitempty"`
+}
+
+// ListV4ItemsResponse type is a struct for v4 list items JSON response.
+type ListV4ItemsResponse struct {
+	StatusMessage string `json:"status_message"`
+	Results       []struct {
+		MediaType string `json:"media_type"`
+		MediaID   int64  `json:"media_id"`
+		Success   bool   `json:"success"`
+	} `json:"results"`
+	Success    bool `json:"success"`
+	StatusCode int  `json:"status_code"`
+}
+
+// AddListV4Items add movies and TV shows to a v4 list.
+//
+// The results report the success of every item.
+//
+// https://developers.themoviedb.org/4/list/add-items
+func (c *Client) AddListV4Items(
+	listID int64,
+	items []ListV4Item,
+) (*ListV4ItemsResponse, error) {
+	return c.AddListV4ItemsWithContext(context.Background(), listID, items)
+}
+
+// AddListV4ItemsWithContext is like AddListV4Items but uses the
+// given context for cancellation and deadlines.
+func (c *Client) AddListV4ItemsWithContext(
+	ctx context.Context,
+	listID int64,
+	items []ListV4Item,
+) (*ListV4ItemsResponse, error) {
+	return c.listV4Items(ctx, "AddListV4Items", listID, items, http.MethodPost)
+}
+
+// UpdateListV4Items update the comments of items on a v4 list.
+//
+// https://developers.themoviedb.org/4/list/update-items
+func (c *Client) UpdateListV4Items(
+	listID int64,
+	items []ListV4Item,
+) (*ListV4ItemsResponse, error) {
+	return c.UpdateListV4ItemsWithContext(context.Background(), listID, items)
+}
+
+// UpdateListV4ItemsWithContext is like UpdateListV4Items but uses the
+// given context for cancellation and deadlines.
+func (c *Client) UpdateListV4ItemsWithContext(
+	ctx context.Context,
+	listID int64,
+	items []ListV4Item,
+) (*ListV4ItemsResponse, error) {
+	return c.listV4Items(ctx, "UpdateListV4Items", listID, items, http.MethodPut)
+}
+
+// RemoveListV4Items remove movies and TV shows from a v4 list.
+//
+// https://developers.themoviedb.org/4/list/remove-items
+func (c *Client) RemoveListV4Items(
+	listID int64,
+	items []ListV4Item,
+) (*ListV4ItemsResponse, error) {
+	return c.RemoveListV4ItemsWithContext(context.Background(), listID, items)
+}
+
+// RemoveListV4ItemsWithContext is like RemoveListV4Items but uses the
+// given context for cancellation and deadlines.
+func (c *Client) RemoveListV4ItemsWithContext(
+	ctx context.Context,
+	listID int64,
+	items []ListV4Item,
+) (*ListV4ItemsResponse, error) {
+	return c.listV4Items(ctx, "RemoveListV4Items", listID, items, http.MethodDelete)
+}
+
+// listV4Items sends a batch of items to the v4 list items endpoint.
+func (c *Client) listV4Items(
+	ctx context.Context,
+	endpoint string,
+	listID int64,
+	items []ListV4Item,
+	method string,
+) (*ListV4ItemsResponse, error) {
+	tmdbURL := fmt.Sprintf(
+		"%s%s%d/items",
+		c.GetBaseURLV4(),
+		listURL,
+		listID,
+	)
+	body := struct {
+		Items []ListV4Item `json:"items"`
+	}{items}
+	response := ListV4ItemsResponse{}
+	if err := c.request(
+		ctx,
+		endpoint,
+		tmdbURL,
+		body,
+		method,
+		&response,
+	); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
@@ -0,0 +1,128 @@
+package tmdb
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+)
+
+func listV4Routes(w http.ResponseWriter, r *http.Request) {
+	switch r.URL.Path {
+	case "/4/list":
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"status_message":"The item/record was created successfully.","id":7,"success":true,"status_code":1}`))
+	case "/4/list/7":
+		if r.Method != http.MethodGet {
+			w.Write([]byte(`{"status_message":"Success.","success":true,"status_code":1}`))
+			return
+		}
+		page, _ := strconv.Atoi(r.URL.Query().Get("page"))
+		page = max(page, 1)
+		w.Write([]byte(`{"id":7,"name":"Mixed","public":false,"sort_by":"title.asc","comments":{"movie:424783":"Robots","tv:1399":null},"page":` + strconv.Itoa(page) + `,"total_pages":2,"total_results":3,"results":[{"id":` + strconv.Itoa(page) + `,"media_type":"movie","title":"Movie"},{"id":1399,"media_type":"tv","name":"Show"}]}`))
+	case "/4/list/7/items":
+		w.Write([]byte(`{"status_message":"Success.","results":[{"media_type":"movie","media_id":424783,"success":true},{"media_type":"tv","media_id":1399,"success":false}],"success":true,"status_code":1}`))
+	case "/4/list/7/item_status":
+		w.Write([]byte(`{"status_message":"Success.","id":7,"media_id":424783,"media_type":"movie","success":true,"status_code":1}`))
+	case "/4/list/7/clear":
+		w.Write([]byte(`{"items_deleted":2,"status_message":"Success.","id":7,"status_code":1,"success":true}`))
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func (suite *TMBDTestSuite) TestCreateAndUpdateListV4() {
+	var requests []string
+	c, closer := suite.newV4Client("user", "user", &requests, listV4Routes)
+	defer closer()
+	list, err := c.CreateListV4(&ListV4Create{Name: "Mixed", Iso639_1: "en"})
+	suite.Nil(err)
+	suite.Equal(int64(7), list.ID)
+	public := false
+	_, err = c.UpdateListV4(list.ID, &ListV4Update{
+		Public: &public,
+		SortBy: ListV4SortTitleAsc,
+	})
+	suite.Nil(err)
+	_, err = c.DeleteListV4(list.ID)
+	suite.Nil(err)
+	suite.Equal([]string{
+		`POST /4/list {"iso_639_1":"en","name":"Mixed","public":false}`,
+		`PUT /4/list/7 {"public":false,"sort_by":"title.asc"}`,
+		`DELETE /4/list/7 null`,
+	}, requests)
+}
+
+func (suite *TMBDTestSuite) TestListV4Items() {
+	var requests []string
+	c, closer := suite.newV4Client("user", "user", &requests, listV4Routes)
+	defer closer()
+	items := []ListV4Item{
+		{MediaType: "movie", MediaID: bumblebeeID},
+		{MediaType: "tv", MediaID: 1399, Comment: "Winter"},
+	}
+	added, err := c.AddListV4Items(7, items)
+	suite.Nil(err)
+	suite.True(added.Results[0].Success)
+	suite.False(added.Results[1].Success)
+	_, err = c.UpdateListV4Items(7, items)
+	suite.Nil(err)
+	_, err = c.RemoveListV4Items(7, items[:1])
+	suite.Nil(err)
+	status, err := c.GetListV4ItemStatus(7, bumblebeeID, "movie")
+	suite.Nil(err)
+	suite.True(status.Success)
+	cleared, err := c.ClearListV4(7)
+	suite.Nil(err)
+	suite.Equal(int64(2), cleared.ItemsDeleted)
+	suite.Equal([]string{
+		`POST /4/list/7/items {"items":[{"media_id":424783,"media_type":"movie"},{"comment":"Winter","media_id":1399,"media_type":"tv"}]}`,
+		`PUT /4/list/7/items {"items":[{"media_id":424783,"media_type":"movie"},{"comment":"Winter","media_id":1399,"media_type":"tv"}]}`,
+		`DELETE /4/list/7/items {"items":[{"media_id":424783,"media_type":"movie"}]}`,
+		`GET /4/list/7/item_status?media_id=424783&media_type=movie`,
+		`GET /4/list/7/clear`,
+	}, requests)
+}
+
+func (suite *TMBDTestSuite) TestGetListV4ItemStatusEscapesMediaType() {
+	var requests []string
+	c, closer := suite.newV4Client("user", "user", &requests, listV4Routes)
+	defer closer()
+	_, err := c.GetListV4ItemStatus(7, bumblebeeID, "tv&media_id=1")
+	suite.Nil(err)
+	suite.Equal([]string{
+		`GET /4/list/7/item_status?media_id=424783&media_type=tv%26media_id%3D1`,
+	}, requests)
+}
+
+func (suite *TMBDTestSuite) TestGetListV4Details() {
+	var requests []string
+	c, closer := suite.newV4Client("user", "user", &requests, listV4Routes)
+	defer closer()
+	list, err := c.GetListV4Details(7, map[string]string{"sort_by": "title.asc"})
+	suite.Nil(err)
+	suite.Equal("Mixed", list.Name)
+	suite.Equal("Robots", list.Comments["movie:424783"])
+	suite.Equal("tv", list.Results[1].MediaType)
+	suite.Equal(int64(2), list.TotalPages)
+	suite.Equal(`GET /4/list/7?sort_by=title.asc`, requests[0])
+}
+
+func (suite *TMBDTestSuite) TestAllListV4Items() {
+	var requests []string
+	c, closer := suite.newV4Client("user", "user", &requests, listV4Routes)
+	defer closer()
+	ids := []int64{}
+	for item, err := range c.AllListV4Items(context.Background(), 7, nil, PaginateOptions{}) {
+		suite.Nil(err)
+		ids = append(ids, item.ID)
+	}
+	suite.Equal([]int64{1, 1399, 2, 1399}, ids)
+}
+
+func (suite *TMBDTestSuite) TestListV4Unauthorized() {
+	var requests []string
+	c, closer := suite.newV4Client("read", "user", &requests, listV4Routes)
+	defer closer()
+	_, err := c.CreateListV4(&ListV4Create{Name: "Mixed", Iso639_1: "en"})
+	suite.Contains(err.Error(), "code: 3")
+}
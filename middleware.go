@@ -0,0 +1,89 @@
+package tmdb
+
+import (
+	"net/http"
+	"net/url"
+)
+
+// Request type is a struct for a single TMDb API call
+// as seen by the middlewares.
+type Request struct {
+	// Endpoint is the name of the Client method that
+	// issued the call, e.g. "GetMovieDetails".
+	Endpoint string
+	// Params holds the resolved query parameters of the
+	// call, without credentials like api_key and session_id.
+	Params url.Values
+	// HTTP is the underlying http request.
+	HTTP *http.Request
+}
+
+// Doer sends a Request and returns its http response.
+//
+// When the TMDb API answers with a status outside of the 2xx
+// range, except 304 Not Modified, the response is returned along
+// with the decoded *APIError.
+// A nil response must only be returned alongside a non-nil error.
+type Doer interface {
+	Do(req *Request) (*http.Response, error)
+}
+
+// DoerFunc type is an adapter to allow the use of
+// ordinary functions as a Doer.
+type DoerFunc func(req *Request) (*http.Response, error)
+
+// Do calls f(req).
+func (f DoerFunc) Do(req *Request) (*http.Response, error) {
+	return f(req)
+}
+
+// Middleware wraps a Doer with additional behavior like
+// logging, header injection or metrics.
+type Middleware func(next Doer) Doer
+
+// Use appends middlewares to the client chain.
+//
+// Middlewares are applied in the order they are added, so the
+// first one is the outermost. They wrap every attempt made by
+// the client, including the auto retry ones.
+//
+// Use is not safe to call while requests are in flight.
+func (c *Client) Use(middlewares ...Middleware) {
+	c.middlewares = append(c.middlewares, middlewares...)
+}
+
+// credentialParams are the query parameters hidden from middlewares.
+var credentialParams = []string{"api_key", "session_id", "guest_session_id"}
+
+// send runs the request through the middleware chain.
+func (c *Client) send(endpoint string, req *http.Request) (*http.Response, error) {
+	params := req.URL.Query()
+	for _, key := range credentialParams {
+		params.Del(key)
+	}
+	var doer Doer = DoerFunc(c.do)
+	for i := len(c.middlewares) - 1; i >= 0; i-- {
+		doer = c.middlewares[i](doer)
+	}
+	return doer.Do(&Request{
+		Endpoint: endpoint,
+		Params:   params,
+		HTTP:     req,
+	})
+}
+
+// do is the innermost Doer of the middleware chain.
+func (c *Client) do(req *Request) (*http.Response, error) {
+	res, err := c.http.Do(req.HTTP)
+	if err != nil {
+		return nil, err
+	}
+	if res.StatusCode == http.StatusNotModified {
+		return res, nil
+	}
+	if res.StatusCode < http.StatusOK ||
+		res.StatusCode >= http.StatusMultipleChoices {
+		return res, c.decodeError(res)
+	}
+	return res, nil
+}
@@ -0,0 +1,69 @@
+package tmdb
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+)
+
+func (suite *TMBDTestSuite) TestMiddlewareChain() {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		suite.Equal("golang-tmdb", r.Header.Get("X-Client"))
+		w.Write([]byte(`{"id":424783,"title":"Bumblebee"}`))
+	}))
+	defer ts.Close()
+	c, _ := Init(apiKey)
+	c.SetSessionID(sessionID)
+	c.SetCustomBaseURL(ts.URL)
+	var calls []string
+	var got *Request
+	c.Use(
+		func(next Doer) Doer {
+			return DoerFunc(func(req *Request) (*http.Response, error) {
+				calls = append(calls, "outer")
+				got = req
+				return next.Do(req)
+			})
+		},
+		func(next Doer) Doer {
+			return DoerFunc(func(req *Request) (*http.Response, error) {
+				calls = append(calls, "inner")
+				req.HTTP.Header.Set("X-Client", "golang-tmdb")
+				return next.Do(req)
+			})
+		},
+	)
+	movie, err := c.GetMovieDetails(bumblebeeID, map[string]string{"language": "pt-BR"})
+	suite.Nil(err)
+	suite.Equal("Bumblebee", movie.Title)
+	suite.Equal([]string{"outer", "inner"}, calls)
+	suite.Equal("GetMovieDetails", got.Endpoint)
+	suite.Equal("pt-BR", got.Params.Get("language"))
+	suite.False(got.Params.Has("api_key"))
+}
+
+func (suite *TMBDTestSuite) TestMiddlewareObservesError() {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+		w.Write([]byte(`{"status_code":34,"status_message":"The resource you requested could not be found.","success":false}`))
+	}))
+	defer ts.Close()
+	c, _ := Init(apiKey)
+	c.SetCustomBaseURL(ts.URL)
+	var observed Error
+	var status int
+	c.Use(func(next Doer) Doer {
+		return DoerFunc(func(req *Request) (*http.Response, error) {
+			res, err := next.Do(req)
+			errors.As(err, &observed)
+			if res != nil {
+				status = res.StatusCode
+			}
+			return res, err
+		})
+	})
+	_, err := c.CreateList(&ListCreate{Name: "Test"})
+	suite.Error(err)
+	suite.Equal(34, observed.StatusCode)
+	suite.Equal(http.StatusNotFound, status)
+}
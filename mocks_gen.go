@@ -0,0 +1,4648 @@
+// Code generated by servicegen. DO NOT EDIT.
+
+package tmdb
+
+import (
+	"context"
+	"fmt"
+	"iter"
+)
+
+// AccountServiceMock type is an AccountService recording its calls.
+//
+// Set the Func fields to stub the methods. A method without
+// a Func falls back to the Func of its WithContext variant,
+// then returns zero values and ErrNotStubbed.
+type AccountServiceMock struct {
+	MockRecorder
+
+	GetAccountDetailsFunc              func() (*AccountDetails, error)
+	GetAccountDetailsWithContextFunc   func(context.Context) (*AccountDetails, error)
+	GetCreatedListsFunc                func(int, map[string]string) (*AccountCreatedLists, error)
+	GetCreatedListsWithContextFunc     func(context.Context, int, map[string]string) (*AccountCreatedLists, error)
+	GetFavoriteMoviesFunc              func(int, map[string]string) (*AccountFavoriteMovies, error)
+	GetFavoriteMoviesWithContextFunc   func(context.Context, int, map[string]string) (*AccountFavoriteMovies, error)
+	GetFavoriteTVShowsFunc             func(int, map[string]string) (*AccountFavoriteTVShows, error)
+	GetFavoriteTVShowsWithContextFunc  func(context.Context, int, map[string]string) (*AccountFavoriteTVShows, error)
+	MarkAsFavoriteFunc                 func(int, *AccountFavorite) (*Response, error)
+	MarkAsFavoriteWithContextFunc      func(context.Context, int, *AccountFavorite) (*Response, error)
+	GetRatedMoviesFunc                 func(int, map[string]string) (*AccountRatedMovies, error)
+	GetRatedMoviesWithContextFunc      func(context.Context, int, map[string]string) (*AccountRatedMovies, error)
+	GetRatedTVShowsFunc                func(int, map[string]string) (*AccountRatedTVShows, error)
+	GetRatedTVShowsWithContextFunc     func(context.Context, int, map[string]string) (*AccountRatedTVShows, error)
+	GetRatedTVEpisodesFunc             func(int, map[string]string) (*AccountRatedTVEpisodes, error)
+	GetRatedTVEpisodesWithContextFunc  func(context.Context, int, map[string]string) (*AccountRatedTVEpisodes, error)
+	GetMovieWatchlistFunc              func(int, map[string]string) (*AccountMovieWatchlist, error)
+	GetMovieWatchlistWithContextFunc   func(context.Context, int, map[string]string) (*AccountMovieWatchlist, error)
+	GetTVShowsWatchlistFunc            func(int, map[string]string) (*AccountTVShowsWatchlist, error)
+	GetTVShowsWatchlistWithContextFunc func(context.Context, int, map[string]string) (*AccountTVShowsWatchlist, error)
+	AddToWatchlistFunc                 func(int, *AccountWatchlist) (*Response, error)
+	AddToWatchlistWithContextFunc      func(context.Context, int, *AccountWatchlist) (*Response, error)
+}
+
+var _ AccountService = (*AccountServiceMock)(nil)
+
+// GetAccountDetails records the call and calls GetAccountDetailsFunc.
+func (m *AccountServiceMock) GetAccountDetails() (*AccountDetails, error) {
+	m.record("GetAccountDetails")
+	if m.GetAccountDetailsFunc != nil {
+		return m.GetAccountDetailsFunc()
+	}
+	if m.GetAccountDetailsWithContextFunc != nil {
+		return m.GetAccountDetailsWithContextFunc(context.Background())
+	}
+	var r0 *AccountDetails
+	return r0, fmt.Errorf("%w: AccountService.GetAccountDetails", ErrNotStubbed)
+}
+
+// GetAccountDetailsWithContext records the call and calls GetAccountDetailsWithContextFunc.
+func (m *AccountServiceMock) GetAccountDetailsWithContext(ctx context.Context) (*AccountDetails, error) {
+	m.record("GetAccountDetailsWithContext", ctx)
+	if m.GetAccountDetailsWithContextFunc != nil {
+		return m.GetAccountDetailsWithContextFunc(ctx)
+	}
+	var r0 *AccountDetails
+	return r0, fmt.Errorf("%w: AccountService.GetAccountDetailsWithContext", ErrNotStubbed)
+}
+
+// GetCreatedLists records the call and calls GetCreatedListsFunc.
+func (m *AccountServiceMock) GetCreatedLists(id int, urlOptions map[string]string) (*AccountCreatedLists, error) {
+	m.record("GetCreatedLists", id, urlOptions)
+	if m.GetCreatedListsFunc != nil {
+		return m.GetCreatedListsFunc(id, urlOptions)
+	}
+	if m.GetCreatedListsWithContextFunc != nil {
+		return m.GetCreatedListsWithContextFunc(context.Background(), id, urlOptions)
+	}
+	var r0 *AccountCreatedLists
+	return r0, fmt.Errorf("%w: AccountService.GetCreatedLists", ErrNotStubbed)
+}
+
+// GetCreatedListsWithContext records the call and calls GetCreatedListsWithContextFunc.
+func (m *AccountServiceMock) GetCreatedListsWithContext(ctx context.Context, id int, urlOptions map[string]string) (*AccountCreatedLists, error) {
+	m.record("GetCreatedListsWithContext", ctx, id, urlOptions)
+	if m.GetCreatedListsWithContextFunc != nil {
+		return m.GetCreatedListsWithContextFunc(ctx, id, urlOptions)
+	}
+	var r0 *AccountCreatedLists
+	return r0, fmt.Errorf("%w: AccountService.GetCreatedListsWithContext", ErrNotStubbed)
+}
+
+// GetFavoriteMovies records the call and calls GetFavoriteMoviesFunc.
+func (m *AccountServiceMock) GetFavoriteMovies(id int, urlOptions map[string]string) (*AccountFavoriteMovies, error) {
+	m.record("GetFavoriteMovies", id, urlOptions)
+	if m.GetFavoriteMoviesFunc != nil {
+		return m.GetFavoriteMoviesFunc(id, urlOptions)
+	}
+	if m.GetFavoriteMoviesWithContextFunc != nil {
+		return m.GetFavoriteMoviesWithContextFunc(context.Background(), id, urlOptions)
+	}
+	var r0 *AccountFavoriteMovies
+	return r0, fmt.Errorf("%w: AccountService.GetFavoriteMovies", ErrNotStubbed)
+}
+
+// GetFavoriteMoviesWithContext records the call and calls GetFavoriteMoviesWithContextFunc.
+func (m *AccountServiceMock) GetFavoriteMoviesWithContext(ctx context.Context, id int, urlOptions map[string]string) (*AccountFavoriteMovies, error) {
+	m.record("GetFavoriteMoviesWithContext", ctx, id, urlOptions)
+	if m.GetFavoriteMoviesWithContextFunc != nil {
+		return m.GetFavoriteMoviesWithContextFunc(ctx, id, urlOptions)
+	}
+	var r0 *AccountFavoriteMovies
+	return r0, fmt.Errorf("%w: AccountService.GetFavoriteMoviesWithContext", ErrNotStubbed)
+}
+
+// GetFavoriteTVShows records the call and calls GetFavoriteTVShowsFunc.
+func (m *AccountServiceMock) GetFavoriteTVShows(id int, urlOptions map[string]string) (*AccountFavoriteTVShows, error) {
+	m.record("GetFavoriteTVShows", id, urlOptions)
+	if m.GetFavoriteTVShowsFunc != nil {
+		return m.GetFavoriteTVShowsFunc(id, urlOptions)
+	}
+	if m.GetFavoriteTVShowsWithContextFunc != nil {
+		return m.GetFavoriteTVShowsWithContextFunc(context.Background(), id, urlOptions)
+	}
+	var r0 *AccountFavoriteTVShows
+	return r0, fmt.Errorf("%w: AccountService.GetFavoriteTVShows", ErrNotStubbed)
+}
+
+// GetFavoriteTVShowsWithContext records the call and calls GetFavoriteTVShowsWithContextFunc.
+func (m *AccountServiceMock) GetFavoriteTVShowsWithContext(ctx context.Context, id int, urlOptions map[string]string) (*AccountFavoriteTVShows, error) {
+	m.record("GetFavoriteTVShowsWithContext", ctx, id, urlOptions)
+	if m.GetFavoriteTVShowsWithContextFunc != nil {
+		return m.GetFavoriteTVShowsWithContextFunc(ctx, id, urlOptions)
+	}
+	var r0 *AccountFavoriteTVShows
+	return r0, fmt.Errorf("%w: AccountService.GetFavoriteTVShowsWithContext", ErrNotStubbed)
+}
+
+// MarkAsFavorite records the call and calls MarkAsFavoriteFunc.
+func (m *AccountServiceMock) MarkAsFavorite(id int, title *AccountFavorite) (*Response, error) {
+	m.record("MarkAsFavorite", id, title)
+	if m.MarkAsFavoriteFunc != nil {
+		return m.MarkAsFavoriteFunc(id, title)
+	}
+	if m.MarkAsFavoriteWithContextFunc != nil {
+		return m.MarkAsFavoriteWithContextFunc(context.Background(), id, title)
+	}
+	var r0 *Response
+	return r0, fmt.Errorf("%w: AccountService.MarkAsFavorite", ErrNotStubbed)
+}
+
+// MarkAsFavoriteWithContext records the call and calls MarkAsFavoriteWithContextFunc.
+func (m *AccountServiceMock) MarkAsFavoriteWithContext(ctx context.Context, id int, title *AccountFavorite) (*Response, error) {
+	m.record("MarkAsFavoriteWithContext", ctx, id, title)
+	if m.MarkAsFavoriteWithContextFunc != nil {
+		return m.MarkAsFavoriteWithContextFunc(ctx, id, title)
+	}
+	var r0 *Response
+	return r0, fmt.Errorf("%w: AccountService.MarkAsFavoriteWithContext", ErrNotStubbed)
+}
+
+// GetRatedMovies records the call and calls GetRatedMoviesFunc.
+func (m *AccountServiceMock) GetRatedMovies(id int, urlOptions map[string]string) (*AccountRatedMovies, error) {
+	m.record("GetRatedMovies", id, urlOptions)
+	if m.GetRatedMoviesFunc != nil {
+		return m.GetRatedMoviesFunc(id, urlOptions)
+	}
+	if m.GetRatedMoviesWithContextFunc != nil {
+		return m.GetRatedMoviesWithContextFunc(context.Background(), id, urlOptions)
+	}
+	var r0 *AccountRatedMovies
+	return r0, fmt.Errorf("%w: AccountService.GetRatedMovies", ErrNotStubbed)
+}
+
+// GetRatedMoviesWithContext records the call and calls GetRatedMoviesWithContextFunc.
+func (m *AccountServiceMock) GetRatedMoviesWithContext(ctx context.Context, id int, urlOptions map[string]string) (*AccountRatedMovies, error) {
+	m.record("GetRatedMoviesWithContext", ctx, id, urlOptions)
+	if m.GetRatedMoviesWithContextFunc != nil {
+		return m.GetRatedMoviesWithContextFunc(ctx, id, urlOptions)
+	}
+	var r0 *AccountRatedMovies
+	return r0, fmt.Errorf("%w: AccountService.GetRatedMoviesWithContext", ErrNotStubbed)
+}
+
+// GetRatedTVShows records the call and calls GetRatedTVShowsFunc.
+func (m *AccountServiceMock) GetRatedTVShows(id int, urlOptions map[string]string) (*AccountRatedTVShows, error) {
+	m.record("GetRatedTVShows", id, urlOptions)
+	if m.GetRatedTVShowsFunc != nil {
+		return m.GetRatedTVShowsFunc(id, urlOptions)
+	}
+	if m.GetRatedTVShowsWithContextFunc != nil {
+		return m.GetRatedTVShowsWithContextFunc(context.Background(), id, urlOptions)
+	}
+	var r0 *AccountRatedTVShows
+	return r0, fmt.Errorf("%w: AccountService.GetRatedTVShows", ErrNotStubbed)
+}
+
+// GetRatedTVShowsWithContext records the call and calls GetRatedTVShowsWithContextFunc.
+func (m *AccountServiceMock) GetRatedTVShowsWithContext(ctx context.Context, id int, urlOptions map[string]string) (*AccountRatedTVShows, error) {
+	m.record("GetRatedTVShowsWithContext", ctx, id, urlOptions)
+	if m.GetRatedTVShowsWithContextFunc != nil {
+		return m.GetRatedTVShowsWithContextFunc(ctx, id, urlOptions)
+	}
+	var r0 *AccountRatedTVShows
+	return r0, fmt.Errorf("%w: AccountService.GetRatedTVShowsWithContext", ErrNotStubbed)
+}
+
+// GetRatedTVEpisodes records the call and calls GetRatedTVEpisodesFunc.
+func (m *AccountServiceMock) GetRatedTVEpisodes(id int, urlOptions map[string]string) (*AccountRatedTVEpisodes, error) {
+	m.record("GetRatedTVEpisodes", id, urlOptions)
+	if m.GetRatedTVEpisodesFunc != nil {
+		return m.GetRatedTVEpisodesFunc(id, urlOptions)
+	}
+	if m.GetRatedTVEpisodesWithContextFunc != nil {
+		return m.GetRatedTVEpisodesWithContextFunc(context.Background(), id, urlOptions)
+	}
+	var r0 *AccountRatedTVEpisodes
+	return r0, fmt.Errorf("%w: AccountService.GetRatedTVEpisodes", ErrNotStubbed)
+}
+
+// GetRatedTVEpisodesWithContext records the call and calls GetRatedTVEpisodesWithContextFunc.
+func (m *AccountServiceMock) GetRatedTVEpisodesWithContext(ctx context.Context, id int, urlOptions map[string]string) (*AccountRatedTVEpisodes, error) {
+	m.record("GetRatedTVEpisodesWithContext", ctx, id, urlOptions)
+	if m.GetRatedTVEpisodesWithContextFunc != nil {
+		return m.GetRatedTVEpisodesWithContextFunc(ctx, id, urlOptions)
+	}
+	var r0 *AccountRatedTVEpisodes
+	return r0, fmt.Errorf("%w: AccountService.GetRatedTVEpisodesWithContext", ErrNotStubbed)
+}
+
+// GetMovieWatchlist records the call and calls GetMovieWatchlistFunc.
+func (m *AccountServiceMock) GetMovieWatchlist(id int, urlOptions map[string]string) (*AccountMovieWatchlist, error) {
+	m.record("GetMovieWatchlist", id, urlOptions)
+	if m.GetMovieWatchlistFunc != nil {
+		return m.GetMovieWatchlistFunc(id, urlOptions)
+	}
+	if m.GetMovieWatchlistWithContextFunc != nil {
+		return m.GetMovieWatchlistWithContextFunc(context.Background(), id, urlOptions)
+	}
+	var r0 *AccountMovieWatchlist
+	return r0, fmt.Errorf("%w: AccountService.GetMovieWatchlist", ErrNotStubbed)
+}
+
+// GetMovieWatchlistWithContext records the call and calls GetMovieWatchlistWithContextFunc.
+func (m *AccountServiceMock) GetMovieWatchlistWithContext(ctx context.Context, id int, urlOptions map[string]string) (*AccountMovieWatchlist, error) {
+	m.record("GetMovieWatchlistWithContext", ctx, id, urlOptions)
+	if m.GetMovieWatchlistWithContextFunc != nil {
+		return m.GetMovieWatchlistWithContextFunc(ctx, id, urlOptions)
+	}
+	var r0 *AccountMovieWatchlist
+	return r0, fmt.Errorf("%w: AccountService.GetMovieWatchlistWithContext", ErrNotStubbed)
+}
+
+// GetTVShowsWatchlist records the call and calls GetTVShowsWatchlistFunc.
+func (m *AccountServiceMock) GetTVShowsWatchlist(id int, urlOptions map[string]string) (*AccountTVShowsWatchlist, error) {
+	m.record("GetTVShowsWatchlist", id, urlOptions)
+	if m.GetTVShowsWatchlistFunc != nil {
+		return m.GetTVShowsWatchlistFunc(id, urlOptions)
+	}
+	if m.GetTVShowsWatchlistWithContextFunc != nil {
+		return m.GetTVShowsWatchlistWithContextFunc(context.Background(), id, urlOptions)
+	}
+	var r0 *AccountTVShowsWatchlist
+	return r0, fmt.Errorf("%w: AccountService.GetTVShowsWatchlist", ErrNotStubbed)
+}
+
+// GetTVShowsWatchlistWithContext records the call and calls GetTVShowsWatchlistWithContextFunc.
+func (m *AccountServiceMock) GetTVShowsWatchlistWithContext(ctx context.Context, id int, urlOptions map[string]string) (*AccountTVShowsWatchlist, error) {
+	m.record("GetTVShowsWatchlistWithContext", ctx, id, urlOptions)
+	if m.GetTVShowsWatchlistWithContextFunc != nil {
+		return m.GetTVShowsWatchlistWithContextFunc(ctx, id, urlOptions)
+	}
+	var r0 *AccountTVShowsWatchlist
+	return r0, fmt.Errorf("%w: AccountService.GetTVShowsWatchlistWithContext", ErrNotStubbed)
+}
+
+// AddToWatchlist records the call and calls AddToWatchlistFunc.
+func (m *AccountServiceMock) AddToWatchlist(id int, title *AccountWatchlist) (*Response, error) {
+	m.record("AddToWatchlist", id, title)
+	if m.AddToWatchlistFunc != nil {
+		return m.AddToWatchlistFunc(id, title)
+	}
+	if m.AddToWatchlistWithContextFunc != nil {
+		return m.AddToWatchlistWithContextFunc(context.Background(), id, title)
+	}
+	var r0 *Response
+	return r0, fmt.Errorf("%w: AccountService.AddToWatchlist", ErrNotStubbed)
+}
+
+// AddToWatchlistWithContext records the call and calls AddToWatchlistWithContextFunc.
+func (m *AccountServiceMock) AddToWatchlistWithContext(ctx context.Context, id int, title *AccountWatchlist) (*Response, error) {
+	m.record("AddToWatchlistWithContext", ctx, id, title)
+	if m.AddToWatchlistWithContextFunc != nil {
+		return m.AddToWatchlistWithContextFunc(ctx, id, title)
+	}
+	var r0 *Response
+	return r0, fmt.Errorf("%w: AccountService.AddToWatchlistWithContext", ErrNotStubbed)
+}
+
+// AccountV4ServiceMock type is an AccountV4Service recording its calls.
+//
+// Set the Func fields to stub the methods. A method without
+// a Func falls back to the Func of its WithContext variant,
+// then returns zero values and ErrNotStubbed.
+type AccountV4ServiceMock struct {
+	MockRecorder
+
+	GetAccountV4ListsFunc                            func(string, map[string]string) (*AccountV4Lists, error)
+	GetAccountV4ListsWithContextFunc                 func(context.Context, string, map[string]string) (*AccountV4Lists, error)
+	AllAccountV4ListsFunc                            func(context.Context, string, map[string]string, PaginateOptions) iter.Seq2[AccountV4List, error]
+	GetAccountV4FavoriteMoviesFunc                   func(string, map[string]string) (*AccountV4Movies, error)
+	GetAccountV4FavoriteMoviesWithContextFunc        func(context.Context, string, map[string]string) (*AccountV4Movies, error)
+	AllAccountV4FavoriteMoviesFunc                   func(context.Context, string, map[string]string, PaginateOptions) iter.Seq2[AccountV4Movie, error]
+	GetAccountV4FavoriteTVShowsFunc                  func(string, map[string]string) (*AccountV4TVShows, error)
+	GetAccountV4FavoriteTVShowsWithContextFunc       func(context.Context, string, map[string]string) (*AccountV4TVShows, error)
+	AllAccountV4FavoriteTVShowsFunc                  func(context.Context, string, map[string]string, PaginateOptions) iter.Seq2[AccountV4TVShow, error]
+	GetAccountV4MovieWatchlistFunc                   func(string, map[string]string) (*AccountV4Movies, error)
+	GetAccountV4MovieWatchlistWithContextFunc        func(context.Context, string, map[string]string) (*AccountV4Movies, error)
+	AllAccountV4MovieWatchlistFunc                   func(context.Context, string, map[string]string, PaginateOptions) iter.Seq2[AccountV4Movie, error]
+	GetAccountV4TVShowWatchlistFunc                  func(string, map[string]string) (*AccountV4TVShows, error)
+	GetAccountV4TVShowWatchlistWithContextFunc       func(context.Context, string, map[string]string) (*AccountV4TVShows, error)
+	AllAccountV4TVShowWatchlistFunc                  func(context.Context, string, map[string]string, PaginateOptions) iter.Seq2[AccountV4TVShow, error]
+	GetAccountV4RatedMoviesFunc                      func(string, map[string]string) (*AccountV4Movies, error)
+	GetAccountV4RatedMoviesWithContextFunc           func(context.Context, string, map[string]string) (*AccountV4Movies, error)
+	AllAccountV4RatedMoviesFunc                      func(context.Context, string, map[string]string, PaginateOptions) iter.Seq2[AccountV4Movie, error]
+	GetAccountV4RatedTVShowsFunc                     func(string, map[string]string) (*AccountV4TVShows, error)
+	GetAccountV4RatedTVShowsWithContextFunc          func(context.Context, string, map[string]string) (*AccountV4TVShows, error)
+	AllAccountV4RatedTVShowsFunc                     func(context.Context, string, map[string]string, PaginateOptions) iter.Seq2[AccountV4TVShow, error]
+	GetAccountV4MovieRecommendationsFunc             func(string, map[string]string) (*AccountV4Movies, error)
+	GetAccountV4MovieRecommendationsWithContextFunc  func(context.Context, string, map[string]string) (*AccountV4Movies, error)
+	AllAccountV4MovieRecommendationsFunc             func(context.Context, string, map[string]string, PaginateOptions) iter.Seq2[AccountV4Movie, error]
+	GetAccountV4TVShowRecommendationsFunc            func(string, map[string]string) (*AccountV4TVShows, error)
+	GetAccountV4TVShowRecommendationsWithContextFunc func(context.Context, string, map[string]string) (*AccountV4TVShows, error)
+	AllAccountV4TVShowRecommendationsFunc            func(context.Context, string, map[string]string, PaginateOptions) iter.Seq2[AccountV4TVShow, error]
+}
+
+var _ AccountV4Service = (*AccountV4ServiceMock)(nil)
+
+// GetAccountV4Lists records the call and calls GetAccountV4ListsFunc.
+func (m *AccountV4ServiceMock) GetAccountV4Lists(accountObjectID string, urlOptions map[string]string) (*AccountV4Lists, error) {
+	m.record("GetAccountV4Lists", accountObjectID, urlOptions)
+	if m.GetAccountV4ListsFunc != nil {
+		return m.GetAccountV4ListsFunc(accountObjectID, urlOptions)
+	}
+	if m.GetAccountV4ListsWithContextFunc != nil {
+		return m.GetAccountV4ListsWithContextFunc(context.Background(), accountObjectID, urlOptions)
+	}
+	var r0 *AccountV4Lists
+	return r0, fmt.Errorf("%w: AccountV4Service.GetAccountV4Lists", ErrNotStubbed)
+}
+
+// GetAccountV4ListsWithContext records the call and calls GetAccountV4ListsWithContextFunc.
+func (m *AccountV4ServiceMock) GetAccountV4ListsWithContext(ctx context.Context, accountObjectID string, urlOptions map[string]string) (*AccountV4Lists, error) {
+	m.record("GetAccountV4ListsWithContext", ctx, accountObjectID, urlOptions)
+	if m.GetAccountV4ListsWithContextFunc != nil {
+		return m.GetAccountV4ListsWithContextFunc(ctx, accountObjectID, urlOptions)
+	}
+	var r0 *AccountV4Lists
+	return r0, fmt.Errorf("%w: AccountV4Service.GetAccountV4ListsWithContext", ErrNotStubbed)
+}
+
+// AllAccountV4Lists records the call and calls AllAccountV4ListsFunc.
+func (m *AccountV4ServiceMock) AllAccountV4Lists(ctx context.Context, accountObjectID string, urlOptions map[string]string, opts PaginateOptions) iter.Seq2[AccountV4List, error] {
+	m.record("AllAccountV4Lists", ctx, accountObjectID, urlOptions, opts)
+	if m.AllAccountV4ListsFunc != nil {
+		return m.AllAccountV4ListsFunc(ctx, accountObjectID, urlOptions, opts)
+	}
+	return func(yield func(AccountV4List, error) bool) {
+		var zero AccountV4List
+		yield(zero, fmt.Errorf("%w: AccountV4Service.AllAccountV4Lists", ErrNotStubbed))
+	}
+}
+
+// GetAccountV4FavoriteMovies records the call and calls GetAccountV4FavoriteMoviesFunc.
+func (m *AccountV4ServiceMock) GetAccountV4FavoriteMovies(accountObjectID string, urlOptions map[string]string) (*AccountV4Movies, error) {
+	m.record("GetAccountV4FavoriteMovies", accountObjectID, urlOptions)
+	if m.GetAccountV4FavoriteMoviesFunc != nil {
+		return m.GetAccountV4FavoriteMoviesFunc(accountObjectID, urlOptions)
+	}
+	if m.GetAccountV4FavoriteMoviesWithContextFunc != nil {
+		return m.GetAccountV4FavoriteMoviesWithContextFunc(context.Background(), accountObjectID, urlOptions)
+	}
+	var r0 *AccountV4Movies
+	return r0, fmt.Errorf("%w: AccountV4Service.GetAccountV4FavoriteMovies", ErrNotStubbed)
+}
+
+// GetAccountV4FavoriteMoviesWithContext records the call and calls GetAccountV4FavoriteMoviesWithContextFunc.
+func (m *AccountV4ServiceMock) GetAccountV4FavoriteMoviesWithContext(ctx context.Context, accountObjectID string, urlOptions map[string]string) (*AccountV4Movies, error) {
+	m.record("GetAccountV4FavoriteMoviesWithContext", ctx, accountObjectID, urlOptions)
+	if m.GetAccountV4FavoriteMoviesWithContextFunc != nil {
+		return m.GetAccountV4FavoriteMoviesWithContextFunc(ctx, accountObjectID, urlOptions)
+	}
+	var r0 *AccountV4Movies
+	return r0, fmt.Errorf("%w: AccountV4Service.GetAccountV4FavoriteMoviesWithContext", ErrNotStubbed)
+}
+
+// AllAccountV4FavoriteMovies records the call and calls AllAccountV4FavoriteMoviesFunc.
+func (m *AccountV4ServiceMock) AllAccountV4FavoriteMovies(ctx context.Context, accountObjectID string, urlOptions map[string]string, opts PaginateOptions) iter.Seq2[AccountV4Movie, error] {
+	m.record("AllAccountV4FavoriteMovies", ctx, accountObjectID, urlOptions, opts)
+	if m.AllAccountV4FavoriteMoviesFunc != nil {
+		return m.AllAccountV4FavoriteMoviesFunc(ctx, accountObjectID, urlOptions, opts)
+	}
+	return func(yield func(AccountV4Movie, error) bool) {
+		var zero AccountV4Movie
+		yield(zero, fmt.Errorf("%w: AccountV4Service.AllAccountV4FavoriteMovies", ErrNotStubbed))
+	}
+}
+
+// GetAccountV4FavoriteTVShows records the call and calls GetAccountV4FavoriteTVShowsFunc.
+func (m *AccountV4ServiceMock) GetAccountV4FavoriteTVShows(accountObjectID string, urlOptions map[string]string) (*AccountV4TVShows, error) {
+	m.record("GetAccountV4FavoriteTVShows", accountObjectID, urlOptions)
+	if m.GetAccountV4FavoriteTVShowsFunc != nil {
+		return m.GetAccountV4FavoriteTVShowsFunc(accountObjectID, urlOptions)
+	}
+	if m.GetAccountV4FavoriteTVShowsWithContextFunc != nil {
+		return m.GetAccountV4FavoriteTVShowsWithContextFunc(context.Background(), accountObjectID, urlOptions)
+	}
+	var r0 *AccountV4TVShows
+	return r0, fmt.Errorf("%w: AccountV4Service.GetAccountV4FavoriteTVShows", ErrNotStubbed)
+}
+
+// GetAccountV4FavoriteTVShowsWithContext records the call and calls GetAccountV4FavoriteTVShowsWithContextFunc.
+func (m *AccountV4ServiceMock) GetAccountV4FavoriteTVShowsWithContext(ctx context.Context, accountObjectID string, urlOptions map[string]string) (*AccountV4TVShows, error) {
+	m.record("GetAccountV4FavoriteTVShowsWithContext", ctx, accountObjectID, urlOptions)
+	if m.GetAccountV4FavoriteTVShowsWithContextFunc != nil {
+		return m.GetAccountV4FavoriteTVShowsWithContextFunc(ctx, accountObjectID, urlOptions)
+	}
+	var r0 *AccountV4TVShows
+	return r0, fmt.Errorf("%w: AccountV4Service.GetAccountV4FavoriteTVShowsWithContext", ErrNotStubbed)
+}
+
+// AllAccountV4FavoriteTVShows records the call and calls AllAccountV4FavoriteTVShowsFunc.
+func (m *AccountV4ServiceMock) AllAccountV4FavoriteTVShows(ctx context.Context, accountObjectID string, urlOptions map[string]string, opts PaginateOptions) iter.Seq2[AccountV4TVShow, error] {
+	m.record("AllAccountV4FavoriteTVShows", ctx, accountObjectID, urlOptions, opts)
+	if m.AllAccountV4FavoriteTVShowsFunc != nil {
+		return m.AllAccountV4FavoriteTVShowsFunc(ctx, accountObjectID, urlOptions, opts)
+	}
+	return func(yield func(AccountV4TVShow, error) bool) {
+		var zero AccountV4TVShow
+		yield(zero, fmt.Errorf("%w: AccountV4Service.AllAccountV4FavoriteTVShows", ErrNotStubbed))
+	}
+}
+
+// GetAccountV4MovieWatchlist records the call and calls GetAccountV4MovieWatchlistFunc.
+func (m *AccountV4ServiceMock) GetAccountV4MovieWatchlist(accountObjectID string, urlOptions map[string]string) (*AccountV4Movies, error) {
+	m.record("GetAccountV4MovieWatchlist", accountObjectID, urlOptions)
+	if m.GetAccountV4MovieWatchlistFunc != nil {
+		return m.GetAccountV4MovieWatchlistFunc(accountObjectID, urlOptions)
+	}
+	if m.GetAccountV4MovieWatchlistWithContextFunc != nil {
+		return m.GetAccountV4MovieWatchlistWithContextFunc(context.Background(), accountObjectID, urlOptions)
+	}
+	var r0 *AccountV4Movies
+	return r0, fmt.Errorf("%w: AccountV4Service.GetAccountV4MovieWatchlist", ErrNotStubbed)
+}
+
+// GetAccountV4MovieWatchlistWithContext records the call and calls GetAccountV4MovieWatchlistWithContextFunc.
+func (m *AccountV4ServiceMock) GetAccountV4MovieWatchlistWithContext(ctx context.Context, accountObjectID string, urlOptions map[string]string) (*AccountV4Movies, error) {
+	m.record("GetAccountV4MovieWatchlistWithContext", ctx, accountObjectID, urlOptions)
+	if m.GetAccountV4MovieWatchlistWithContextFunc != nil {
+		return m.GetAccountV4MovieWatchlistWithContextFunc(ctx, accountObjectID, urlOptions)
+	}
+	var r0 *AccountV4Movies
+	return r0, fmt.Errorf("%w: AccountV4Service.GetAccountV4MovieWatchlistWithContext", ErrNotStubbed)
+}
+
+// AllAccountV4MovieWatchlist records the call and calls AllAccountV4MovieWatchlistFunc.
+func (m *AccountV4ServiceMock) AllAccountV4MovieWatchlist(ctx context.Context, accountObjectID string, urlOptions map[string]string, opts PaginateOptions) iter.Seq2[AccountV4Movie, error] {
+	m.record("AllAccountV4MovieWatchlist", ctx, accountObjectID, urlOptions, opts)
+	if m.AllAccountV4MovieWatchlistFunc != nil {
+		return m.AllAccountV4MovieWatchlistFunc(ctx, accountObjectID, urlOptions, opts)
+	}
+	return func(yield func(AccountV4Movie, error) bool) {
+		var zero AccountV4Movie
+		yield(zero, fmt.Errorf("%w: AccountV4Service.AllAccountV4MovieWatchlist", ErrNotStubbed))
+	}
+}
+
+// GetAccountV4TVShowWatchlist records the call and calls GetAccountV4TVShowWatchlistFunc.
+func (m *AccountV4ServiceMock) GetAccountV4TVShowWatchlist(accountObjectID string, urlOptions map[string]string) (*AccountV4TVShows, error) {
+	m.record("GetAccountV4TVShowWatchlist", accountObjectID, urlOptions)
+	if m.GetAccountV4TVShowWatchlistFunc != nil {
+		return m.GetAccountV4TVShowWatchlistFunc(accountObjectID, urlOptions)
+	}
+	if m.GetAccountV4TVShowWatchlistWithContextFunc != nil {
+		return m.GetAccountV4TVShowWatchlistWithContextFunc(context.Background(), accountObjectID, urlOptions)
+	}
+	var r0 *AccountV4TVShows
+	return r0, fmt.Errorf("%w: AccountV4Service.GetAccountV4TVShowWatchlist", ErrNotStubbed)
+}
+
+// GetAccountV4TVShowWatchlistWithContext records the call and calls GetAccountV4TVShowWatchlistWithContextFunc.
+func (m *AccountV4ServiceMock) GetAccountV4TVShowWatchlistWithContext(ctx context.Context, accountObjectID string, urlOptions map[string]string) (*AccountV4TVShows, error) {
+	m.record("GetAccountV4TVShowWatchlistWithContext", ctx, accountObjectID, urlOptions)
+	if m.GetAccountV4TVShowWatchlistWithContextFunc != nil {
+		return m.GetAccountV4TVShowWatchlistWithContextFunc(ctx, accountObjectID, urlOptions)
+	}
+	var r0 *AccountV4TVShows
+	return r0, fmt.Errorf("%w: AccountV4Service.GetAccountV4TVShowWatchlistWithContext", ErrNotStubbed)
+}
+
+// AllAccountV4TVShowWatchlist records the call and calls AllAccountV4TVShowWatchlistFunc.
+func (m *AccountV4ServiceMock) AllAccountV4TVShowWatchlist(ctx context.Context, accountObjectID string, urlOptions map[string]string, opts PaginateOptions) iter.Seq2[AccountV4TVShow, error] {
+	m.record("AllAccountV4TVShowWatchlist", ctx, accountObjectID, urlOptions, opts)
+	if m.AllAccountV4TVShowWatchlistFunc != nil {
+		return m.AllAccountV4TVShowWatchlistFunc(ctx, accountObjectID, urlOptions, opts)
+	}
+	return func(yield func(AccountV4TVShow, error) bool) {
+		var zero AccountV4TVShow
+		yield(zero, fmt.Errorf("%w: AccountV4Service.AllAccountV4TVShowWatchlist", ErrNotStubbed))
+	}
+}
+
+// GetAccountV4RatedMovies records the call and calls GetAccountV4RatedMoviesFunc.
+func (m *AccountV4ServiceMock) GetAccountV4RatedMovies(accountObjectID string, urlOptions map[string]string) (*AccountV4Movies, error) {
+	m.record("GetAccountV4RatedMovies", accountObjectID, urlOptions)
+	if m.GetAccountV4RatedMoviesFunc != nil {
+		return m.GetAccountV4RatedMoviesFunc(accountObjectID, urlOptions)
+	}
+	if m.GetAccountV4RatedMoviesWithContextFunc != nil {
+		return m.GetAccountV4RatedMoviesWithContextFunc(context.Background(), accountObjectID, urlOptions)
+	}
+	var r0 *AccountV4Movies
+	return r0, fmt.Errorf("%w: AccountV4Service.GetAccountV4RatedMovies", ErrNotStubbed)
+}
+
+// GetAccountV4RatedMoviesWithContext records the call and calls GetAccountV4RatedMoviesWithContextFunc.
+func (m *AccountV4ServiceMock) GetAccountV4RatedMoviesWithContext(ctx context.Context, accountObjectID string, urlOptions map[string]string) (*AccountV4Movies, error) {
+	m.record("GetAccountV4RatedMoviesWithContext", ctx, accountObjectID, urlOptions)
+	if m.GetAccountV4RatedMoviesWithContextFunc != nil {
+		return m.GetAccountV4RatedMoviesWithContextFunc(ctx, accountObjectID, urlOptions)
+	}
+	var r0 *AccountV4Movies
+	return r0, fmt.Errorf("%w: AccountV4Service.GetAccountV4RatedMoviesWithContext", ErrNotStubbed)
+}
+
+// AllAccountV4RatedMovies records the call and calls AllAccountV4RatedMoviesFunc.
+func (m *AccountV4ServiceMock) AllAccountV4RatedMovies(ctx context.Context, accountObjectID string, urlOptions map[string]string, opts PaginateOptions) iter.Seq2[AccountV4Movie, error] {
+	m.record("AllAccountV4RatedMovies", ctx, accountObjectID, urlOptions, opts)
+	if m.AllAccountV4RatedMoviesFunc != nil {
+		return m.AllAccountV4RatedMoviesFunc(ctx, accountObjectID, urlOptions, opts)
+	}
+	return func(yield func(AccountV4Movie, error) bool) {
+		var zero AccountV4Movie
+		yield(zero, fmt.Errorf("%w: AccountV4Service.AllAccountV4RatedMovies", ErrNotStubbed))
+	}
+}
+
+// GetAccountV4RatedTVShows records the call and calls GetAccountV4RatedTVShowsFunc.
+func (m *AccountV4ServiceMock) GetAccountV4RatedTVShows(accountObjectID string, urlOptions map[string]string) (*AccountV4TVShows, error) {
+	m.record("GetAccountV4RatedTVShows", accountObjectID, urlOptions)
+	if m.GetAccountV4RatedTVShowsFunc != nil {
+		return m.GetAccountV4RatedTVShowsFunc(accountObjectID, urlOptions)
+	}
+	if m.GetAccountV4RatedTVShowsWithContextFunc != nil {
+		return m.GetAccountV4RatedTVShowsWithContextFunc(context.Background(), accountObjectID, urlOptions)
+	}
+	var r0 *AccountV4TVShows
+	return r0, fmt.Errorf("%w: AccountV4Service.GetAccountV4RatedTVShows", ErrNotStubbed)
+}
+
+// GetAccountV4RatedTVShowsWithContext records the call and calls GetAccountV4RatedTVShowsWithContextFunc.
+func (m *AccountV4ServiceMock) GetAccountV4RatedTVShowsWithContext(ctx context.Context, accountObjectID string, urlOptions map[string]string) (*AccountV4TVShows, error) {
+	m.record("GetAccountV4RatedTVShowsWithContext", ctx, accountObjectID, urlOptions)
+	if m.GetAccountV4RatedTVShowsWithContextFunc != nil {
+		return m.GetAccountV4RatedTVShowsWithContextFunc(ctx, accountObjectID, urlOptions)
+	}
+	var r0 *AccountV4TVShows
+	return r0, fmt.Errorf("%w: AccountV4Service.GetAccountV4RatedTVShowsWithContext", ErrNotStubbed)
+}
+
+// AllAccountV4RatedTVShows records the call and calls AllAccountV4RatedTVShowsFunc.
+func (m *AccountV4ServiceMock) AllAccountV4RatedTVShows(ctx context.Context, accountObjectID string, urlOptions map[string]string, opts PaginateOptions) iter.Seq2[AccountV4TVShow, error] {
+	m.record("AllAccountV4RatedTVShows", ctx, accountObjectID, urlOptions, opts)
+	if m.AllAccountV4RatedTVShowsFunc != nil {
+		return m.AllAccountV4RatedTVShowsFunc(ctx, accountObjectID, urlOptions, opts)
+	}
+	return func(yield func(AccountV4TVShow, error) bool) {
+		var zero AccountV4TVShow
+		yield(zero, fmt.Errorf("%w: AccountV4Service.AllAccountV4RatedTVShows", ErrNotStubbed))
+	}
+}
+
+// GetAccountV4MovieRecommendations records the call and calls GetAccountV4MovieRecommendationsFunc.
+func (m *AccountV4ServiceMock) GetAccountV4MovieRecommendations(accountObjectID string, urlOptions map[string]string) (*AccountV4Movies, error) {
+	m.record("GetAccountV4MovieRecommendations", accountObjectID, urlOptions)
+	if m.GetAccountV4MovieRecommendationsFunc != nil {
+		return m.GetAccountV4MovieRecommendationsFunc(accountObjectID, urlOptions)
+	}
+	if m.GetAccountV4MovieRecommendationsWithContextFunc != nil {
+		return m.GetAccountV4MovieRecommendationsWithContextFunc(context.Background(), accountObjectID, urlOptions)
+	}
+	var r0 *AccountV4Movies
+	return r0, fmt.Errorf("%w: AccountV4Service.GetAccountV4MovieRecommendations", ErrNotStubbed)
+}
+
+// GetAccountV4MovieRecommendationsWithContext records the call and calls GetAccountV4MovieRecommendationsWithContextFunc.
+func (m *AccountV4ServiceMock) GetAccountV4MovieRecommendationsWithContext(ctx context.Context, accountObjectID string, urlOptions map[string]string) (*AccountV4Movies, error) {
+	m.record("GetAccountV4MovieRecommendationsWithContext", ctx, accountObjectID, urlOptions)
+	if m.GetAccountV4MovieRecommendationsWithContextFunc != nil {
+		return m.GetAccountV4MovieRecommendationsWithContextFunc(ctx, accountObjectID, urlOptions)
+	}
+	var r0 *AccountV4Movies
+	return r0, fmt.Errorf("%w: AccountV4Service.GetAccountV4MovieRecommendationsWithContext", ErrNotStubbed)
+}
+
+// AllAccountV4MovieRecommendations records the call and calls AllAccountV4MovieRecommendationsFunc.
+func (m *AccountV4ServiceMock) AllAccountV4MovieRecommendations(ctx context.Context, accountObjectID string, urlOptions map[string]string, opts PaginateOptions) iter.Seq2[AccountV4Movie, error] {
+	m.record("AllAccountV4MovieRecommendations", ctx, accountObjectID, urlOptions, opts)
+	if m.AllAccountV4MovieRecommendationsFunc != nil {
+		return m.AllAccountV4MovieRecommendationsFunc(ctx, accountObjectID, urlOptions, opts)
+	}
+	return func(yield func(AccountV4Movie, error) bool) {
+		var zero AccountV4Movie
+		yield(zero, fmt.Errorf("%w: AccountV4Service.AllAccountV4MovieRecommendations", ErrNotStubbed))
+	}
+}
+
+// GetAccountV4TVShowRecommendations records the call and calls GetAccountV4TVShowRecommendationsFunc.
+func (m *AccountV4ServiceMock) GetAccountV4TVShowRecommendations(accountObjectID string, urlOptions map[string]string) (*AccountV4TVShows, error) {
+	m.record("GetAccountV4TVShowRecommendations", accountObjectID, urlOptions)
+	if m.GetAccountV4TVShowRecommendationsFunc != nil {
+		return m.GetAccountV4TVShowRecommendationsFunc(accountObjectID, urlOptions)
+	}
+	if m.GetAccountV4TVShowRecommendationsWithContextFunc != nil {
+		return m.GetAccountV4TVShowRecommendationsWithContextFunc(context.Background(), accountObjectID, urlOptions)
+	}
+	var r0 *AccountV4TVShows
+	return r0, fmt.Errorf("%w: AccountV4Service.GetAccountV4TVShowRecommendations", ErrNotStubbed)
+}
+
+// GetAccountV4TVShowRecommendationsWithContext records the call and calls GetAccountV4TVShowRecommendationsWithContextFunc.
+func (m *AccountV4ServiceMock) GetAccountV4TVShowRecommendationsWithContext(ctx context.Context, accountObjectID string, urlOptions map[string]string) (*AccountV4TVShows, error) {
+	m.record("GetAccountV4TVShowRecommendationsWithContext", ctx, accountObjectID, urlOptions)
+	if m.GetAccountV4TVShowRecommendationsWithContextFunc != nil {
+		return m.GetAccountV4TVShowRecommendationsWithContextFunc(ctx, accountObjectID, urlOptions)
+	}
+	var r0 *AccountV4TVShows
+	return r0, fmt.Errorf("%w: AccountV4Service.GetAccountV4TVShowRecommendationsWithContext", ErrNotStubbed)
+}
+
+// AllAccountV4TVShowRecommendations records the call and calls AllAccountV4TVShowRecommendationsFunc.
+func (m *AccountV4ServiceMock) AllAccountV4TVShowRecommendations(ctx context.Context, accountObjectID string, urlOptions map[string]string, opts PaginateOptions) iter.Seq2[AccountV4TVShow, error] {
+	m.record("AllAccountV4TVShowRecommendations", ctx, accountObjectID, urlOptions, opts)
+	if m.AllAccountV4TVShowRecommendationsFunc != nil {
+		return m.AllAccountV4TVShowRecommendationsFunc(ctx, accountObjectID, urlOptions, opts)
+	}
+	return func(yield func(AccountV4TVShow, error) bool) {
+		var zero AccountV4TVShow
+		yield(zero, fmt.Errorf("%w: AccountV4Service.AllAccountV4TVShowRecommendations", ErrNotStubbed))
+	}
+}
+
+// AuthenticationServiceMock type is an AuthenticationService recording its calls.
+//
+// Set the Func fields to stub the methods. A method without
+// a Func falls back to the Func of its WithContext variant,
+// then returns zero values and ErrNotStubbed.
+type AuthenticationServiceMock struct {
+	MockRecorder
+
+	CreateGuestSessionFunc                func() (*RequestToken, error)
+	CreateGuestSessionWithContextFunc     func(context.Context) (*RequestToken, error)
+	CreateRequestTokenFunc                func() (*RequestToken, error)
+	CreateRequestTokenWithContextFunc     func(context.Context) (*RequestToken, error)
+	CreateSessionFunc                     func(string) (*Session, error)
+	CreateSessionWithContextFunc          func(context.Context, string) (*Session, error)
+	CreateSessionWithLoginFunc            func(string, string, string) (*RequestToken, error)
+	CreateSessionWithLoginWithContextFunc func(context.Context, string, string, string) (*RequestToken, error)
+	CreateSessionFromV4Func               func(string) (*Session, error)
+	CreateSessionFromV4WithContextFunc    func(context.Context, string) (*Session, error)
+	DeleteSessionFunc                     func(string) (*Session, error)
+	DeleteSessionWithContextFunc          func(context.Context, string) (*Session, error)
+	GetApprovalURLFunc                    func(string, string) string
+	CreateRequestTokenV4Func              func(string) (*RequestTokenV4, error)
+	CreateRequestTokenV4WithContextFunc   func(context.Context, string) (*RequestTokenV4, error)
+	CreateAccessTokenV4Func               func(string) (*AccessTokenV4, error)
+	CreateAccessTokenV4WithContextFunc    func(context.Context, string) (*AccessTokenV4, error)
+	DeleteAccessTokenV4Func               func(string) (*Response, error)
+	DeleteAccessTokenV4WithContextFunc    func(context.Context, string) (*Response, error)
+	GetApprovalURLV4Func                  func(string) string
+}
+
+var _ AuthenticationService = (*AuthenticationServiceMock)(nil)
+
+// CreateGuestSession records the call and calls CreateGuestSessionFunc.
+func (m *AuthenticationServiceMock) CreateGuestSession() (*RequestToken, error) {
+	m.record("CreateGuestSession")
+	if m.CreateGuestSessionFunc != nil {
+		return m.CreateGuestSessionFunc()
+	}
+	if m.CreateGuestSessionWithContextFunc != nil {
+		return m.CreateGuestSessionWithContextFunc(context.Background())
+	}
+	var r0 *RequestToken
+	return r0, fmt.Errorf("%w: AuthenticationService.CreateGuestSession", ErrNotStubbed)
+}
+
+// CreateGuestSessionWithContext records the call and calls CreateGuestSessionWithContextFunc.
+func (m *AuthenticationServiceMock) CreateGuestSessionWithContext(ctx context.Context) (*RequestToken, error) {
+	m.record("CreateGuestSessionWithContext", ctx)
+	if m.CreateGuestSessionWithContextFunc != nil {
+		return m.CreateGuestSessionWithContextFunc(ctx)
+	}
+	var r0 *RequestToken
+	return r0, fmt.Errorf("%w: AuthenticationService.CreateGuestSessionWithContext", ErrNotStubbed)
+}
+
+// CreateRequestToken records the call and calls CreateRequestTokenFunc.
+func (m *AuthenticationServiceMock) CreateRequestToken() (*RequestToken, error) {
+	m.record("CreateRequestToken")
+	if m.CreateRequestTokenFunc != nil {
+		return m.CreateRequestTokenFunc()
+	}
+	if m.CreateRequestTokenWithContextFunc != nil {
+		return m.CreateRequestTokenWithContextFunc(context.Background())
+	}
+	var r0 *RequestToken
+	return r0, fmt.Errorf("%w: AuthenticationService.CreateRequestToken", ErrNotStubbed)
+}
+
+// CreateRequestTokenWithContext records the call and calls CreateRequestTokenWithContextFunc.
+func (m *AuthenticationServiceMock) CreateRequestTokenWithContext(ctx context.Context) (*RequestToken, error) {
+	m.record("CreateRequestTokenWithContext", ctx)
+	if m.CreateRequestTokenWithContextFunc != nil {
+		return m.CreateRequestTokenWithContextFunc(ctx)
+	}
+	var r0 *RequestToken
+	return r0, fmt.Errorf("%w: AuthenticationService.CreateRequestTokenWithContext", ErrNotStubbed)
+}
+
+// CreateSession records the call and calls CreateSessionFunc.
+func (m *AuthenticationServiceMock) CreateSession(rt string) (*Session, error) {
+	m.record("CreateSession", rt)
+	if m.CreateSessionFunc != nil {
+		return m.CreateSessionFunc(rt)
+	}
+	if m.CreateSessionWithContextFunc != nil {
+		return m.CreateSessionWithContextFunc(context.Background(), rt)
+	}
+	var r0 *Session
+	return r0, fmt.Errorf("%w: AuthenticationService.CreateSession", ErrNotStubbed)
+}
+
+// CreateSessionWithContext records the call and calls CreateSessionWithContextFunc.
+func (m *AuthenticationServiceMock) CreateSessionWithContext(ctx context.Context, rt string) (*Session, error) {
+	m.record("CreateSessionWithContext", ctx, rt)
+	if m.CreateSessionWithContextFunc != nil {
+		return m.CreateSessionWithContextFunc(ctx, rt)
+	}
+	var r0 *Session
+	return r0, fmt.Errorf("%w: AuthenticationService.CreateSessionWithContext", ErrNotStubbed)
+}
+
+// CreateSessionWithLogin records the call and calls CreateSessionWithLoginFunc.
+func (m *AuthenticationServiceMock) CreateSessionWithLogin(u string, p string, rt string) (*RequestToken, error) {
+	m.record("CreateSessionWithLogin", u, p, rt)
+	if m.CreateSessionWithLoginFunc != nil {
+		return m.CreateSessionWithLoginFunc(u, p, rt)
+	}
+	if m.CreateSessionWithLoginWithContextFunc != nil {
+		return m.CreateSessionWithLoginWithContextFunc(context.Background(), u, p, rt)
+	}
+	var r0 *RequestToken
+	return r0, fmt.Errorf("%w: AuthenticationService.CreateSessionWithLogin", ErrNotStubbed)
+}
+
+// CreateSessionWithLoginWithContext records the call and calls CreateSessionWithLoginWithContextFunc.
+func (m *AuthenticationServiceMock) CreateSessionWithLoginWithContext(ctx context.Context, u string, p string, rt string) (*RequestToken, error) {
+	m.record("CreateSessionWithLoginWithContext", ctx, u, p, rt)
+	if m.CreateSessionWithLoginWithContextFunc != nil {
+		return m.CreateSessionWithLoginWithContextFunc(ctx, u, p, rt)
+	}
+	var r0 *RequestToken
+	return r0, fmt.Errorf("%w: AuthenticationService.CreateSessionWithLoginWithContext", ErrNotStubbed)
+}
+
+// CreateSessionFromV4 records the call and calls CreateSessionFromV4Func.
+func (m *AuthenticationServiceMock) CreateSessionFromV4(at string) (*Session, error) {
+	m.record("CreateSessionFromV4", at)
+	if m.CreateSessionFromV4Func != nil {
+		return m.CreateSessionFromV4Func(at)
+	}
+	if m.CreateSessionFromV4WithContextFunc != nil {
+		return m.CreateSessionFromV4WithContextFunc(context.Background(), at)
+	}
+	var r0 *Session
+	return r0, fmt.Errorf("%w: AuthenticationService.CreateSessionFromV4", ErrNotStubbed)
+}
+
+// CreateSessionFromV4WithContext records the call and calls CreateSessionFromV4WithContextFunc.
+func (m *AuthenticationServiceMock) CreateSessionFromV4WithContext(ctx context.Context, at string) (*Session, error) {
+	m.record("CreateSessionFromV4WithContext", ctx, at)
+	if m.CreateSessionFromV4WithContextFunc != nil {
+		return m.CreateSessionFromV4WithContextFunc(ctx, at)
+	}
+	var r0 *Session
+	return r0, fmt.Errorf("%w: AuthenticationService.CreateSessionFromV4WithContext", ErrNotStubbed)
+}
+
+// DeleteSession records the call and calls DeleteSessionFunc.
+func (m *AuthenticationServiceMock) DeleteSession(sid string) (*Session, error) {
+	m.record("DeleteSession", sid)
+	if m.DeleteSessionFunc != nil {
+		return m.DeleteSessionFunc(sid)
+	}
+	if m.DeleteSessionWithContextFunc != nil {
+		return m.DeleteSessionWithContextFunc(context.Background(), sid)
+	}
+	var r0 *Session
+	return r0, fmt.Errorf("%w: AuthenticationService.DeleteSession", ErrNotStubbed)
+}
+
+// DeleteSessionWithContext records the call and calls DeleteSessionWithContextFunc.
+func (m *AuthenticationServiceMock) DeleteSessionWithContext(ctx context.Context, sid string) (*Session, error) {
+	m.record("DeleteSessionWithContext", ctx, sid)
+	if m.DeleteSessionWithContextFunc != nil {
+		return m.DeleteSessionWithContextFunc(ctx, sid)
+	}
+	var r0 *Session
+	return r0, fmt.Errorf("%w: AuthenticationService.DeleteSessionWithContext", ErrNotStubbed)
+}
+
+// GetApprovalURL records the call and calls GetApprovalURLFunc.
+func (m *AuthenticationServiceMock) GetApprovalURL(requestToken string, redirectTo string) string {
+	m.record("GetApprovalURL", requestToken, redirectTo)
+	if m.GetApprovalURLFunc != nil {
+		return m.GetApprovalURLFunc(requestToken, redirectTo)
+	}
+	var r0 string
+	return r0
+}
+
+// CreateRequestTokenV4 records the call and calls CreateRequestTokenV4Func.
+func (m *AuthenticationServiceMock) CreateRequestTokenV4(redirectTo string) (*RequestTokenV4, error) {
+	m.record("CreateRequestTokenV4", redirectTo)
+	if m.CreateRequestTokenV4Func != nil {
+		return m.CreateRequestTokenV4Func(redirectTo)
+	}
+	if m.CreateRequestTokenV4WithContextFunc != nil {
+		return m.CreateRequestTokenV4WithContextFunc(context.Background(), redirectTo)
+	}
+	var r0 *RequestTokenV4
+	return r0, fmt.Errorf("%w: AuthenticationService.CreateRequestTokenV4", ErrNotStubbed)
+}
+
+// CreateRequestTokenV4WithContext records the call and calls CreateRequestTokenV4WithContextFunc.
+func (m *AuthenticationServiceMock) CreateRequestTokenV4WithContext(ctx context.Context, redirectTo string) (*RequestTokenV4, error) {
+	m.record("CreateRequestTokenV4WithContext", ctx, redirectTo)
+	if m.CreateRequestTokenV4WithContextFunc != nil {
+		return m.CreateRequestTokenV4WithContextFunc(ctx, redirectTo)
+	}
+	var r0 *RequestTokenV4
+	return r0, fmt.Errorf("%w: AuthenticationService.CreateRequestTokenV4WithContext", ErrNotStubbed)
+}
+
+// CreateAccessTokenV4 records the call and calls CreateAccessTokenV4Func.
+func (m *AuthenticationServiceMock) CreateAccessTokenV4(requestToken string) (*AccessTokenV4, error) {
+	m.record("CreateAccessTokenV4", requestToken)
+	if m.CreateAccessTokenV4Func != nil {
+		return m.CreateAccessTokenV4Func(requestToken)
+	}
+	if m.CreateAccessTokenV4WithContextFunc != nil {
+		return m.CreateAccessTokenV4WithContextFunc(context.Background(), requestToken)
+	}
+	var r0 *AccessTokenV4
+	return r0, fmt.Errorf("%w: AuthenticationService.CreateAccessTokenV4", ErrNotStubbed)
+}
+
+// CreateAccessTokenV4WithContext records the call and calls CreateAccessTokenV4WithContextFunc.
+func (m *AuthenticationServiceMock) CreateAccessTokenV4WithContext(ctx context.Context, requestToken string) (*AccessTokenV4, error) {
+	m.record("CreateAccessTokenV4WithContext", ctx, requestToken)
+	if m.CreateAccessTokenV4WithContextFunc != nil {
+		return m.CreateAccessTokenV4WithContextFunc(ctx, requestToken)
+	}
+	var r0 *AccessTokenV4
+	return r0, fmt.Errorf("%w: AuthenticationService.CreateAccessTokenV4WithContext", ErrNotStubbed)
+}
+
+// DeleteAccessTokenV4 records the call and calls DeleteAccessTokenV4Func.
+func (m *AuthenticationServiceMock) DeleteAccessTokenV4(accessToken string) (*Response, error) {
+	m.record("DeleteAccessTokenV4", accessToken)
+	if m.DeleteAccessTokenV4Func != nil {
+		return m.DeleteAccessTokenV4Func(accessToken)
+	}
+	if m.DeleteAccessTokenV4WithContextFunc != nil {
+		return m.DeleteAccessTokenV4WithContextFunc(context.Background(), accessToken)
+	}
+	var r0 *Response
+	return r0, fmt.Errorf("%w: AuthenticationService.DeleteAccessTokenV4", ErrNotStubbed)
+}
+
+// DeleteAccessTokenV4WithContext records the call and calls DeleteAccessTokenV4WithContextFunc.
+func (m *AuthenticationServiceMock) DeleteAccessTokenV4WithContext(ctx context.Context, accessToken string) (*Response, error) {
+	m.record("DeleteAccessTokenV4WithContext", ctx, accessToken)
+	if m.DeleteAccessTokenV4WithContextFunc != nil {
+		return m.DeleteAccessTokenV4WithContextFunc(ctx, accessToken)
+	}
+	var r0 *Response
+	return r0, fmt.Errorf("%w: AuthenticationService.DeleteAccessTokenV4WithContext", ErrNotStubbed)
+}
+
+// GetApprovalURLV4 records the call and calls GetApprovalURLV4Func.
+func (m *AuthenticationServiceMock) GetApprovalURLV4(requestToken string) string {
+	m.record("GetApprovalURLV4", requestToken)
+	if m.GetApprovalURLV4Func != nil {
+		return m.GetApprovalURLV4Func(requestToken)
+	}
+	var r0 string
+	return r0
+}
+
+// CertificationsServiceMock type is a CertificationsService recording its calls.
+//
+// Set the Func fields to stub the methods. A method without
+// a Func falls back to the Func of its WithContext variant,
+// then returns zero values and ErrNotStubbed.
+type CertificationsServiceMock struct {
+	MockRecorder
+
+	GetCertificationMovieFunc            func() (*Certifications, error)
+	GetCertificationMovieWithContextFunc func(context.Context) (*Certifications, error)
+	GetCertificationTVFunc               func() (*Certifications, error)
+	GetCertificationTVWithContextFunc    func(context.Context) (*Certifications, error)
+}
+
+var _ CertificationsService = (*CertificationsServiceMock)(nil)
+
+// GetCertificationMovie records the call and calls GetCertificationMovieFunc.
+func (m *CertificationsServiceMock) GetCertificationMovie() (*Certifications, error) {
+	m.record("GetCertificationMovie")
+	if m.GetCertificationMovieFunc != nil {
+		return m.GetCertificationMovieFunc()
+	}
+	if m.GetCertificationMovieWithContextFunc != nil {
+		return m.GetCertificationMovieWithContextFunc(context.Background())
+	}
+	var r0 *Certifications
+	return r0, fmt.Errorf("%w: CertificationsService.GetCertificationMovie", ErrNotStubbed)
+}
+
+// GetCertificationMovieWithContext records the call and calls GetCertificationMovieWithContextFunc.
+func (m *CertificationsServiceMock) GetCertificationMovieWithContext(ctx context.Context) (*Certifications, error) {
+	m.record("GetCertificationMovieWithContext", ctx)
+	if m.GetCertificationMovieWithContextFunc != nil {
+		return m.GetCertificationMovieWithContextFunc(ctx)
+	}
+	var r0 *Certifications
+	return r0, fmt.Errorf("%w: CertificationsService.GetCertificationMovieWithContext", ErrNotStubbed)
+}
+
+// GetCertificationTV records the call and calls GetCertificationTVFunc.
+func (m *CertificationsServiceMock) GetCertificationTV() (*Certifications, error) {
+	m.record("GetCertificationTV")
+	if m.GetCertificationTVFunc != nil {
+		return m.GetCertificationTVFunc()
+	}
+	if m.GetCertificationTVWithContextFunc != nil {
+		return m.GetCertificationTVWithContextFunc(context.Background())
+	}
+	var r0 *Certifications
+	return r0, fmt.Errorf("%w: CertificationsService.GetCertificationTV", ErrNotStubbed)
+}
+
+// GetCertificationTVWithContext records the call and calls GetCertificationTVWithContextFunc.
+func (m *CertificationsServiceMock) GetCertificationTVWithContext(ctx context.Context) (*Certifications, error) {
+	m.record("GetCertificationTVWithContext", ctx)
+	if m.GetCertificationTVWithContextFunc != nil {
+		return m.GetCertificationTVWithContextFunc(ctx)
+	}
+	var r0 *Certifications
+	return r0, fmt.Errorf("%w: CertificationsService.GetCertificationTVWithContext", ErrNotStubbed)
+}
+
+// ChangesServiceMock type is a ChangesService recording its calls.
+//
+// Set the Func fields to stub the methods. A method without
+// a Func falls back to the Func of its WithContext variant,
+// then returns zero values and ErrNotStubbed.
+type ChangesServiceMock struct {
+	MockRecorder
+
+	GetChangesMovieFunc             func(map[string]string) (*ChangesMovie, error)
+	GetChangesMovieWithContextFunc  func(context.Context, map[string]string) (*ChangesMovie, error)
+	GetChangesTVFunc                func(map[string]string) (*ChangesTV, error)
+	GetChangesTVWithContextFunc     func(context.Context, map[string]string) (*ChangesTV, error)
+	GetChangesPersonFunc            func(map[string]string) (*ChangesPerson, error)
+	GetChangesPersonWithContextFunc func(context.Context, map[string]string) (*ChangesPerson, error)
+}
+
+var _ ChangesService = (*ChangesServiceMock)(nil)
+
+// GetChangesMovie records the call and calls GetChangesMovieFunc.
+func (m *ChangesServiceMock) GetChangesMovie(urlOptions map[string]string) (*ChangesMovie, error) {
+	m.record("GetChangesMovie", urlOptions)
+	if m.GetChangesMovieFunc != nil {
+		return m.GetChangesMovieFunc(urlOptions)
+	}
+	if m.GetChangesMovieWithContextFunc != nil {
+		return m.GetChangesMovieWithContextFunc(context.Background(), urlOptions)
+	}
+	var r0 *ChangesMovie
+	return r0, fmt.Errorf("%w: ChangesService.GetChangesMovie", ErrNotStubbed)
+}
+
+// GetChangesMovieWithContext records the call and calls GetChangesMovieWithContextFunc.
+func (m *ChangesServiceMock) GetChangesMovieWithContext(ctx context.Context, urlOptions map[string]string) (*ChangesMovie, error) {
+	m.record("GetChangesMovieWithContext", ctx, urlOptions)
+	if m.GetChangesMovieWithContextFunc != nil {
+		return m.GetChangesMovieWithContextFunc(ctx, urlOptions)
+	}
+	var r0 *ChangesMovie
+	return r0, fmt.Errorf("%w: ChangesService.GetChangesMovieWithContext", ErrNotStubbed)
+}
+
+// GetChangesTV records the call and calls GetChangesTVFunc.
+func (m *ChangesServiceMock) GetChangesTV(urlOptions map[string]string) (*ChangesTV, error) {
+	m.record("GetChangesTV", urlOptions)
+	if m.GetChangesTVFunc != nil {
+		return m.GetChangesTVFunc(urlOptions)
+	}
+	if m.GetChangesTVWithContextFunc != nil {
+		return m.GetChangesTVWithContextFunc(context.Background(), urlOptions)
+	}
+	var r0 *ChangesTV
+	return r0, fmt.Errorf("%w: ChangesService.GetChangesTV", ErrNotStubbed)
+}
+
+// GetChangesTVWithContext records the call and calls GetChangesTVWithContextFunc.
+func (m *ChangesServiceMock) GetChangesTVWithContext(ctx context.Context, urlOptions map[string]string) (*ChangesTV, error) {
+	m.record("GetChangesTVWithContext", ctx, urlOptions)
+	if m.GetChangesTVWithContextFunc != nil {
+		return m.GetChangesTVWithContextFunc(ctx, urlOptions)
+	}
+	var r0 *ChangesTV
+	return r0, fmt.Errorf("%w: ChangesService.GetChangesTVWithContext", ErrNotStubbed)
+}
+
+// GetChangesPerson records the call and calls GetChangesPersonFunc.
+func (m *ChangesServiceMock) GetChangesPerson(urlOptions map[string]string) (*ChangesPerson, error) {
+	m.record("GetChangesPerson", urlOptions)
+	if m.GetChangesPersonFunc != nil {
+		return m.GetChangesPersonFunc(urlOptions)
+	}
+	if m.GetChangesPersonWithContextFunc != nil {
+		return m.GetChangesPersonWithContextFunc(context.Background(), urlOptions)
+	}
+	var r0 *ChangesPerson
+	return r0, fmt.Errorf("%w: ChangesService.GetChangesPerson", ErrNotStubbed)
+}
+
+// GetChangesPersonWithContext records the call and calls GetChangesPersonWithContextFunc.
+func (m *ChangesServiceMock) GetChangesPersonWithContext(ctx context.Context, urlOptions map[string]string) (*ChangesPerson, error) {
+	m.record("GetChangesPersonWithContext", ctx, urlOptions)
+	if m.GetChangesPersonWithContextFunc != nil {
+		return m.GetChangesPersonWithContextFunc(ctx, urlOptions)
+	}
+	var r0 *ChangesPerson
+	return r0, fmt.Errorf("%w: ChangesService.GetChangesPersonWithContext", ErrNotStubbed)
+}
+
+// CollectionsServiceMock type is a CollectionsService recording its calls.
+//
+// Set the Func fields to stub the methods. A method without
+// a Func falls back to the Func of its WithContext variant,
+// then returns zero values and ErrNotStubbed.
+type CollectionsServiceMock struct {
+	MockRecorder
+
+	GetCollectionDetailsFunc                 func(int, map[string]string) (*CollectionDetails, error)
+	GetCollectionDetailsWithContextFunc      func(context.Context, int, map[string]string) (*CollectionDetails, error)
+	GetCollectionImagesFunc                  func(int, map[string]string) (*CollectionImages, error)
+	GetCollectionImagesWithContextFunc       func(context.Context, int, map[string]string) (*CollectionImages, error)
+	GetCollectionTranslationsFunc            func(int, map[string]string) (*CollectionTranslations, error)
+	GetCollectionTranslationsWithContextFunc func(context.Context, int, map[string]string) (*CollectionTranslations, error)
+}
+
+var _ CollectionsService = (*CollectionsServiceMock)(nil)
+
+// GetCollectionDetails records the call and calls GetCollectionDetailsFunc.
+func (m *CollectionsServiceMock) GetCollectionDetails(id int, urlOptions map[string]string) (*CollectionDetails, error) {
+	m.record("GetCollectionDetails", id, urlOptions)
+	if m.GetCollectionDetailsFunc != nil {
+		return m.GetCollectionDetailsFunc(id, urlOptions)
+	}
+	if m.GetCollectionDetailsWithContextFunc != nil {
+		return m.GetCollectionDetailsWithContextFunc(context.Background(), id, urlOptions)
+	}
+	var r0 *CollectionDetails
+	return r0, fmt.Errorf("%w: CollectionsService.GetCollectionDetails", ErrNotStubbed)
+}
+
+// GetCollectionDetailsWithContext records the call and calls GetCollectionDetailsWithContextFunc.
+func (m *CollectionsServiceMock) GetCollectionDetailsWithContext(ctx context.Context, id int, urlOptions map[string]string) (*CollectionDetails, error) {
+	m.record("GetCollectionDetailsWithContext", ctx, id, urlOptions)
+	if m.GetCollectionDetailsWithContextFunc != nil {
+		return m.GetCollectionDetailsWithContextFunc(ctx, id, urlOptions)
+	}
+	var r0 *CollectionDetails
+	return r0, fmt.Errorf("%w: CollectionsService.GetCollectionDetailsWithContext", ErrNotStubbed)
+}
+
+// GetCollectionImages records the call and calls GetCollectionImagesFunc.
+func (m *CollectionsServiceMock) GetCollectionImages(id int, urlOptions map[string]string) (*CollectionImages, error) {
+	m.record("GetCollectionImages", id, urlOptions)
+	if m.GetCollectionImagesFunc != nil {
+		return m.GetCollectionImagesFunc(id, urlOptions)
+	}
+	if m.GetCollectionImagesWithContextFunc != nil {
+		return m.GetCollectionImagesWithContextFunc(context.Background(), id, urlOptions)
+	}
+	var r0 *CollectionImages
+	return r0, fmt.Errorf("%w: CollectionsService.GetCollectionImages", ErrNotStubbed)
+}
+
+// GetCollectionImagesWithContext records the call and calls GetCollectionImagesWithContextFunc.
+func (m *CollectionsServiceMock) GetCollectionImagesWithContext(ctx context.Context, id int, urlOptions map[string]string) (*CollectionImages, error) {
+	m.record("GetCollectionImagesWithContext", ctx, id, urlOptions)
+	if m.GetCollectionImagesWithContextFunc != nil {
+		return m.GetCollectionImagesWithContextFunc(ctx, id, urlOptions)
+	}
+	var r0 *CollectionImages
+	return r0, fmt.Errorf("%w: CollectionsService.GetCollectionImagesWithContext", ErrNotStubbed)
+}
+
+// GetCollectionTranslations records the call and calls GetCollectionTranslationsFunc.
+func (m *CollectionsServiceMock) GetCollectionTranslations(id int, urlOptions map[string]string) (*CollectionTranslations, error) {
+	m.record("GetCollectionTranslations", id, urlOptions)
+	if m.GetCollectionTranslationsFunc != nil {
+		return m.GetCollectionTranslationsFunc(id, urlOptions)
+	}
+	if m.GetCollectionTranslationsWithContextFunc != nil {
+		return m.GetCollectionTranslationsWithContextFunc(context.Background(), id, urlOptions)
+	}
+	var r0 *CollectionTranslations
+	return r0, fmt.Errorf("%w: CollectionsService.GetCollectionTranslations", ErrNotStubbed)
+}
+
+// GetCollectionTranslationsWithContext records the call and calls GetCollectionTranslationsWithContextFunc.
+func (m *CollectionsServiceMock) GetCollectionTranslationsWithContext(ctx context.Context, id int, urlOptions map[string]string) (*CollectionTranslations, error) {
+	m.record("GetCollectionTranslationsWithContext", ctx, id, urlOptions)
+	if m.GetCollectionTranslationsWithContextFunc != nil {
+		return m.GetCollectionTranslationsWithContextFunc(ctx, id, urlOptions)
+	}
+	var r0 *CollectionTranslations
+	return r0, fmt.Errorf("%w: CollectionsService.GetCollectionTranslationsWithContext", ErrNotStubbed)
+}
+
+// CompaniesServiceMock type is a CompaniesService recording its calls.
+//
+// Set the Func fields to stub the methods. A method without
+// a Func falls back to the Func of its WithContext variant,
+// then returns zero values and ErrNotStubbed.
+type CompaniesServiceMock struct {
+	MockRecorder
+
+	GetCompanyDetailsFunc                     func(int) (*CompanyDetails, error)
+	GetCompanyDetailsWithContextFunc          func(context.Context, int) (*CompanyDetails, error)
+	GetCompanyAlternativeNamesFunc            func(int) (*CompanyAlternativeNames, error)
+	GetCompanyAlternativeNamesWithContextFunc func(context.Context, int) (*CompanyAlternativeNames, error)
+	GetCompanyImagesFunc                      func(int) (*CompanyImages, error)
+	GetCompanyImagesWithContextFunc           func(context.Context, int) (*CompanyImages, error)
+}
+
+var _ CompaniesService = (*CompaniesServiceMock)(nil)
+
+// GetCompanyDetails records the call and calls GetCompanyDetailsFunc.
+func (m *CompaniesServiceMock) GetCompanyDetails(id int) (*CompanyDetails, error) {
+	m.record("GetCompanyDetails", id)
+	if m.GetCompanyDetailsFunc != nil {
+		return m.GetCompanyDetailsFunc(id)
+	}
+	if m.GetCompanyDetailsWithContextFunc != nil {
+		return m.GetCompanyDetailsWithContextFunc(context.Background(), id)
+	}
+	var r0 *CompanyDetails
+	return r0, fmt.Errorf("%w: CompaniesService.GetCompanyDetails", ErrNotStubbed)
+}
+
+// GetCompanyDetailsWithContext records the call and calls GetCompanyDetailsWithContextFunc.
+func (m *CompaniesServiceMock) GetCompanyDetailsWithContext(ctx context.Context, id int) (*CompanyDetails, error) {
+	m.record("GetCompanyDetailsWithContext", ctx, id)
+	if m.GetCompanyDetailsWithContextFunc != nil {
+		return m.GetCompanyDetailsWithContextFunc(ctx, id)
+	}
+	var r0 *CompanyDetails
+	return r0, fmt.Errorf("%w: CompaniesService.GetCompanyDetailsWithContext", ErrNotStubbed)
+}
+
+// GetCompanyAlternativeNames records the call and calls GetCompanyAlternativeNamesFunc.
+func (m *CompaniesServiceMock) GetCompanyAlternativeNames(id int) (*CompanyAlternativeNames, error) {
+	m.record("GetCompanyAlternativeNames", id)
+	if m.GetCompanyAlternativeNamesFunc != nil {
+		return m.GetCompanyAlternativeNamesFunc(id)
+	}
+	if m.GetCompanyAlternativeNamesWithContextFunc != nil {
+		return m.GetCompanyAlternativeNamesWithContextFunc(context.Background(), id)
+	}
+	var r0 *CompanyAlternativeNames
+	return r0, fmt.Errorf("%w: CompaniesService.GetCompanyAlternativeNames", ErrNotStubbed)
+}
+
+// GetCompanyAlternativeNamesWithContext records the call and calls GetCompanyAlternativeNamesWithContextFunc.
+func (m *CompaniesServiceMock) GetCompanyAlternativeNamesWithContext(ctx context.Context, id int) (*CompanyAlternativeNames, error) {
+	m.record("GetCompanyAlternativeNamesWithContext", ctx, id)
+	if m.GetCompanyAlternativeNamesWithContextFunc != nil {
+		return m.GetCompanyAlternativeNamesWithContextFunc(ctx, id)
+	}
+	var r0 *CompanyAlternativeNames
+	return r0, fmt.Errorf("%w: CompaniesService.GetCompanyAlternativeNamesWithContext", ErrNotStubbed)
+}
+
+// GetCompanyImages records the call and calls GetCompanyImagesFunc.
+func (m *CompaniesServiceMock) GetCompanyImages(id int) (*CompanyImages, error) {
+	m.record("GetCompanyImages", id)
+	if m.GetCompanyImagesFunc != nil {
+		return m.GetCompanyImagesFunc(id)
+	}
+	if m.GetCompanyImagesWithContextFunc != nil {
+		return m.GetCompanyImagesWithContextFunc(context.Background(), id)
+	}
+	var r0 *CompanyImages
+	return r0, fmt.Errorf("%w: CompaniesService.GetCompanyImages", ErrNotStubbed)
+}
+
+// GetCompanyImagesWithContext records the call and calls GetCompanyImagesWithContextFunc.
+func (m *CompaniesServiceMock) GetCompanyImagesWithContext(ctx context.Context, id int) (*CompanyImages, error) {
+	m.record("GetCompanyImagesWithContext", ctx, id)
+	if m.GetCompanyImagesWithContextFunc != nil {
+		return m.GetCompanyImagesWithContextFunc(ctx, id)
+	}
+	var r0 *CompanyImages
+	return r0, fmt.Errorf("%w: CompaniesService.GetCompanyImagesWithContext", ErrNotStubbed)
+}
+
+// ConfigurationServiceMock type is a ConfigurationService recording its calls.
+//
+// Set the Func fields to stub the methods. A method without
+// a Func falls back to the Func of its WithContext variant,
+// then returns zero values and ErrNotStubbed.
+type ConfigurationServiceMock struct {
+	MockRecorder
+
+	GetConfigurationAPIFunc                            func() (*ConfigurationAPI, error)
+	GetConfigurationAPIWithContextFunc                 func(context.Context) (*ConfigurationAPI, error)
+	GetConfigurationCountriesFunc                      func() (*ConfigurationCountries, error)
+	GetConfigurationCountriesWithContextFunc           func(context.Context) (*ConfigurationCountries, error)
+	GetConfigurationJobsFunc                           func() (*ConfigurationJobs, error)
+	GetConfigurationJobsWithContextFunc                func(context.Context) (*ConfigurationJobs, error)
+	GetConfigurationLanguagesFunc                      func() (*ConfigurationLanguages, error)
+	GetConfigurationLanguagesWithContextFunc           func(context.Context) (*ConfigurationLanguages, error)
+	GetConfigurationPrimaryTranslationsFunc            func() (*ConfigurationPrimaryTranslations, error)
+	GetConfigurationPrimaryTranslationsWithContextFunc func(context.Context) (*ConfigurationPrimaryTranslations, error)
+	GetConfigurationTimezonesFunc                      func() (*ConfigurationTimezones, error)
+	GetConfigurationTimezonesWithContextFunc           func(context.Context) (*ConfigurationTimezones, error)
+}
+
+var _ ConfigurationService = (*ConfigurationServiceMock)(nil)
+
+// GetConfigurationAPI records the call and calls GetConfigurationAPIFunc.
+func (m *ConfigurationServiceMock) GetConfigurationAPI() (*ConfigurationAPI, error) {
+	m.record("GetConfigurationAPI")
+	if m.GetConfigurationAPIFunc != nil {
+		return m.GetConfigurationAPIFunc()
+	}
+	if m.GetConfigurationAPIWithContextFunc != nil {
+		return m.GetConfigurationAPIWithContextFunc(context.Background())
+	}
+	var r0 *ConfigurationAPI
+	return r0, fmt.Errorf("%w: ConfigurationService.GetConfigurationAPI", ErrNotStubbed)
+}
+
+// GetConfigurationAPIWithContext records the call and calls GetConfigurationAPIWithContextFunc.
+func (m *ConfigurationServiceMock) GetConfigurationAPIWithContext(ctx context.Context) (*ConfigurationAPI, error) {
+	m.record("GetConfigurationAPIWithContext", ctx)
+	if m.GetConfigurationAPIWithContextFunc != nil {
+		return m.GetConfigurationAPIWithContextFunc(ctx)
+	}
+	var r0 *ConfigurationAPI
+	return r0, fmt.Errorf("%w: ConfigurationService.GetConfigurationAPIWithContext", ErrNotStubbed)
+}
+
+// GetConfigurationCountries records the call and calls GetConfigurationCountriesFunc.
+func (m *ConfigurationServiceMock) GetConfigurationCountries() (*ConfigurationCountries, error) {
+	m.record("GetConfigurationCountries")
+	if m.GetConfigurationCountriesFunc != nil {
+		return m.GetConfigurationCountriesFunc()
+	}
+	if m.GetConfigurationCountriesWithContextFunc != nil {
+		return m.GetConfigurationCountriesWithContextFunc(context.Background())
+	}
+	var r0 *ConfigurationCountries
+	return r0, fmt.Errorf("%w: ConfigurationService.GetConfigurationCountries", ErrNotStubbed)
+}
+
+// GetConfigurationCountriesWithContext records the call and calls GetConfigurationCountriesWithContextFunc.
+func (m *ConfigurationServiceMock) GetConfigurationCountriesWithContext(ctx context.Context) (*ConfigurationCountries, error) {
+	m.record("GetConfigurationCountriesWithContext", ctx)
+	if m.GetConfigurationCountriesWithContextFunc != nil {
+		return m.GetConfigurationCountriesWithContextFunc(ctx)
+	}
+	var r0 *ConfigurationCountries
+	return r0, fmt.Errorf("%w: ConfigurationService.GetConfigurationCountriesWithContext", ErrNotStubbed)
+}
+
+// GetConfigurationJobs records the call and calls GetConfigurationJobsFunc.
+func (m *ConfigurationServiceMock) GetConfigurationJobs() (*ConfigurationJobs, error) {
+	m.record("GetConfigurationJobs")
+	if m.GetConfigurationJobsFunc != nil {
+		return m.GetConfigurationJobsFunc()
+	}
+	if m.GetConfigurationJobsWithContextFunc != nil {
+		return m.GetConfigurationJobsWithContextFunc(context.Background())
+	}
+	var r0 *ConfigurationJobs
+	return r0, fmt.Errorf("%w: ConfigurationService.GetConfigurationJobs", ErrNotStubbed)
+}
+
+// GetConfigurationJobsWithContext records the call and calls GetConfigurationJobsWithContextFunc.
+func (m *ConfigurationServiceMock) GetConfigurationJobsWithContext(ctx context.Context) (*ConfigurationJobs, error) {
+	m.record("GetConfigurationJobsWithContext", ctx)
+	if m.GetConfigurationJobsWithContextFunc != nil {
+		return m.GetConfigurationJobsWithContextFunc(ctx)
+	}
+	var r0 *ConfigurationJobs
+	return r0, fmt.Errorf("%w: ConfigurationService.GetConfigurationJobsWithContext", ErrNotStubbed)
+}
+
+// GetConfigurationLanguages records the call and calls GetConfigurationLanguagesFunc.
+func (m *ConfigurationServiceMock) GetConfigurationLanguages() (*ConfigurationLanguages, error) {
+	m.record("GetConfigurationLanguages")
+	if m.GetConfigurationLanguagesFunc != nil {
+		return m.GetConfigurationLanguagesFunc()
+	}
+	if m.GetConfigurationLanguagesWithContextFunc != nil {
+		return m.GetConfigurationLanguagesWithContextFunc(context.Background())
+	}
+	var r0 *ConfigurationLanguages
+	return r0, fmt.Errorf("%w: ConfigurationService.GetConfigurationLanguages", ErrNotStubbed)
+}
+
+// GetConfigurationLanguagesWithContext records the call and calls GetConfigurationLanguagesWithContextFunc.
+func (m *ConfigurationServiceMock) GetConfigurationLanguagesWithContext(ctx context.Context) (*ConfigurationLanguages, error) {
+	m.record("GetConfigurationLanguagesWithContext", ctx)
+	if m.GetConfigurationLanguagesWithContextFunc != nil {
+		return m.GetConfigurationLanguagesWithContextFunc(ctx)
+	}
+	var r0 *ConfigurationLanguages
+	return r0, fmt.Errorf("%w: ConfigurationService.GetConfigurationLanguagesWithContext", ErrNotStubbed)
+}
+
+// GetConfigurationPrimaryTranslations records the call and calls GetConfigurationPrimaryTranslationsFunc.
+func (m *ConfigurationServiceMock) GetConfigurationPrimaryTranslations() (*ConfigurationPrimaryTranslations, error) {
+	m.record("GetConfigurationPrimaryTranslations")
+	if m.GetConfigurationPrimaryTranslationsFunc != nil {
+		return m.GetConfigurationPrimaryTranslationsFunc()
+	}
+	if m.GetConfigurationPrimaryTranslationsWithContextFunc != nil {
+		return m.GetConfigurationPrimaryTranslationsWithContextFunc(context.Background())
+	}
+	var r0 *ConfigurationPrimaryTranslations
+	return r0, fmt.Errorf("%w: ConfigurationService.GetConfigurationPrimaryTranslations", ErrNotStubbed)
+}
+
+// GetConfigurationPrimaryTranslationsWithContext records the call and calls GetConfigurationPrimaryTranslationsWithContextFunc.
+func (m *ConfigurationServiceMock) GetConfigurationPrimaryTranslationsWithContext(ctx context.Context) (*ConfigurationPrimaryTranslations, error) {
+	m.record("GetConfigurationPrimaryTranslationsWithContext", ctx)
+	if m.GetConfigurationPrimaryTranslationsWithContextFunc != nil {
+		return m.GetConfigurationPrimaryTranslationsWithContextFunc(ctx)
+	}
+	var r0 *ConfigurationPrimaryTranslations
+	return r0, fmt.Errorf("%w: ConfigurationService.GetConfigurationPrimaryTranslationsWithContext", ErrNotStubbed)
+}
+
+// GetConfigurationTimezones records the call and calls GetConfigurationTimezonesFunc.
+func (m *ConfigurationServiceMock) GetConfigurationTimezones() (*ConfigurationTimezones, error) {
+	m.record("GetConfigurationTimezones")
+	if m.GetConfigurationTimezonesFunc != nil {
+		return m.GetConfigurationTimezonesFunc()
+	}
+	if m.GetConfigurationTimezonesWithContextFunc != nil {
+		return m.GetConfigurationTimezonesWithContextFunc(context.Background())
+	}
+	var r0 *ConfigurationTimezones
+	return r0, fmt.Errorf("%w: ConfigurationService.GetConfigurationTimezones", ErrNotStubbed)
+}
+
+// GetConfigurationTimezonesWithContext records the call and calls GetConfigurationTimezonesWithContextFunc.
+func (m *ConfigurationServiceMock) GetConfigurationTimezonesWithContext(ctx context.Context) (*ConfigurationTimezones, error) {
+	m.record("GetConfigurationTimezonesWithContext", ctx)
+	if m.GetConfigurationTimezonesWithContextFunc != nil {
+		return m.GetConfigurationTimezonesWithContextFunc(ctx)
+	}
+	var r0 *ConfigurationTimezones
+	return r0, fmt.Errorf("%w: ConfigurationService.GetConfigurationTimezonesWithContext", ErrNotStubbed)
+}
+
+// CreditsServiceMock type is a CreditsService recording its calls.
+//
+// Set the Func fields to stub the methods. A method without
+// a Func falls back to the Func of its WithContext variant,
+// then returns zero values and ErrNotStubbed.
+type CreditsServiceMock struct {
+	MockRecorder
+
+	GetCreditDetailsFunc            func(string) (*CreditsDetails, error)
+	GetCreditDetailsWithContextFunc func(context.Context, string) (*CreditsDetails, error)
+}
+
+var _ CreditsService = (*CreditsServiceMock)(nil)
+
+// GetCreditDetails records the call and calls GetCreditDetailsFunc.
+func (m *CreditsServiceMock) GetCreditDetails(id string) (*CreditsDetails, error) {
+	m.record("GetCreditDetails", id)
+	if m.GetCreditDetailsFunc != nil {
+		return m.GetCreditDetailsFunc(id)
+	}
+	if m.GetCreditDetailsWithContextFunc != nil {
+		return m.GetCreditDetailsWithContextFunc(context.Background(), id)
+	}
+	var r0 *CreditsDetails
+	return r0, fmt.Errorf("%w: CreditsService.GetCreditDetails", ErrNotStubbed)
+}
+
+// GetCreditDetailsWithContext records the call and calls GetCreditDetailsWithContextFunc.
+func (m *CreditsServiceMock) GetCreditDetailsWithContext(ctx context.Context, id string) (*CreditsDetails, error) {
+	m.record("GetCreditDetailsWithContext", ctx, id)
+	if m.GetCreditDetailsWithContextFunc != nil {
+		return m.GetCreditDetailsWithContextFunc(ctx, id)
+	}
+	var r0 *CreditsDetails
+	return r0, fmt.Errorf("%w: CreditsService.GetCreditDetailsWithContext", ErrNotStubbed)
+}
+
+// DiscoverServiceMock type is a DiscoverService recording its calls.
+//
+// Set the Func fields to stub the methods. A method without
+// a Func falls back to the Func of its WithContext variant,
+// then returns zero values and ErrNotStubbed.
+type DiscoverServiceMock struct {
+	MockRecorder
+
+	GetDiscoverMovieFunc                 func(map[string]string) (*DiscoverMovie, error)
+	GetDiscoverMovieWithContextFunc      func(context.Context, map[string]string) (*DiscoverMovie, error)
+	GetDiscoverTVFunc                    func(map[string]string) (*DiscoverTV, error)
+	GetDiscoverTVWithContextFunc         func(context.Context, map[string]string) (*DiscoverTV, error)
+	GetDiscoverMovieQueryFunc            func(*DiscoverMovieQuery) (*DiscoverMovie, error)
+	GetDiscoverMovieQueryWithContextFunc func(context.Context, *DiscoverMovieQuery) (*DiscoverMovie, error)
+	GetDiscoverTVQueryFunc               func(*DiscoverTVQuery) (*DiscoverTV, error)
+	GetDiscoverTVQueryWithContextFunc    func(context.Context, *DiscoverTVQuery) (*DiscoverTV, error)
+}
+
+var _ DiscoverService = (*DiscoverServiceMock)(nil)
+
+// GetDiscoverMovie records the call and calls GetDiscoverMovieFunc.
+func (m *DiscoverServiceMock) GetDiscoverMovie(urlOptions map[string]string) (*DiscoverMovie, error) {
+	m.record("GetDiscoverMovie", urlOptions)
+	if m.GetDiscoverMovieFunc != nil {
+		return m.GetDiscoverMovieFunc(urlOptions)
+	}
+	if m.GetDiscoverMovieWithContextFunc != nil {
+		return m.GetDiscoverMovieWithContextFunc(context.Background(), urlOptions)
+	}
+	var r0 *DiscoverMovie
+	return r0, fmt.Errorf("%w: DiscoverService.GetDiscoverMovie", ErrNotStubbed)
+}
+
+// GetDiscoverMovieWithContext records the call and calls GetDiscoverMovieWithContextFunc.
+func (m *DiscoverServiceMock) GetDiscoverMovieWithContext(ctx context.Context, urlOptions map[string]string) (*DiscoverMovie, error) {
+	m.record("GetDiscoverMovieWithContext", ctx, urlOptions)
+	if m.GetDiscoverMovieWithContextFunc != nil {
+		return m.GetDiscoverMovieWithContextFunc(ctx, urlOptions)
+	}
+	var r0 *DiscoverMovie
+	return r0, fmt.Errorf("%w: DiscoverService.GetDiscoverMovieWithContext", ErrNotStubbed)
+}
+
+// GetDiscoverTV records the call and calls GetDiscoverTVFunc.
+func (m *DiscoverServiceMock) GetDiscoverTV(urlOptions map[string]string) (*DiscoverTV, error) {
+	m.record("GetDiscoverTV", urlOptions)
+	if m.GetDiscoverTVFunc != nil {
+		return m.GetDiscoverTVFunc(urlOptions)
+	}
+	if m.GetDiscoverTVWithContextFunc != nil {
+		return m.GetDiscoverTVWithContextFunc(context.Background(), urlOptions)
+	}
+	var r0 *DiscoverTV
+	return r0, fmt.Errorf("%w: DiscoverService.GetDiscoverTV", ErrNotStubbed)
+}
+
+// GetDiscoverTVWithContext records the call and calls GetDiscoverTVWithContextFunc.
+func (m *DiscoverServiceMock) GetDiscoverTVWithContext(ctx context.Context, urlOptions map[string]string) (*DiscoverTV, error) {
+	m.record("GetDiscoverTVWithContext", ctx, urlOptions)
+	if m.GetDiscoverTVWithContextFunc != nil {
+		return m.GetDiscoverTVWithContextFunc(ctx, urlOptions)
+	}
+	var r0 *DiscoverTV
+	return r0, fmt.Errorf("%w: DiscoverService.GetDiscoverTVWithContext", ErrNotStubbed)
+}
+
+// GetDiscoverMovieQuery records the call and calls GetDiscoverMovieQueryFunc.
+func (m *DiscoverServiceMock) GetDiscoverMovieQuery(query *DiscoverMovieQuery) (*DiscoverMovie, error) {
+	m.record("GetDiscoverMovieQuery", query)
+	if m.GetDiscoverMovieQueryFunc != nil {
+		return m.GetDiscoverMovieQueryFunc(query)
+	}
+	if m.GetDiscoverMovieQueryWithContextFunc != nil {
+		return m.GetDiscoverMovieQueryWithContextFunc(context.Background(), query)
+	}
+	var r0 *DiscoverMovie
+	return r0, fmt.Errorf("%w: DiscoverService.GetDiscoverMovieQuery", ErrNotStubbed)
+}
+
+// GetDiscoverMovieQueryWithContext records the call and calls GetDiscoverMovieQueryWithContextFunc.
+func (m *DiscoverServiceMock) GetDiscoverMovieQueryWithContext(ctx context.Context, query *DiscoverMovieQuery) (*DiscoverMovie, error) {
+	m.record("GetDiscoverMovieQueryWithContext", ctx, query)
+	if m.GetDiscoverMovieQueryWithContextFunc != nil {
+		return m.GetDiscoverMovieQueryWithContextFunc(ctx, query)
+	}
+	var r0 *DiscoverMovie
+	return r0, fmt.Errorf("%w: DiscoverService.GetDiscoverMovieQueryWithContext", ErrNotStubbed)
+}
+
+// GetDiscoverTVQuery records the call and calls GetDiscoverTVQueryFunc.
+func (m *DiscoverServiceMock) GetDiscoverTVQuery(query *DiscoverTVQuery) (*DiscoverTV, error) {
+	m.record("GetDiscoverTVQuery", query)
+	if m.GetDiscoverTVQueryFunc != nil {
+		return m.GetDiscoverTVQueryFunc(query)
+	}
+	if m.GetDiscoverTVQueryWithContextFunc != nil {
+		return m.GetDiscoverTVQueryWithContextFunc(context.Background(), query)
+	}
+	var r0 *DiscoverTV
+	return r0, fmt.Errorf("%w: DiscoverService.GetDiscoverTVQuery", ErrNotStubbed)
+}
+
+// GetDiscoverTVQueryWithContext records the call and calls GetDiscoverTVQueryWithContextFunc.
+func (m *DiscoverServiceMock) GetDiscoverTVQueryWithContext(ctx context.Context, query *DiscoverTVQuery) (*DiscoverTV, error) {
+	m.record("GetDiscoverTVQueryWithContext", ctx, query)
+	if m.GetDiscoverTVQueryWithContextFunc != nil {
+		return m.GetDiscoverTVQueryWithContextFunc(ctx, query)
+	}
+	var r0 *DiscoverTV
+	return r0, fmt.Errorf("%w: DiscoverService.GetDiscoverTVQueryWithContext", ErrNotStubbed)
+}
+
+// FindServiceMock type is a FindService recording its calls.
+//
+// Set the Func fields to stub the methods. A method without
+// a Func falls back to the Func of its WithContext variant,
+// then returns zero values and ErrNotStubbed.
+type FindServiceMock struct {
+	MockRecorder
+
+	GetFindByIDFunc            func(string, map[string]string) (*FindByID, error)
+	GetFindByIDWithContextFunc func(context.Context, string, map[string]string) (*FindByID, error)
+}
+
+var _ FindService = (*FindServiceMock)(nil)
+
+// GetFindByID records the call and calls GetFindByIDFunc.
+func (m *FindServiceMock) GetFindByID(id string, urlOptions map[string]string) (*FindByID, error) {
+	m.record("GetFindByID", id, urlOptions)
+	if m.GetFindByIDFunc != nil {
+		return m.GetFindByIDFunc(id, urlOptions)
+	}
+	if m.GetFindByIDWithContextFunc != nil {
+		return m.GetFindByIDWithContextFunc(context.Background(), id, urlOptions)
+	}
+	var r0 *FindByID
+	return r0, fmt.Errorf("%w: FindService.GetFindByID", ErrNotStubbed)
+}
+
+// GetFindByIDWithContext records the call and calls GetFindByIDWithContextFunc.
+func (m *FindServiceMock) GetFindByIDWithContext(ctx context.Context, id string, urlOptions map[string]string) (*FindByID, error) {
+	m.record("GetFindByIDWithContext", ctx, id, urlOptions)
+	if m.GetFindByIDWithContextFunc != nil {
+		return m.GetFindByIDWithContextFunc(ctx, id, urlOptions)
+	}
+	var r0 *FindByID
+	return r0, fmt.Errorf("%w: FindService.GetFindByIDWithContext", ErrNotStubbed)
+}
+
+// GenresServiceMock type is a GenresService recording its calls.
+//
+// Set the Func fields to stub the methods. A method without
+// a Func falls back to the Func of its WithContext variant,
+// then returns zero values and ErrNotStubbed.
+type GenresServiceMock struct {
+	MockRecorder
+
+	GetGenreMovieListFunc            func(map[string]string) (*GenreMovieList, error)
+	GetGenreMovieListWithContextFunc func(context.Context, map[string]string) (*GenreMovieList, error)
+	GetGenreTVListFunc               func(map[string]string) (*GenreMovieList, error)
+	GetGenreTVListWithContextFunc    func(context.Context, map[string]string) (*GenreMovieList, error)
+}
+
+var _ GenresService = (*GenresServiceMock)(nil)
+
+// GetGenreMovieList records the call and calls GetGenreMovieListFunc.
+func (m *GenresServiceMock) GetGenreMovieList(urlOptions map[string]string) (*GenreMovieList, error) {
+	m.record("GetGenreMovieList", urlOptions)
+	if m.GetGenreMovieListFunc != nil {
+		return m.GetGenreMovieListFunc(urlOptions)
+	}
+	if m.GetGenreMovieListWithContextFunc != nil {
+		return m.GetGenreMovieListWithContextFunc(context.Background(), urlOptions)
+	}
+	var r0 *GenreMovieList
+	return r0, fmt.Errorf("%w: GenresService.GetGenreMovieList", ErrNotStubbed)
+}
+
+// GetGenreMovieListWithContext records the call and calls GetGenreMovieListWithContextFunc.
+func (m *GenresServiceMock) GetGenreMovieListWithContext(ctx context.Context, urlOptions map[string]string) (*GenreMovieList, error) {
+	m.record("GetGenreMovieListWithContext", ctx, urlOptions)
+	if m.GetGenreMovieListWithContextFunc != nil {
+		return m.GetGenreMovieListWithContextFunc(ctx, urlOptions)
+	}
+	var r0 *GenreMovieList
+	return r0, fmt.Errorf("%w: GenresService.GetGenreMovieListWithContext", ErrNotStubbed)
+}
+
+// GetGenreTVList records the call and calls GetGenreTVListFunc.
+func (m *GenresServiceMock) GetGenreTVList(urlOptions map[string]string) (*GenreMovieList, error) {
+	m.record("GetGenreTVList", urlOptions)
+	if m.GetGenreTVListFunc != nil {
+		return m.GetGenreTVListFunc(urlOptions)
+	}
+	if m.GetGenreTVListWithContextFunc != nil {
+		return m.GetGenreTVListWithContextFunc(context.Background(), urlOptions)
+	}
+	var r0 *GenreMovieList
+	return r0, fmt.Errorf("%w: GenresService.GetGenreTVList", ErrNotStubbed)
+}
+
+// GetGenreTVListWithContext records the call and calls GetGenreTVListWithContextFunc.
+func (m *GenresServiceMock) GetGenreTVListWithContext(ctx context.Context, urlOptions map[string]string) (*GenreMovieList, error) {
+	m.record("GetGenreTVListWithContext", ctx, urlOptions)
+	if m.GetGenreTVListWithContextFunc != nil {
+		return m.GetGenreTVListWithContextFunc(ctx, urlOptions)
+	}
+	var r0 *GenreMovieList
+	return r0, fmt.Errorf("%w: GenresService.GetGenreTVListWithContext", ErrNotStubbed)
+}
+
+// GuestSessionsServiceMock type is a GuestSessionsService recording its calls.
+//
+// Set the Func fields to stub the methods. A method without
+// a Func falls back to the Func of its WithContext variant,
+// then returns zero values and ErrNotStubbed.
+type GuestSessionsServiceMock struct {
+	MockRecorder
+
+	GetGuestSessionRatedMoviesFunc                func(string, map[string]string) (*GuestSessionRatedMovies, error)
+	GetGuestSessionRatedMoviesWithContextFunc     func(context.Context, string, map[string]string) (*GuestSessionRatedMovies, error)
+	GetGuestSessionRatedTVShowsFunc               func(string, map[string]string) (*GuestSessionRatedTVShows, error)
+	GetGuestSessionRatedTVShowsWithContextFunc    func(context.Context, string, map[string]string) (*GuestSessionRatedTVShows, error)
+	GetGuestSessionRatedTVEpisodesFunc            func(string, map[string]string) (*GuestSessionRatedTVEpisodes, error)
+	GetGuestSessionRatedTVEpisodesWithContextFunc func(context.Context, string, map[string]string) (*GuestSessionRatedTVEpisodes, error)
+}
+
+var _ GuestSessionsService = (*GuestSessionsServiceMock)(nil)
+
+// GetGuestSessionRatedMovies records the call and calls GetGuestSessionRatedMoviesFunc.
+func (m *GuestSessionsServiceMock) GetGuestSessionRatedMovies(id string, urlOptions map[string]string) (*GuestSessionRatedMovies, error) {
+	m.record("GetGuestSessionRatedMovies", id, urlOptions)
+	if m.GetGuestSessionRatedMoviesFunc != nil {
+		return m.GetGuestSessionRatedMoviesFunc(id, urlOptions)
+	}
+	if m.GetGuestSessionRatedMoviesWithContextFunc != nil {
+		return m.GetGuestSessionRatedMoviesWithContextFunc(context.Background(), id, urlOptions)
+	}
+	var r0 *GuestSessionRatedMovies
+	return r0, fmt.Errorf("%w: GuestSessionsService.GetGuestSessionRatedMovies", ErrNotStubbed)
+}
+
+// GetGuestSessionRatedMoviesWithContext records the call and calls GetGuestSessionRatedMoviesWithContextFunc.
+func (m *GuestSessionsServiceMock) GetGuestSessionRatedMoviesWithContext(ctx context.Context, id string, urlOptions map[string]string) (*GuestSessionRatedMovies, error) {
+	m.record("GetGuestSessionRatedMoviesWithContext", ctx, id, urlOptions)
+	if m.GetGuestSessionRatedMoviesWithContextFunc != nil {
+		return m.GetGuestSessionRatedMoviesWithContextFunc(ctx, id, urlOptions)
+	}
+	var r0 *GuestSessionRatedMovies
+	return r0, fmt.Errorf("%w: GuestSessionsService.GetGuestSessionRatedMoviesWithContext", ErrNotStubbed)
+}
+
+// GetGuestSessionRatedTVShows records the call and calls GetGuestSessionRatedTVShowsFunc.
+func (m *GuestSessionsServiceMock) GetGuestSessionRatedTVShows(id string, urlOptions map[string]string) (*GuestSessionRatedTVShows, error) {
+	m.record("GetGuestSessionRatedTVShows", id, urlOptions)
+	if m.GetGuestSessionRatedTVShowsFunc != nil {
+		return m.GetGuestSessionRatedTVShowsFunc(id, urlOptions)
+	}
+	if m.GetGuestSessionRatedTVShowsWithContextFunc != nil {
+		return m.GetGuestSessionRatedTVShowsWithContextFunc(context.Background(), id, urlOptions)
+	}
+	var r0 *GuestSessionRatedTVShows
+	return r0, fmt.Errorf("%w: GuestSessionsService.GetGuestSessionRatedTVShows", ErrNotStubbed)
+}
+
+// GetGuestSessionRatedTVShowsWithContext records the call and calls GetGuestSessionRatedTVShowsWithContextFunc.
+func (m *GuestSessionsServiceMock) GetGuestSessionRatedTVShowsWithContext(ctx context.Context, id string, urlOptions map[string]string) (*GuestSessionRatedTVShows, error) {
+	m.record("GetGuestSessionRatedTVShowsWithContext", ctx, id, urlOptions)
+	if m.GetGuestSessionRatedTVShowsWithContextFunc != nil {
+		return m.GetGuestSessionRatedTVShowsWithContextFunc(ctx, id, urlOptions)
+	}
+	var r0 *GuestSessionRatedTVShows
+	return r0, fmt.Errorf("%w: GuestSessionsService.GetGuestSessionRatedTVShowsWithContext", ErrNotStubbed)
+}
+
+// GetGuestSessionRatedTVEpisodes records the call and calls GetGuestSessionRatedTVEpisodesFunc.
+func (m *GuestSessionsServiceMock) GetGuestSessionRatedTVEpisodes(id string, urlOptions map[string]string) (*GuestSessionRatedTVEpisodes, error) {
+	m.record("GetGuestSessionRatedTVEpisodes", id, urlOptions)
+	if m.GetGuestSessionRatedTVEpisodesFunc != nil {
+		return m.GetGuestSessionRatedTVEpisodesFunc(id, urlOptions)
+	}
+	if m.GetGuestSessionRatedTVEpisodesWithContextFunc != nil {
+		return m.GetGuestSessionRatedTVEpisodesWithContextFunc(context.Background(), id, urlOptions)
+	}
+	var r0 *GuestSessionRatedTVEpisodes
+	return r0, fmt.Errorf("%w: GuestSessionsService.GetGuestSessionRatedTVEpisodes", ErrNotStubbed)
+}
+
+// GetGuestSessionRatedTVEpisodesWithContext records the call and calls GetGuestSessionRatedTVEpisodesWithContextFunc.
+func (m *GuestSessionsServiceMock) GetGuestSessionRatedTVEpisodesWithContext(ctx context.Context, id string, urlOptions map[string]string) (*GuestSessionRatedTVEpisodes, error) {
+	m.record("GetGuestSessionRatedTVEpisodesWithContext", ctx, id, urlOptions)
+	if m.GetGuestSessionRatedTVEpisodesWithContextFunc != nil {
+		return m.GetGuestSessionRatedTVEpisodesWithContextFunc(ctx, id, urlOptions)
+	}
+	var r0 *GuestSessionRatedTVEpisodes
+	return r0, fmt.Errorf("%w: GuestSessionsService.GetGuestSessionRatedTVEpisodesWithContext", ErrNotStubbed)
+}
+
+// KeywordsServiceMock type is a KeywordsService recording its calls.
+//
+// Set the Func fields to stub the methods. A method without
+// a Func falls back to the Func of its WithContext variant,
+// then returns zero values and ErrNotStubbed.
+type KeywordsServiceMock struct {
+	MockRecorder
+
+	GetKeywordDetailsFunc            func(int) (*KeywordDetails, error)
+	GetKeywordDetailsWithContextFunc func(context.Context, int) (*KeywordDetails, error)
+	GetKeywordMoviesFunc             func(int, map[string]string) (*KeywordMovies, error)
+	GetKeywordMoviesWithContextFunc  func(context.Context, int, map[string]string) (*KeywordMovies, error)
+}
+
+var _ KeywordsService = (*KeywordsServiceMock)(nil)
+
+// GetKeywordDetails records the call and calls GetKeywordDetailsFunc.
+func (m *KeywordsServiceMock) GetKeywordDetails(id int) (*KeywordDetails, error) {
+	m.record("GetKeywordDetails", id)
+	if m.GetKeywordDetailsFunc != nil {
+		return m.GetKeywordDetailsFunc(id)
+	}
+	if m.GetKeywordDetailsWithContextFunc != nil {
+		return m.GetKeywordDetailsWithContextFunc(context.Background(), id)
+	}
+	var r0 *KeywordDetails
+	return r0, fmt.Errorf("%w: KeywordsService.GetKeywordDetails", ErrNotStubbed)
+}
+
+// GetKeywordDetailsWithContext records the call and calls GetKeywordDetailsWithContextFunc.
+func (m *KeywordsServiceMock) GetKeywordDetailsWithContext(ctx context.Context, id int) (*KeywordDetails, error) {
+	m.record("GetKeywordDetailsWithContext", ctx, id)
+	if m.GetKeywordDetailsWithContextFunc != nil {
+		return m.GetKeywordDetailsWithContextFunc(ctx, id)
+	}
+	var r0 *KeywordDetails
+	return r0, fmt.Errorf("%w: KeywordsService.GetKeywordDetailsWithContext", ErrNotStubbed)
+}
+
+// GetKeywordMovies records the call and calls GetKeywordMoviesFunc.
+func (m *KeywordsServiceMock) GetKeywordMovies(id int, urlOptions map[string]string) (*KeywordMovies, error) {
+	m.record("GetKeywordMovies", id, urlOptions)
+	if m.GetKeywordMoviesFunc != nil {
+		return m.GetKeywordMoviesFunc(id, urlOptions)
+	}
+	if m.GetKeywordMoviesWithContextFunc != nil {
+		return m.GetKeywordMoviesWithContextFunc(context.Background(), id, urlOptions)
+	}
+	var r0 *KeywordMovies
+	return r0, fmt.Errorf("%w: KeywordsService.GetKeywordMovies", ErrNotStubbed)
+}
+
+// GetKeywordMoviesWithContext records the call and calls GetKeywordMoviesWithContextFunc.
+func (m *KeywordsServiceMock) GetKeywordMoviesWithContext(ctx context.Context, id int, urlOptions map[string]string) (*KeywordMovies, error) {
+	m.record("GetKeywordMoviesWithContext", ctx, id, urlOptions)
+	if m.GetKeywordMoviesWithContextFunc != nil {
+		return m.GetKeywordMoviesWithContextFunc(ctx, id, urlOptions)
+	}
+	var r0 *KeywordMovies
+	return r0, fmt.Errorf("%w: KeywordsService.GetKeywordMoviesWithContext", ErrNotStubbed)
+}
+
+// ListsServiceMock type is a ListsService recording its calls.
+//
+// Set the Func fields to stub the methods. A method without
+// a Func falls back to the Func of its WithContext variant,
+// then returns zero values and ErrNotStubbed.
+type ListsServiceMock struct {
+	MockRecorder
+
+	GetListDetailsFunc               func(int64, map[string]string) (*ListDetails, error)
+	GetListDetailsWithContextFunc    func(context.Context, int64, map[string]string) (*ListDetails, error)
+	GetListItemStatusFunc            func(int64, map[string]string) (*ListItemStatus, error)
+	GetListItemStatusWithContextFunc func(context.Context, int64, map[string]string) (*ListItemStatus, error)
+	CreateListFunc                   func(*ListCreate) (*ListResponse, error)
+	CreateListWithContextFunc        func(context.Context, *ListCreate) (*ListResponse, error)
+	AddMovieFunc                     func(int, *ListMedia) (*Response, error)
+	AddMovieWithContextFunc          func(context.Context, int, *ListMedia) (*Response, error)
+	RemoveMovieFunc                  func(int, *ListMedia) (*Response, error)
+	RemoveMovieWithContextFunc       func(context.Context, int, *ListMedia) (*Response, error)
+	ClearListFunc                    func(int, bool) (*Response, error)
+	ClearListWithContextFunc         func(context.Context, int, bool) (*Response, error)
+	DeleteListFunc                   func(int) (*Response, error)
+	DeleteListWithContextFunc        func(context.Context, int) (*Response, error)
+}
+
+var _ ListsService = (*ListsServiceMock)(nil)
+
+// GetListDetails records the call and calls GetListDetailsFunc.
+func (m *ListsServiceMock) GetListDetails(id int64, urlOptions map[string]string) (*ListDetails, error) {
+	m.record("GetListDetails", id, urlOptions)
+	if m.GetListDetailsFunc != nil {
+		return m.GetListDetailsFunc(id, urlOptions)
+	}
+	if m.GetListDetailsWithContextFunc != nil {
+		return m.GetListDetailsWithContextFunc(context.Background(), id, urlOptions)
+	}
+	var r0 *ListDetails
+	return r0, fmt.Errorf("%w: ListsService.GetListDetails", ErrNotStubbed)
+}
+
+// GetListDetailsWithContext records the call and calls GetListDetailsWithContextFunc.
+func (m *ListsServiceMock) GetListDetailsWithContext(ctx context.Context, id int64, urlOptions map[string]string) (*ListDetails, error) {
+	m.record("GetListDetailsWithContext", ctx, id, urlOptions)
+	if m.GetListDetailsWithContextFunc != nil {
+		return m.GetListDetailsWithContextFunc(ctx, id, urlOptions)
+	}
+	var r0 *ListDetails
+	return r0, fmt.Errorf("%w: ListsService.GetListDetailsWithContext", ErrNotStubbed)
+}
+
+// GetListItemStatus records the call and calls GetListItemStatusFunc.
+func (m *ListsServiceMock) GetListItemStatus(id int64, urlOptions map[string]string) (*ListItemStatus, error) {
+	m.record("GetListItemStatus", id, urlOptions)
+	if m.GetListItemStatusFunc != nil {
+		return m.GetListItemStatusFunc(id, urlOptions)
+	}
+	if m.GetListItemStatusWithContextFunc != nil {
+		return m.GetListItemStatusWithContextFunc(context.Background(), id, urlOptions)
+	}
+	var r0 *ListItemStatus
+	return r0, fmt.Errorf("%w: ListsService.GetListItemStatus", ErrNotStubbed)
+}
+
+// GetListItemStatusWithContext records the call and calls GetListItemStatusWithContextFunc.
+func (m *ListsServiceMock) GetListItemStatusWithContext(ctx context.Context, id int64, urlOptions map[string]string) (*ListItemStatus, error) {
+	m.record("GetListItemStatusWithContext", ctx, id, urlOptions)
+	if m.GetListItemStatusWithContextFunc != nil {
+		return m.GetListItemStatusWithContextFunc(ctx, id, urlOptions)
+	}
+	var r0 *ListItemStatus
+	return r0, fmt.Errorf("%w: ListsService.GetListItemStatusWithContext", ErrNotStubbed)
+}
+
+// CreateList records the call and calls CreateListFunc.
+func (m *ListsServiceMock) CreateList(list *ListCreate) (*ListResponse, error) {
+	m.record("CreateList", list)
+	if m.CreateListFunc != nil {
+		return m.CreateListFunc(list)
+	}
+	if m.CreateListWithContextFunc != nil {
+		return m.CreateListWithContextFunc(context.Background(), list)
+	}
+	var r0 *ListResponse
+	return r0, fmt.Errorf("%w: ListsService.CreateList", ErrNotStubbed)
+}
+
+// CreateListWithContext records the call and calls CreateListWithContextFunc.
+func (m *ListsServiceMock) CreateListWithContext(ctx context.Context, list *ListCreate) (*ListResponse, error) {
+	m.record("CreateListWithContext", ctx, list)
+	if m.CreateListWithContextFunc != nil {
+		return m.CreateListWithContextFunc(ctx, list)
+	}
+	var r0 *ListResponse
+	return r0, fmt.Errorf("%w: ListsService.CreateListWithContext", ErrNotStubbed)
+}
+
+// AddMovie records the call and calls AddMovieFunc.
+func (m *ListsServiceMock) AddMovie(listID int, mediaID *ListMedia) (*Response, error) {
+	m.record("AddMovie", listID, mediaID)
+	if m.AddMovieFunc != nil {
+		return m.AddMovieFunc(listID, mediaID)
+	}
+	if m.AddMovieWithContextFunc != nil {
+		return m.AddMovieWithContextFunc(context.Background(), listID, mediaID)
+	}
+	var r0 *Response
+	return r0, fmt.Errorf("%w: ListsService.AddMovie", ErrNotStubbed)
+}
+
+// AddMovieWithContext records the call and calls AddMovieWithContextFunc.
+func (m *ListsServiceMock) AddMovieWithContext(ctx context.Context, listID int, mediaID *ListMedia) (*Response, error) {
+	m.record("AddMovieWithContext", ctx, listID, mediaID)
+	if m.AddMovieWithContextFunc != nil {
+		return m.AddMovieWithContextFunc(ctx, listID, mediaID)
+	}
+	var r0 *Response
+	return r0, fmt.Errorf("%w: ListsService.AddMovieWithContext", ErrNotStubbed)
+}
+
+// RemoveMovie records the call and calls RemoveMovieFunc.
+func (m *ListsServiceMock) RemoveMovie(listID int, mediaID *ListMedia) (*Response, error) {
+	m.record("RemoveMovie", listID, mediaID)
+	if m.RemoveMovieFunc != nil {
+		return m.RemoveMovieFunc(listID, mediaID)
+	}
+	if m.RemoveMovieWithContextFunc != nil {
+		return m.RemoveMovieWithContextFunc(context.Background(), listID, mediaID)
+	}
+	var r0 *Response
+	return r0, fmt.Errorf("%w: ListsService.RemoveMovie", ErrNotStubbed)
+}
+
+// RemoveMovieWithContext records the call and calls RemoveMovieWithContextFunc.
+func (m *ListsServiceMock) RemoveMovieWithContext(ctx context.Context, listID int, mediaID *ListMedia) (*Response, error) {
+	m.record("RemoveMovieWithContext", ctx, listID, mediaID)
+	if m.RemoveMovieWithContextFunc != nil {
+		return m.RemoveMovieWithContextFunc(ctx, listID, mediaID)
+	}
+	var r0 *Response
+	return r0, fmt.Errorf("%w: ListsService.RemoveMovieWithContext", ErrNotStubbed)
+}
+
+// ClearList records the call and calls ClearListFunc.
+func (m *ListsServiceMock) ClearList(listID int, confirm bool) (*Response, error) {
+	m.record("ClearList", listID, confirm)
+	if m.ClearListFunc != nil {
+		return m.ClearListFunc(listID, confirm)
+	}
+	if m.ClearListWithContextFunc != nil {
+		return m.ClearListWithContextFunc(context.Background(), listID, confirm)
+	}
+	var r0 *Response
+	return r0, fmt.Errorf("%w: ListsService.ClearList", ErrNotStubbed)
+}
+
+// ClearListWithContext records the call and calls ClearListWithContextFunc.
+func (m *ListsServiceMock) ClearListWithContext(ctx context.Context, listID int, confirm bool) (*Response, error) {
+	m.record("ClearListWithContext", ctx, listID, confirm)
+	if m.ClearListWithContextFunc != nil {
+		return m.ClearListWithContextFunc(ctx, listID, confirm)
+	}
+	var r0 *Response
+	return r0, fmt.Errorf("%w: ListsService.ClearListWithContext", ErrNotStubbed)
+}
+
+// DeleteList records the call and calls DeleteListFunc.
+func (m *ListsServiceMock) DeleteList(listID int) (*Response, error) {
+	m.record("DeleteList", listID)
+	if m.DeleteListFunc != nil {
+		return m.DeleteListFunc(listID)
+	}
+	if m.DeleteListWithContextFunc != nil {
+		return m.DeleteListWithContextFunc(context.Background(), listID)
+	}
+	var r0 *Response
+	return r0, fmt.Errorf("%w: ListsService.DeleteList", ErrNotStubbed)
+}
+
+// DeleteListWithContext records the call and calls DeleteListWithContextFunc.
+func (m *ListsServiceMock) DeleteListWithContext(ctx context.Context, listID int) (*Response, error) {
+	m.record("DeleteListWithContext", ctx, listID)
+	if m.DeleteListWithContextFunc != nil {
+		return m.DeleteListWithContextFunc(ctx, listID)
+	}
+	var r0 *Response
+	return r0, fmt.Errorf("%w: ListsService.DeleteListWithContext", ErrNotStubbed)
+}
+
+// ListsV4ServiceMock type is a ListsV4Service recording its calls.
+//
+// Set the Func fields to stub the methods. A method without
+// a Func falls back to the Func of its WithContext variant,
+// then returns zero values and ErrNotStubbed.
+type ListsV4ServiceMock struct {
+	MockRecorder
+
+	GetListV4DetailsFunc               func(int64, map[string]string) (*ListV4Details, error)
+	GetListV4DetailsWithContextFunc    func(context.Context, int64, map[string]string) (*ListV4Details, error)
+	AllListV4ItemsFunc                 func(context.Context, int64, map[string]string, PaginateOptions) iter.Seq2[ListV4Result, error]
+	GetListV4ItemStatusFunc            func(int64, int64, string) (*ListV4ItemStatus, error)
+	GetListV4ItemStatusWithContextFunc func(context.Context, int64, int64, string) (*ListV4ItemStatus, error)
+	CreateListV4Func                   func(*ListV4Create) (*ListV4Response, error)
+	CreateListV4WithContextFunc        func(context.Context, *ListV4Create) (*ListV4Response, error)
+	UpdateListV4Func                   func(int64, *ListV4Update) (*ListV4Response, error)
+	UpdateListV4WithContextFunc        func(context.Context, int64, *ListV4Update) (*ListV4Response, error)
+	ClearListV4Func                    func(int64) (*ListV4ClearResponse, error)
+	ClearListV4WithContextFunc         func(context.Context, int64) (*ListV4ClearResponse, error)
+	DeleteListV4Func                   func(int64) (*ListV4Response, error)
+	DeleteListV4WithContextFunc        func(context.Context, int64) (*ListV4Response, error)
+	AddListV4ItemsFunc                 func(int64, []ListV4Item) (*ListV4ItemsResponse, error)
+	AddListV4ItemsWithContextFunc      func(context.Context, int64, []ListV4Item) (*ListV4ItemsResponse, error)
+	UpdateListV4ItemsFunc              func(int64, []ListV4Item) (*ListV4ItemsResponse, error)
+	UpdateListV4ItemsWithContextFunc   func(context.Context, int64, []ListV4Item) (*ListV4ItemsResponse, error)
+	RemoveListV4ItemsFunc              func(int64, []ListV4Item) (*ListV4ItemsResponse, error)
+	RemoveListV4ItemsWithContextFunc   func(context.Context, int64, []ListV4Item) (*ListV4ItemsResponse, error)
+}
+
+var _ ListsV4Service = (*ListsV4ServiceMock)(nil)
+
+// GetListV4Details records the call and calls GetListV4DetailsFunc.
+func (m *ListsV4ServiceMock) GetListV4Details(listID int64, urlOptions map[string]string) (*ListV4Details, error) {
+	m.record("GetListV4Details", listID, urlOptions)
+	if m.GetListV4DetailsFunc != nil {
+		return m.GetListV4DetailsFunc(listID, urlOptions)
+	}
+	if m.GetListV4DetailsWithContextFunc != nil {
+		return m.GetListV4DetailsWithContextFunc(context.Background(), listID, urlOptions)
+	}
+	var r0 *ListV4Details
+	return r0, fmt.Errorf("%w: ListsV4Service.GetListV4Details", ErrNotStubbed)
+}
+
+// GetListV4DetailsWithContext records the call and calls GetListV4DetailsWithContextFunc.
+func (m *ListsV4ServiceMock) GetListV4DetailsWithContext(ctx context.Context, listID int64, urlOptions map[string]string) (*ListV4Details, error) {
+	m.record("GetListV4DetailsWithContext", ctx, listID, urlOptions)
+	if m.GetListV4DetailsWithContextFunc != nil {
+		return m.GetListV4DetailsWithContextFunc(ctx, listID, urlOptions)
+	}
+	var r0 *ListV4Details
+	return r0, fmt.Errorf("%w: ListsV4Service.GetListV4DetailsWithContext", ErrNotStubbed)
+}
+
+// AllListV4Items records the call and calls AllListV4ItemsFunc.
+func (m *ListsV4ServiceMock) AllListV4Items(ctx context.Context, listID int64, urlOptions map[string]string, opts PaginateOptions) iter.Seq2[ListV4Result, error] {
+	m.record("AllListV4Items", ctx, listID, urlOptions, opts)
+	if m.AllListV4ItemsFunc != nil {
+		return m.AllListV4ItemsFunc(ctx, listID, urlOptions, opts)
+	}
+	return func(yield func(ListV4Result, error) bool) {
+		var zero ListV4Result
+		yield(zero, fmt.Errorf("%w: ListsV4Service.AllListV4Items", ErrNotStubbed))
+	}
+}
+
+// GetListV4ItemStatus records the call and calls GetListV4ItemStatusFunc.
+func (m *ListsV4ServiceMock) GetListV4ItemStatus(listID int64, mediaID int64, mediaType string) (*ListV4ItemStatus, error) {
+	m.record("GetListV4ItemStatus", listID, mediaID, mediaType)
+	if m.GetListV4ItemStatusFunc != nil {
+		return m.GetListV4ItemStatusFunc(listID, mediaID, mediaType)
+	}
+	if m.GetListV4ItemStatusWithContextFunc != nil {
+		return m.GetListV4ItemStatusWithContextFunc(context.Background(), listID, mediaID, mediaType)
+	}
+	var r0 *ListV4ItemStatus
+	return r0, fmt.Errorf("%w: ListsV4Service.GetListV4ItemStatus", ErrNotStubbed)
+}
+
+// GetListV4ItemStatusWithContext records the call and calls GetListV4ItemStatusWithContextFunc.
+func (m *ListsV4ServiceMock) GetListV4ItemStatusWithContext(ctx context.Context, listID int64, mediaID int64, mediaType string) (*ListV4ItemStatus, error) {
+	m.record("GetListV4ItemStatusWithContext", ctx, listID, mediaID, mediaType)
+	if m.GetListV4ItemStatusWithContextFunc != nil {
+		return m.GetListV4ItemStatusWithContextFunc(ctx, listID, mediaID, mediaType)
+	}
+	var r0 *ListV4ItemStatus
+	return r0, fmt.Errorf("%w: ListsV4Service.GetListV4ItemStatusWithContext", ErrNotStubbed)
+}
+
+// CreateListV4 records the call and calls CreateListV4Func.
+func (m *ListsV4ServiceMock) CreateListV4(list *ListV4Create) (*ListV4Response, error) {
+	m.record("CreateListV4", list)
+	if m.CreateListV4Func != nil {
+		return m.CreateListV4Func(list)
+	}
+	if m.CreateListV4WithContextFunc != nil {
+		return m.CreateListV4WithContextFunc(context.Background(), list)
+	}
+	var r0 *ListV4Response
+	return r0, fmt.Errorf("%w: ListsV4Service.CreateListV4", ErrNotStubbed)
+}
+
+// CreateListV4WithContext records the call and calls CreateListV4WithContextFunc.
+func (m *ListsV4ServiceMock) CreateListV4WithContext(ctx context.Context, list *ListV4Create) (*ListV4Response, error) {
+	m.record("CreateListV4WithContext", ctx, list)
+	if m.CreateListV4WithContextFunc != nil {
+		return m.CreateListV4WithContextFunc(ctx, list)
+	}
+	var r0 *ListV4Response
+	return r0, fmt.Errorf("%w: ListsV4Service.CreateListV4WithContext", ErrNotStubbed)
+}
+
+// UpdateListV4 records the call and calls UpdateListV4Func.
+func (m *ListsV4ServiceMock) UpdateListV4(listID int64, list *ListV4Update) (*ListV4Response, error) {
+	m.record("UpdateListV4", listID, list)
+	if m.UpdateListV4Func != nil {
+		return m.UpdateListV4Func(listID, list)
+	}
+	if m.UpdateListV4WithContextFunc != nil {
+		return m.UpdateListV4WithContextFunc(context.Background(), listID, list)
+	}
+	var r0 *ListV4Response
+	return r0, fmt.Errorf("%w: ListsV4Service.UpdateListV4", ErrNotStubbed)
+}
+
+// UpdateListV4WithContext records the call and calls UpdateListV4WithContextFunc.
+func (m *ListsV4ServiceMock) UpdateListV4WithContext(ctx context.Context, listID int64, list *ListV4Update) (*ListV4Response, error) {
+	m.record("UpdateListV4WithContext", ctx, listID, list)
+	if m.UpdateListV4WithContextFunc != nil {
+		return m.UpdateListV4WithContextFunc(ctx, listID, list)
+	}
+	var r0 *ListV4Response
+	return r0, fmt.Errorf("%w: ListsV4Service.UpdateListV4WithContext", ErrNotStubbed)
+}
+
+// ClearListV4 records the call and calls ClearListV4Func.
+func (m *ListsV4ServiceMock) ClearListV4(listID int64) (*ListV4ClearResponse, error) {
+	m.record("ClearListV4", listID)
+	if m.ClearListV4Func != nil {
+		return m.ClearListV4Func(listID)
+	}
+	if m.ClearListV4WithContextFunc != nil {
+		return m.ClearListV4WithContextFunc(context.Background(), listID)
+	}
+	var r0 *ListV4ClearResponse
+	return r0, fmt.Errorf("%w: ListsV4Service.ClearListV4", ErrNotStubbed)
+}
+
+// ClearListV4WithContext records the call and calls ClearListV4WithContextFunc.
+func (m *ListsV4ServiceMock) ClearListV4WithContext(ctx context.Context, listID int64) (*ListV4ClearResponse, error) {
+	m.record("ClearListV4WithContext", ctx, listID)
+	if m.ClearListV4WithContextFunc != nil {
+		return m.ClearListV4WithContextFunc(ctx, listID)
+	}
+	var r0 *ListV4ClearResponse
+	return r0, fmt.Errorf("%w: ListsV4Service.ClearListV4WithContext", ErrNotStubbed)
+}
+
+// DeleteListV4 records the call and calls DeleteListV4Func.
+func (m *ListsV4ServiceMock) DeleteListV4(listID int64) (*ListV4Response, error) {
+	m.record("DeleteListV4", listID)
+	if m.DeleteListV4Func != nil {
+		return m.DeleteListV4Func(listID)
+	}
+	if m.DeleteListV4WithContextFunc != nil {
+		return m.DeleteListV4WithContextFunc(context.Background(), listID)
+	}
+	var r0 *ListV4Response
+	return r0, fmt.Errorf("%w: ListsV4Service.DeleteListV4", ErrNotStubbed)
+}
+
+// DeleteListV4WithContext records the call and calls DeleteListV4WithContextFunc.
+func (m *ListsV4ServiceMock) DeleteListV4WithContext(ctx context.Context, listID int64) (*ListV4Response, error) {
+	m.record("DeleteListV4WithContext", ctx, listID)
+	if m.DeleteListV4WithContextFunc != nil {
+		return m.DeleteListV4WithContextFunc(ctx, listID)
+	}
+	var r0 *ListV4Response
+	return r0, fmt.Errorf("%w: ListsV4Service.DeleteListV4WithContext", ErrNotStubbed)
+}
+
+// AddListV4Items records the call and calls AddListV4ItemsFunc.
+func (m *ListsV4ServiceMock) AddListV4Items(listID int64, items []ListV4Item) (*ListV4ItemsResponse, error) {
+	m.record("AddListV4Items", listID, items)
+	if m.AddListV4ItemsFunc != nil {
+		return m.AddListV4ItemsFunc(listID, items)
+	}
+	if m.AddListV4ItemsWithContextFunc != nil {
+		return m.AddListV4ItemsWithContextFunc(context.Background(), listID, items)
+	}
+	var r0 *ListV4ItemsResponse
+	return r0, fmt.Errorf("%w: ListsV4Service.AddListV4Items", ErrNotStubbed)
+}
+
+// AddListV4ItemsWithContext records the call and calls AddListV4ItemsWithContextFunc.
+func (m *ListsV4ServiceMock) AddListV4ItemsWithContext(ctx context.Context, listID int64, items []ListV4Item) (*ListV4ItemsResponse, error) {
+	m.record("AddListV4ItemsWithContext", ctx, listID, items)
+	if m.AddListV4ItemsWithContextFunc != nil {
+		return m.AddListV4ItemsWithContextFunc(ctx, listID, items)
+	}
+	var r0 *ListV4ItemsResponse
+	return r0, fmt.Errorf("%w: ListsV4Service.AddListV4ItemsWithContext", ErrNotStubbed)
+}
+
+// UpdateListV4Items records the call and calls UpdateListV4ItemsFunc.
+func (m *ListsV4ServiceMock) UpdateListV4Items(listID int64, items []ListV4Item) (*ListV4ItemsResponse, error) {
+	m.record("UpdateListV4Items", listID, items)
+	if m.UpdateListV4ItemsFunc != nil {
+		return m.UpdateListV4ItemsFunc(listID, items)
+	}
+	if m.UpdateListV4ItemsWithContextFunc != nil {
+		return m.UpdateListV4ItemsWithContextFunc(context.Background(), listID, items)
+	}
+	var r0 *ListV4ItemsResponse
+	return r0, fmt.Errorf("%w: ListsV4Service.UpdateListV4Items", ErrNotStubbed)
+}
+
+// UpdateListV4ItemsWithContext records the call and calls UpdateListV4ItemsWithContextFunc.
+func (m *ListsV4ServiceMock) UpdateListV4ItemsWithContext(ctx context.Context, listID int64, items []ListV4Item) (*ListV4ItemsResponse, error) {
+	m.record("UpdateListV4ItemsWithContext", ctx, listID, items)
+	if m.UpdateListV4ItemsWithContextFunc != nil {
+		return m.UpdateListV4ItemsWithContextFunc(ctx, listID, items)
+	}
+	var r0 *ListV4ItemsResponse
+	return r0, fmt.Errorf("%w: ListsV4Service.UpdateListV4ItemsWithContext", ErrNotStubbed)
+}
+
+// RemoveListV4Items records the call and calls RemoveListV4ItemsFunc.
+func (m *ListsV4ServiceMock) RemoveListV4Items(listID int64, items []ListV4Item) (*ListV4ItemsResponse, error) {
+	m.record("RemoveListV4Items", listID, items)
+	if m.RemoveListV4ItemsFunc != nil {
+		return m.RemoveListV4ItemsFunc(listID, items)
+	}
+	if m.RemoveListV4ItemsWithContextFunc != nil {
+		return m.RemoveListV4ItemsWithContextFunc(context.Background(), listID, items)
+	}
+	var r0 *ListV4ItemsResponse
+	return r0, fmt.Errorf("%w: ListsV4Service.RemoveListV4Items", ErrNotStubbed)
+}
+
+// RemoveListV4ItemsWithContext records the call and calls RemoveListV4ItemsWithContextFunc.
+func (m *ListsV4ServiceMock) RemoveListV4ItemsWithContext(ctx context.Context, listID int64, items []ListV4Item) (*ListV4ItemsResponse, error) {
+	m.record("RemoveListV4ItemsWithContext", ctx, listID, items)
+	if m.RemoveListV4ItemsWithContextFunc != nil {
+		return m.RemoveListV4ItemsWithContextFunc(ctx, listID, items)
+	}
+	var r0 *ListV4ItemsResponse
+	return r0, fmt.Errorf("%w: ListsV4Service.RemoveListV4ItemsWithContext", ErrNotStubbed)
+}
+
+// MoviesServiceMock type is a MoviesService recording its calls.
+//
+// Set the Func fields to stub the methods. A method without
+// a Func falls back to the Func of its WithContext variant,
+// then returns zero values and ErrNotStubbed.
+type MoviesServiceMock struct {
+	MockRecorder
+
+	GetMovieDetailsFunc                      func(int, map[string]string) (*MovieDetails, error)
+	GetMovieDetailsWithContextFunc           func(context.Context, int, map[string]string) (*MovieDetails, error)
+	GetMovieAccountStatesFunc                func(int, map[string]string) (*MovieAccountStates, error)
+	GetMovieAccountStatesWithContextFunc     func(context.Context, int, map[string]string) (*MovieAccountStates, error)
+	GetMovieAlternativeTitlesFunc            func(int, map[string]string) (*MovieAlternativeTitles, error)
+	GetMovieAlternativeTitlesWithContextFunc func(context.Context, int, map[string]string) (*MovieAlternativeTitles, error)
+	GetMovieChangesFunc                      func(int, map[string]string) (*MovieChanges, error)
+	GetMovieChangesWithContextFunc           func(context.Context, int, map[string]string) (*MovieChanges, error)
+	GetMovieCreditsFunc                      func(int, map[string]string) (*MovieCredits, error)
+	GetMovieCreditsWithContextFunc           func(context.Context, int, map[string]string) (*MovieCredits, error)
+	GetMovieExternalIDsFunc                  func(int, map[string]string) (*MovieExternalIDs, error)
+	GetMovieExternalIDsWithContextFunc       func(context.Context, int, map[string]string) (*MovieExternalIDs, error)
+	GetMovieImagesFunc                       func(int, map[string]string) (*MovieImages, error)
+	GetMovieImagesWithContextFunc            func(context.Context, int, map[string]string) (*MovieImages, error)
+	GetMovieKeywordsFunc                     func(int) (*MovieKeywords, error)
+	GetMovieKeywordsWithContextFunc          func(context.Context, int) (*MovieKeywords, error)
+	GetMovieReleaseDatesFunc                 func(int) (*MovieReleaseDates, error)
+	GetMovieReleaseDatesWithContextFunc      func(context.Context, int) (*MovieReleaseDates, error)
+	GetMovieVideosFunc                       func(int, map[string]string) (*VideoResults, error)
+	GetMovieVideosWithContextFunc            func(context.Context, int, map[string]string) (*VideoResults, error)
+	GetMovieWatchProvidersFunc               func(int, map[string]string) (*WatchProviderResults, error)
+	GetMovieWatchProvidersWithContextFunc    func(context.Context, int, map[string]string) (*WatchProviderResults, error)
+	GetMovieTranslationsFunc                 func(int, map[string]string) (*MovieTranslations, error)
+	GetMovieTranslationsWithContextFunc      func(context.Context, int, map[string]string) (*MovieTranslations, error)
+	GetMovieRecommendationsFunc              func(int, map[string]string) (*MovieRecommendations, error)
+	GetMovieRecommendationsWithContextFunc   func(context.Context, int, map[string]string) (*MovieRecommendations, error)
+	GetMovieSimilarFunc                      func(int, map[string]string) (*MovieSimilar, error)
+	GetMovieSimilarWithContextFunc           func(context.Context, int, map[string]string) (*MovieSimilar, error)
+	GetMovieReviewsFunc                      func(int, map[string]string) (*MovieReviews, error)
+	GetMovieReviewsWithContextFunc           func(context.Context, int, map[string]string) (*MovieReviews, error)
+	GetMovieListsFunc                        func(int, map[string]string) (*MovieLists, error)
+	GetMovieListsWithContextFunc             func(context.Context, int, map[string]string) (*MovieLists, error)
+	GetMovieLatestFunc                       func(map[string]string) (*MovieLatest, error)
+	GetMovieLatestWithContextFunc            func(context.Context, map[string]string) (*MovieLatest, error)
+	GetMovieNowPlayingFunc                   func(map[string]string) (*MovieNowPlaying, error)
+	GetMovieNowPlayingWithContextFunc        func(context.Context, map[string]string) (*MovieNowPlaying, error)
+	GetMoviePopularFunc                      func(map[string]string) (*MoviePopular, error)
+	GetMoviePopularWithContextFunc           func(context.Context, map[string]string) (*MoviePopular, error)
+	GetMovieTopRatedFunc                     func(map[string]string) (*MovieTopRated, error)
+	GetMovieTopRatedWithContextFunc          func(context.Context, map[string]string) (*MovieTopRated, error)
+	GetMovieUpcomingFunc                     func(map[string]string) (*MovieUpcoming, error)
+	GetMovieUpcomingWithContextFunc          func(context.Context, map[string]string) (*MovieUpcoming, error)
+	PostMovieRatingFunc                      func(int, float32, map[string]string) (*Response, error)
+	PostMovieRatingWithContextFunc           func(context.Context, int, float32, map[string]string) (*Response, error)
+	DeleteMovieRatingFunc                    func(int, map[string]string) (*Response, error)
+	DeleteMovieRatingWithContextFunc         func(context.Context, int, map[string]string) (*Response, error)
+}
+
+var _ MoviesService = (*MoviesServiceMock)(nil)
+
+// GetMovieDetails records the call and calls GetMovieDetailsFunc.
+func (m *MoviesServiceMock) GetMovieDetails(id int, urlOptions map[string]string) (*MovieDetails, error) {
+	m.record("GetMovieDetails", id, urlOptions)
+	if m.GetMovieDetailsFunc != nil {
+		return m.GetMovieDetailsFunc(id, urlOptions)
+	}
+	if m.GetMovieDetailsWithContextFunc != nil {
+		return m.GetMovieDetailsWithContextFunc(context.Background(), id, urlOptions)
+	}
+	var r0 *MovieDetails
+	return r0, fmt.Errorf("%w: MoviesService.GetMovieDetails", ErrNotStubbed)
+}
+
+// GetMovieDetailsWithContext records the call and calls GetMovieDetailsWithContextFunc.
+func (m *MoviesServiceMock) GetMovieDetailsWithContext(ctx context.Context, id int, urlOptions map[string]string) (*MovieDetails, error) {
+	m.record("GetMovieDetailsWithContext", ctx, id, urlOptions)
+	if m.GetMovieDetailsWithContextFunc != nil {
+		return m.GetMovieDetailsWithContextFunc(ctx, id, urlOptions)
+	}
+	var r0 *MovieDetails
+	return r0, fmt.Errorf("%w: MoviesService.GetMovieDetailsWithContext", ErrNotStubbed)
+}
+
+// GetMovieAccountStates records the call and calls GetMovieAccountStatesFunc.
+func (m *MoviesServiceMock) GetMovieAccountStates(id int, urlOptions map[string]string) (*MovieAccountStates, error) {
+	m.record("GetMovieAccountStates", id, urlOptions)
+	if m.GetMovieAccountStatesFunc != nil {
+		return m.GetMovieAccountStatesFunc(id, urlOptions)
+	}
+	if m.GetMovieAccountStatesWithContextFunc != nil {
+		return m.GetMovieAccountStatesWithContextFunc(context.Background(), id, urlOptions)
+	}
+	var r0 *MovieAccountStates
+	return r0, fmt.Errorf("%w: MoviesService.GetMovieAccountStates", ErrNotStubbed)
+}
+
+// GetMovieAccountStatesWithContext records the call and calls GetMovieAccountStatesWithContextFunc.
+func (m *MoviesServiceMock) GetMovieAccountStatesWithContext(ctx context.Context, id int, urlOptions map[string]string) (*MovieAccountStates, error) {
+	m.record("GetMovieAccountStatesWithContext", ctx, id, urlOptions)
+	if m.GetMovieAccountStatesWithContextFunc != nil {
+		return m.GetMovieAccountStatesWithContextFunc(ctx, id, urlOptions)
+	}
+	var r0 *MovieAccountStates
+	return r0, fmt.Errorf("%w: MoviesService.GetMovieAccountStatesWithContext", ErrNotStubbed)
+}
+
+// GetMovieAlternativeTitles records the call and calls GetMovieAlternativeTitlesFunc.
+func (m *MoviesServiceMock) GetMovieAlternativeTitles(id int, urlOptions map[string]string) (*MovieAlternativeTitles, error) {
+	m.record("GetMovieAlternativeTitles", id, urlOptions)
+	if m.GetMovieAlternativeTitlesFunc != nil {
+		return m.GetMovieAlternativeTitlesFunc(id, urlOptions)
+	}
+	if m.GetMovieAlternativeTitlesWithContextFunc != nil {
+		return m.GetMovieAlternativeTitlesWithContextFunc(context.Background(), id, urlOptions)
+	}
+	var r0 *MovieAlternativeTitles
+	return r0, fmt.Errorf("%w: MoviesService.GetMovieAlternativeTitles", ErrNotStubbed)
+}
+
+// GetMovieAlternativeTitlesWithContext records the call and calls GetMovieAlternativeTitlesWithContextFunc.
+func (m *MoviesServiceMock) GetMovieAlternativeTitlesWithContext(ctx context.Context, id int, urlOptions map[string]string) (*MovieAlternativeTitles, error) {
+	m.record("GetMovieAlternativeTitlesWithContext", ctx, id, urlOptions)
+	if m.GetMovieAlternativeTitlesWithContextFunc != nil {
+		return m.GetMovieAlternativeTitlesWithContextFunc(ctx, id, urlOptions)
+	}
+	var r0 *MovieAlternativeTitles
+	return r0, fmt.Errorf("%w: MoviesService.GetMovieAlternativeTitlesWithContext", ErrNotStubbed)
+}
+
+// GetMovieChanges records the call and calls GetMovieChangesFunc.
+func (m *MoviesServiceMock) GetMovieChanges(id int, urlOptions map[string]string) (*MovieChanges, error) {
+	m.record("GetMovieChanges", id, urlOptions)
+	if m.GetMovieChangesFunc != nil {
+		return m.GetMovieChangesFunc(id, urlOptions)
+	}
+	if m.GetMovieChangesWithContextFunc != nil {
+		return m.GetMovieChangesWithContextFunc(context.Background(), id, urlOptions)
+	}
+	var r0 *MovieChanges
+	return r0, fmt.Errorf("%w: MoviesService.GetMovieChanges", ErrNotStubbed)
+}
+
+// GetMovieChangesWithContext records the call and calls GetMovieChangesWithContextFunc.
+func (m *MoviesServiceMock) GetMovieChangesWithContext(ctx context.Context, id int, urlOptions map[string]string) (*MovieChanges, error) {
+	m.record("GetMovieChangesWithContext", ctx, id, urlOptions)
+	if m.GetMovieChangesWithContextFunc != nil {
+		return m.GetMovieChangesWithContextFunc(ctx, id, urlOptions)
+	}
+	var r0 *MovieChanges
+	return r0, fmt.Errorf("%w: MoviesService.GetMovieChangesWithContext", ErrNotStubbed)
+}
+
+// GetMovieCredits records the call and calls GetMovieCreditsFunc.
+func (m *MoviesServiceMock) GetMovieCredits(id int, urlOptions map[string]string) (*MovieCredits, error) {
+	m.record("GetMovieCredits", id, urlOptions)
+	if m.GetMovieCreditsFunc != nil {
+		return m.GetMovieCreditsFunc(id, urlOptions)
+	}
+	if m.GetMovieCreditsWithContextFunc != nil {
+		return m.GetMovieCreditsWithContextFunc(context.Background(), id, urlOptions)
+	}
+	var r0 *MovieCredits
+	return r0, fmt.Errorf("%w: MoviesService.GetMovieCredits", ErrNotStubbed)
+}
+
+// GetMovieCreditsWithContext records the call and calls GetMovieCreditsWithContextFunc.
+func (m *MoviesServiceMock) GetMovieCreditsWithContext(ctx context.Context, id int, urlOptions map[string]string) (*MovieCredits, error) {
+	m.record("GetMovieCreditsWithContext", ctx, id, urlOptions)
+	if m.GetMovieCreditsWithContextFunc != nil {
+		return m.GetMovieCreditsWithContextFunc(ctx, id, urlOptions)
+	}
+	var r0 *MovieCredits
+	return r0, fmt.Errorf("%w: MoviesService.GetMovieCreditsWithContext", ErrNotStubbed)
+}
+
+// GetMovieExternalIDs records the call and calls GetMovieExternalIDsFunc.
+func (m *MoviesServiceMock) GetMovieExternalIDs(id int, urlOptions map[string]string) (*MovieExternalIDs, error) {
+	m.record("GetMovieExternalIDs", id, urlOptions)
+	if m.GetMovieExternalIDsFunc != nil {
+		return m.GetMovieExternalIDsFunc(id, urlOptions)
+	}
+	if m.GetMovieExternalIDsWithContextFunc != nil {
+		return m.GetMovieExternalIDsWithContextFunc(context.Background(), id, urlOptions)
+	}
+	var r0 *MovieExternalIDs
+	return r0, fmt.Errorf("%w: MoviesService.GetMovieExternalIDs", ErrNotStubbed)
+}
+
+// GetMovieExternalIDsWithContext records the call and calls GetMovieExternalIDsWithContextFunc.
+func (m *MoviesServiceMock) GetMovieExternalIDsWithContext(ctx context.Context, id int, urlOptions map[string]string) (*MovieExternalIDs, error) {
+	m.record("GetMovieExternalIDsWithContext", ctx, id, urlOptions)
+	if m.GetMovieExternalIDsWithContextFunc != nil {
+		return m.GetMovieExternalIDsWithContextFunc(ctx, id, urlOptions)
+	}
+	var r0 *MovieExternalIDs
+	return r0, fmt.Errorf("%w: MoviesService.GetMovieExternalIDsWithContext", ErrNotStubbed)
+}
+
+// GetMovieImages records the call and calls GetMovieImagesFunc.
+func (m *MoviesServiceMock) GetMovieImages(id int, urlOptions map[string]string) (*MovieImages, error) {
+	m.record("GetMovieImages", id, urlOptions)
+	if m.GetMovieImagesFunc != nil {
+		return m.GetMovieImagesFunc(id, urlOptions)
+	}
+	if m.GetMovieImagesWithContextFunc != nil {
+		return m.GetMovieImagesWithContextFunc(context.Background(), id, urlOptions)
+	}
+	var r0 *MovieImages
+	return r0, fmt.Errorf("%w: MoviesService.GetMovieImages", ErrNotStubbed)
+}
+
+// GetMovieImagesWithContext records the call and calls GetMovieImagesWithContextFunc.
+func (m *MoviesServiceMock) GetMovieImagesWithContext(ctx context.Context, id int, urlOptions map[string]string) (*MovieImages, error) {
+	m.record("GetMovieImagesWithContext", ctx, id, urlOptions)
+	if m.GetMovieImagesWithContextFunc != nil {
+		return m.GetMovieImagesWithContextFunc(ctx, id, urlOptions)
+	}
+	var r0 *MovieImages
+	return r0, fmt.Errorf("%w: MoviesService.GetMovieImagesWithContext", ErrNotStubbed)
+}
+
+// GetMovieKeywords records the call and calls GetMovieKeywordsFunc.
+func (m *MoviesServiceMock) GetMovieKeywords(id int) (*MovieKeywords, error) {
+	m.record("GetMovieKeywords", id)
+	if m.GetMovieKeywordsFunc != nil {
+		return m.GetMovieKeywordsFunc(id)
+	}
+	if m.GetMovieKeywordsWithContextFunc != nil {
+		return m.GetMovieKeywordsWithContextFunc(context.Background(), id)
+	}
+	var r0 *MovieKeywords
+	return r0, fmt.Errorf("%w: MoviesService.GetMovieKeywords", ErrNotStubbed)
+}
+
+// GetMovieKeywordsWithContext records the call and calls GetMovieKeywordsWithContextFunc.
+func (m *MoviesServiceMock) GetMovieKeywordsWithContext(ctx context.Context, id int) (*MovieKeywords, error) {
+	m.record("GetMovieKeywordsWithContext", ctx, id)
+	if m.GetMovieKeywordsWithContextFunc != nil {
+		return m.GetMovieKeywordsWithContextFunc(ctx, id)
+	}
+	var r0 *MovieKeywords
+	return r0, fmt.Errorf("%w: MoviesService.GetMovieKeywordsWithContext", ErrNotStubbed)
+}
+
+// GetMovieReleaseDates records the call and calls GetMovieReleaseDatesFunc.
+func (m *MoviesServiceMock) GetMovieReleaseDates(id int) (*MovieReleaseDates, error) {
+	m.record("GetMovieReleaseDates", id)
+	if m.GetMovieReleaseDatesFunc != nil {
+		return m.GetMovieReleaseDatesFunc(id)
+	}
+	if m.GetMovieReleaseDatesWithContextFunc != nil {
+		return m.GetMovieReleaseDatesWithContextFunc(context.Background(), id)
+	}
+	var r0 *MovieReleaseDates
+	return r0, fmt.Errorf("%w: MoviesService.GetMovieReleaseDates", ErrNotStubbed)
+}
+
+// GetMovieReleaseDatesWithContext records the call and calls GetMovieReleaseDatesWithContextFunc.
+func (m *MoviesServiceMock) GetMovieReleaseDatesWithContext(ctx context.Context, id int) (*MovieReleaseDates, error) {
+	m.record("GetMovieReleaseDatesWithContext", ctx, id)
+	if m.GetMovieReleaseDatesWithContextFunc != nil {
+		return m.GetMovieReleaseDatesWithContextFunc(ctx, id)
+	}
+	var r0 *MovieReleaseDates
+	return r0, fmt.Errorf("%w: MoviesService.GetMovieReleaseDatesWithContext", ErrNotStubbed)
+}
+
+// GetMovieVideos records the call and calls GetMovieVideosFunc.
+func (m *MoviesServiceMock) GetMovieVideos(id int, urlOptions map[string]string) (*VideoResults, error) {
+	m.record("GetMovieVideos", id, urlOptions)
+	if m.GetMovieVideosFunc != nil {
+		return m.GetMovieVideosFunc(id, urlOptions)
+	}
+	if m.GetMovieVideosWithContextFunc != nil {
+		return m.GetMovieVideosWithContextFunc(context.Background(), id, urlOptions)
+	}
+	var r0 *VideoResults
+	return r0, fmt.Errorf("%w: MoviesService.GetMovieVideos", ErrNotStubbed)
+}
+
+// GetMovieVideosWithContext records the call and calls GetMovieVideosWithContextFunc.
+func (m *MoviesServiceMock) GetMovieVideosWithContext(ctx context.Context, id int, urlOptions map[string]string) (*VideoResults, error) {
+	m.record("GetMovieVideosWithContext", ctx, id, urlOptions)
+	if m.GetMovieVideosWithContextFunc != nil {
+		return m.GetMovieVideosWithContextFunc(ctx, id, urlOptions)
+	}
+	var r0 *VideoResults
+	return r0, fmt.Errorf("%w: MoviesService.GetMovieVideosWithContext", ErrNotStubbed)
+}
+
+// GetMovieWatchProviders records the call and calls GetMovieWatchProvidersFunc.
+func (m *MoviesServiceMock) GetMovieWatchProviders(id int, urlOptions map[string]string) (*WatchProviderResults, error) {
+	m.record("GetMovieWatchProviders", id, urlOptions)
+	if m.GetMovieWatchProvidersFunc != nil {
+		return m.GetMovieWatchProvidersFunc(id, urlOptions)
+	}
+	if m.GetMovieWatchProvidersWithContextFunc != nil {
+		return m.GetMovieWatchProvidersWithContextFunc(context.Background(), id, urlOptions)
+	}
+	var r0 *WatchProviderResults
+	return r0, fmt.Errorf("%w: MoviesService.GetMovieWatchProviders", ErrNotStubbed)
+}
+
+// GetMovieWatchProvidersWithContext records the call and calls GetMovieWatchProvidersWithContextFunc.
+func (m *MoviesServiceMock) GetMovieWatchProvidersWithContext(ctx context.Context, id int, urlOptions map[string]string) (*WatchProviderResults, error) {
+	m.record("GetMovieWatchProvidersWithContext", ctx, id, urlOptions)
+	if m.GetMovieWatchProvidersWithContextFunc != nil {
+		return m.GetMovieWatchProvidersWithContextFunc(ctx, id, urlOptions)
+	}
+	var r0 *WatchProviderResults
+	return r0, fmt.Errorf("%w: MoviesService.GetMovieWatchProvidersWithContext", ErrNotStubbed)
+}
+
+// GetMovieTranslations records the call and calls GetMovieTranslationsFunc.
+func (m *MoviesServiceMock) GetMovieTranslations(id int, urlOptions map[string]string) (*MovieTranslations, error) {
+	m.record("GetMovieTranslations", id, urlOptions)
+	if m.GetMovieTranslationsFunc != nil {
+		return m.GetMovieTranslationsFunc(id, urlOptions)
+	}
+	if m.GetMovieTranslationsWithContextFunc != nil {
+		return m.GetMovieTranslationsWithContextFunc(context.Background(), id, urlOptions)
+	}
+	var r0 *MovieTranslations
+	return r0, fmt.Errorf("%w: MoviesService.GetMovieTranslations", ErrNotStubbed)
+}
+
+// GetMovieTranslationsWithContext records the call and calls GetMovieTranslationsWithContextFunc.
+func (m *MoviesServiceMock) GetMovieTranslationsWithContext(ctx context.Context, id int, urlOptions map[string]string) (*MovieTranslations, error) {
+	m.record("GetMovieTranslationsWithContext", ctx, id, urlOptions)
+	if m.GetMovieTranslationsWithContextFunc != nil {
+		return m.GetMovieTranslationsWithContextFunc(ctx, id, urlOptions)
+	}
+	var r0 *MovieTranslations
+	return r0, fmt.Errorf("%w: MoviesService.GetMovieTranslationsWithContext", ErrNotStubbed)
+}
+
+// GetMovieRecommendations records the call and calls GetMovieRecommendationsFunc.
+func (m *MoviesServiceMock) GetMovieRecommendations(id int, urlOptions map[string]string) (*MovieRecommendations, error) {
+	m.record("GetMovieRecommendations", id, urlOptions)
+	if m.GetMovieRecommendationsFunc != nil {
+		return m.GetMovieRecommendationsFunc(id, urlOptions)
+	}
+	if m.GetMovieRecommendationsWithContextFunc != nil {
+		return m.GetMovieRecommendationsWithContextFunc(context.Background(), id, urlOptions)
+	}
+	var r0 *MovieRecommendations
+	return r0, fmt.Errorf("%w: MoviesService.GetMovieRecommendations", ErrNotStubbed)
+}
+
+// GetMovieRecommendationsWithContext records the call and calls GetMovieRecommendationsWithContextFunc.
+func (m *MoviesServiceMock) GetMovieRecommendationsWithContext(ctx context.Context, id int, urlOptions map[string]string) (*MovieRecommendations, error) {
+	m.record("GetMovieRecommendationsWithContext", ctx, id, urlOptions)
+	if m.GetMovieRecommendationsWithContextFunc != nil {
+		return m.GetMovieRecommendationsWithContextFunc(ctx, id, urlOptions)
+	}
+	var r0 *MovieRecommendations
+	return r0, fmt.Errorf("%w: MoviesService.GetMovieRecommendationsWithContext", ErrNotStubbed)
+}
+
+// GetMovieSimilar records the call and calls GetMovieSimilarFunc.
+func (m *MoviesServiceMock) GetMovieSimilar(id int, urlOptions map[string]string) (*MovieSimilar, error) {
+	m.record("GetMovieSimilar", id, urlOptions)
+	if m.GetMovieSimilarFunc != nil {
+		return m.GetMovieSimilarFunc(id, urlOptions)
+	}
+	if m.GetMovieSimilarWithContextFunc != nil {
+		return m.GetMovieSimilarWithContextFunc(context.Background(), id, urlOptions)
+	}
+	var r0 *MovieSimilar
+	return r0, fmt.Errorf("%w: MoviesService.GetMovieSimilar", ErrNotStubbed)
+}
+
+// GetMovieSimilarWithContext records the call and calls GetMovieSimilarWithContextFunc.
+func (m *MoviesServiceMock) GetMovieSimilarWithContext(ctx context.Context, id int, urlOptions map[string]string) (*MovieSimilar, error) {
+	m.record("GetMovieSimilarWithContext", ctx, id, urlOptions)
+	if m.GetMovieSimilarWithContextFunc != nil {
+		return m.GetMovieSimilarWithContextFunc(ctx, id, urlOptions)
+	}
+	var r0 *MovieSimilar
+	return r0, fmt.Errorf("%w: MoviesService.GetMovieSimilarWithContext", ErrNotStubbed)
+}
+
+// GetMovieReviews records the call and calls GetMovieReviewsFunc.
+func (m *MoviesServiceMock) GetMovieReviews(id int, urlOptions map[string]string) (*MovieReviews, error) {
+	m.record("GetMovieReviews", id, urlOptions)
+	if m.GetMovieReviewsFunc != nil {
+		return m.GetMovieReviewsFunc(id, urlOptions)
+	}
+	if m.GetMovieReviewsWithContextFunc != nil {
+		return m.GetMovieReviewsWithContextFunc(context.Background(), id, urlOptions)
+	}
+	var r0 *MovieReviews
+	return r0, fmt.Errorf("%w: MoviesService.GetMovieReviews", ErrNotStubbed)
+}
+
+// GetMovieReviewsWithContext records the call and calls GetMovieReviewsWithContextFunc.
+func (m *MoviesServiceMock) GetMovieReviewsWithContext(ctx context.Context, id int, urlOptions map[string]string) (*MovieReviews, error) {
+	m.record("GetMovieReviewsWithContext", ctx, id, urlOptions)
+	if m.GetMovieReviewsWithContextFunc != nil {
+		return m.GetMovieReviewsWithContextFunc(ctx, id, urlOptions)
+	}
+	var r0 *MovieReviews
+	return r0, fmt.Errorf("%w: MoviesService.GetMovieReviewsWithContext", ErrNotStubbed)
+}
+
+// GetMovieLists records the call and calls GetMovieListsFunc.
+func (m *MoviesServiceMock) GetMovieLists(id int, urlOptions map[string]string) (*MovieLists, error) {
+	m.record("GetMovieLists", id, urlOptions)
+	if m.GetMovieListsFunc != nil {
+		return m.GetMovieListsFunc(id, urlOptions)
+	}
+	if m.GetMovieListsWithContextFunc != nil {
+		return m.GetMovieListsWithContextFunc(context.Background(), id, urlOptions)
+	}
+	var r0 *MovieLists
+	return r0, fmt.Errorf("%w: MoviesService.GetMovieLists", ErrNotStubbed)
+}
+
+// GetMovieListsWithContext records the call and calls GetMovieListsWithContextFunc.
+func (m *MoviesServiceMock) GetMovieListsWithContext(ctx context.Context, id int, urlOptions map[string]string) (*MovieLists, error) {
+	m.record("GetMovieListsWithContext", ctx, id, urlOptions)
+	if m.GetMovieListsWithContextFunc != nil {
+		return m.GetMovieListsWithContextFunc(ctx, id, urlOptions)
+	}
+	var r0 *MovieLists
+	return r0, fmt.Errorf("%w: MoviesService.GetMovieListsWithContext", ErrNotStubbed)
+}
+
+// GetMovieLatest records the call and calls GetMovieLatestFunc.
+func (m *MoviesServiceMock) GetMovieLatest(urlOptions map[string]string) (*MovieLatest, error) {
+	m.record("GetMovieLatest", urlOptions)
+	if m.GetMovieLatestFunc != nil {
+		return m.GetMovieLatestFunc(urlOptions)
+	}
+	if m.GetMovieLatestWithContextFunc != nil {
+		return m.GetMovieLatestWithContextFunc(context.Background(), urlOptions)
+	}
+	var r0 *MovieLatest
+	return r0, fmt.Errorf("%w: MoviesService.GetMovieLatest", ErrNotStubbed)
+}
+
+// GetMovieLatestWithContext records the call and calls GetMovieLatestWithContextFunc.
+func (m *MoviesServiceMock) GetMovieLatestWithContext(ctx context.Context, urlOptions map[string]string) (*MovieLatest, error) {
+	m.record("GetMovieLatestWithContext", ctx, urlOptions)
+	if m.GetMovieLatestWithContextFunc != nil {
+		return m.GetMovieLatestWithContextFunc(ctx, urlOptions)
+	}
+	var r0 *MovieLatest
+	return r0, fmt.Errorf("%w: MoviesService.GetMovieLatestWithContext", ErrNotStubbed)
+}
+
+// GetMovieNowPlaying records the call and calls GetMovieNowPlayingFunc.
+func (m *MoviesServiceMock) GetMovieNowPlaying(urlOptions map[string]string) (*MovieNowPlaying, error) {
+	m.record("GetMovieNowPlaying", urlOptions)
+	if m.GetMovieNowPlayingFunc != nil {
+		return m.GetMovieNowPlayingFunc(urlOptions)
+	}
+	if m.GetMovieNowPlayingWithContextFunc != nil {
+		return m.GetMovieNowPlayingWithContextFunc(context.Background(), urlOptions)
+	}
+	var r0 *MovieNowPlaying
+	return r0, fmt.Errorf("%w: MoviesService.GetMovieNowPlaying", ErrNotStubbed)
+}
+
+// GetMovieNowPlayingWithContext records the call and calls GetMovieNowPlayingWithContextFunc.
+func (m *MoviesServiceMock) GetMovieNowPlayingWithContext(ctx context.Context, urlOptions map[string]string) (*MovieNowPlaying, error) {
+	m.record("GetMovieNowPlayingWithContext", ctx, urlOptions)
+	if m.GetMovieNowPlayingWithContextFunc != nil {
+		return m.GetMovieNowPlayingWithContextFunc(ctx, urlOptions)
+	}
+	var r0 *MovieNowPlaying
+	return r0, fmt.Errorf("%w: MoviesService.GetMovieNowPlayingWithContext", ErrNotStubbed)
+}
+
+// GetMoviePopular records the call and calls GetMoviePopularFunc.
+func (m *MoviesServiceMock) GetMoviePopular(urlOptions map[string]string) (*MoviePopular, error) {
+	m.record("GetMoviePopular", urlOptions)
+	if m.GetMoviePopularFunc != nil {
+		return m.GetMoviePopularFunc(urlOptions)
+	}
+	if m.GetMoviePopularWithContextFunc != nil {
+		return m.GetMoviePopularWithContextFunc(context.Background(), urlOptions)
+	}
+	var r0 *MoviePopular
+	return r0, fmt.Errorf("%w: MoviesService.GetMoviePopular", ErrNotStubbed)
+}
+
+// GetMoviePopularWithContext records the call and calls GetMoviePopularWithContextFunc.
+func (m *MoviesServiceMock) GetMoviePopularWithContext(ctx context.Context, urlOptions map[string]string) (*MoviePopular, error) {
+	m.record("GetMoviePopularWithContext", ctx, urlOptions)
+	if m.GetMoviePopularWithContextFunc != nil {
+		return m.GetMoviePopularWithContextFunc(ctx, urlOptions)
+	}
+	var r0 *MoviePopular
+	return r0, fmt.Errorf("%w: MoviesService.GetMoviePopularWithContext", ErrNotStubbed)
+}
+
+// GetMovieTopRated records the call and calls GetMovieTopRatedFunc.
+func (m *MoviesServiceMock) GetMovieTopRated(urlOptions map[string]string) (*MovieTopRated, error) {
+	m.record("GetMovieTopRated", urlOptions)
+	if m.GetMovieTopRatedFunc != nil {
+		return m.GetMovieTopRatedFunc(urlOptions)
+	}
+	if m.GetMovieTopRatedWithContextFunc != nil {
+		return m.GetMovieTopRatedWithContextFunc(context.Background(), urlOptions)
+	}
+	var r0 *MovieTopRated
+	return r0, fmt.Errorf("%w: MoviesService.GetMovieTopRated", ErrNotStubbed)
+}
+
+// GetMovieTopRatedWithContext records the call and calls GetMovieTopRatedWithContextFunc.
+func (m *MoviesServiceMock) GetMovieTopRatedWithContext(ctx context.Context, urlOptions map[string]string) (*MovieTopRated, error) {
+	m.record("GetMovieTopRatedWithContext", ctx, urlOptions)
+	if m.GetMovieTopRatedWithContextFunc != nil {
+		return m.GetMovieTopRatedWithContextFunc(ctx, urlOptions)
+	}
+	var r0 *MovieTopRated
+	return r0, fmt.Errorf("%w: MoviesService.GetMovieTopRatedWithContext", ErrNotStubbed)
+}
+
+// GetMovieUpcoming records the call and calls GetMovieUpcomingFunc.
+func (m *MoviesServiceMock) GetMovieUpcoming(urlOptions map[string]string) (*MovieUpcoming, error) {
+	m.record("GetMovieUpcoming", urlOptions)
+	if m.GetMovieUpcomingFunc != nil {
+		return m.GetMovieUpcomingFunc(urlOptions)
+	}
+	if m.GetMovieUpcomingWithContextFunc != nil {
+		return m.GetMovieUpcomingWithContextFunc(context.Background(), urlOptions)
+	}
+	var r0 *MovieUpcoming
+	return r0, fmt.Errorf("%w: MoviesService.GetMovieUpcoming", ErrNotStubbed)
+}
+
+// GetMovieUpcomingWithContext records the call and calls GetMovieUpcomingWithContextFunc.
+func (m *MoviesServiceMock) GetMovieUpcomingWithContext(ctx context.Context, urlOptions map[string]string) (*MovieUpcoming, error) {
+	m.record("GetMovieUpcomingWithContext", ctx, urlOptions)
+	if m.GetMovieUpcomingWithContextFunc != nil {
+		return m.GetMovieUpcomingWithContextFunc(ctx, urlOptions)
+	}
+	var r0 *MovieUpcoming
+	return r0, fmt.Errorf("%w: MoviesService.GetMovieUpcomingWithContext", ErrNotStubbed)
+}
+
+// PostMovieRating records the call and calls PostMovieRatingFunc.
+func (m *MoviesServiceMock) PostMovieRating(id int, rating float32, urlOptions map[string]string) (*Response, error) {
+	m.record("PostMovieRating", id, rating, urlOptions)
+	if m.PostMovieRatingFunc != nil {
+		return m.PostMovieRatingFunc(id, rating, urlOptions)
+	}
+	if m.PostMovieRatingWithContextFunc != nil {
+		return m.PostMovieRatingWithContextFunc(context.Background(), id, rating, urlOptions)
+	}
+	var r0 *Response
+	return r0, fmt.Errorf("%w: MoviesService.PostMovieRating", ErrNotStubbed)
+}
+
+// PostMovieRatingWithContext records the call and calls PostMovieRatingWithContextFunc.
+func (m *MoviesServiceMock) PostMovieRatingWithContext(ctx context.Context, id int, rating float32, urlOptions map[string]string) (*Response, error) {
+	m.record("PostMovieRatingWithContext", ctx, id, rating, urlOptions)
+	if m.PostMovieRatingWithContextFunc != nil {
+		return m.PostMovieRatingWithContextFunc(ctx, id, rating, urlOptions)
+	}
+	var r0 *Response
+	return r0, fmt.Errorf("%w: MoviesService.PostMovieRatingWithContext", ErrNotStubbed)
+}
+
+// DeleteMovieRating records the call and calls DeleteMovieRatingFunc.
+func (m *MoviesServiceMock) DeleteMovieRating(id int, urlOptions map[string]string) (*Response, error) {
+	m.record("DeleteMovieRating", id, urlOptions)
+	if m.DeleteMovieRatingFunc != nil {
+		return m.DeleteMovieRatingFunc(id, urlOptions)
+	}
+	if m.DeleteMovieRatingWithContextFunc != nil {
+		return m.DeleteMovieRatingWithContextFunc(context.Background(), id, urlOptions)
+	}
+	var r0 *Response
+	return r0, fmt.Errorf("%w: MoviesService.DeleteMovieRating", ErrNotStubbed)
+}
+
+// DeleteMovieRatingWithContext records the call and calls DeleteMovieRatingWithContextFunc.
+func (m *MoviesServiceMock) DeleteMovieRatingWithContext(ctx context.Context, id int, urlOptions map[string]string) (*Response, error) {
+	m.record("DeleteMovieRatingWithContext", ctx, id, urlOptions)
+	if m.DeleteMovieRatingWithContextFunc != nil {
+		return m.DeleteMovieRatingWithContextFunc(ctx, id, urlOptions)
+	}
+	var r0 *Response
+	return r0, fmt.Errorf("%w: MoviesService.DeleteMovieRatingWithContext", ErrNotStubbed)
+}
+
+// NetworksServiceMock type is a NetworksService recording its calls.
+//
+// Set the Func fields to stub the methods. A method without
+// a Func falls back to the Func of its WithContext variant,
+// then returns zero values and ErrNotStubbed.
+type NetworksServiceMock struct {
+	MockRecorder
+
+	GetNetworkDetailsFunc                     func(int) (*NetworkDetails, error)
+	GetNetworkDetailsWithContextFunc          func(context.Context, int) (*NetworkDetails, error)
+	GetNetworkAlternativeNamesFunc            func(int) (*NetworkAlternativeNames, error)
+	GetNetworkAlternativeNamesWithContextFunc func(context.Context, int) (*NetworkAlternativeNames, error)
+	GetNetworkImagesFunc                      func(int) (*NetworkImages, error)
+	GetNetworkImagesWithContextFunc           func(context.Context, int) (*NetworkImages, error)
+}
+
+var _ NetworksService = (*NetworksServiceMock)(nil)
+
+// GetNetworkDetails records the call and calls GetNetworkDetailsFunc.
+func (m *NetworksServiceMock) GetNetworkDetails(id int) (*NetworkDetails, error) {
+	m.record("GetNetworkDetails", id)
+	if m.GetNetworkDetailsFunc != nil {
+		return m.GetNetworkDetailsFunc(id)
+	}
+	if m.GetNetworkDetailsWithContextFunc != nil {
+		return m.GetNetworkDetailsWithContextFunc(context.Background(), id)
+	}
+	var r0 *NetworkDetails
+	return r0, fmt.Errorf("%w: NetworksService.GetNetworkDetails", ErrNotStubbed)
+}
+
+// GetNetworkDetailsWithContext records the call and calls GetNetworkDetailsWithContextFunc.
+func (m *NetworksServiceMock) GetNetworkDetailsWithContext(ctx context.Context, id int) (*NetworkDetails, error) {
+	m.record("GetNetworkDetailsWithContext", ctx, id)
+	if m.GetNetworkDetailsWithContextFunc != nil {
+		return m.GetNetworkDetailsWithContextFunc(ctx, id)
+	}
+	var r0 *NetworkDetails
+	return r0, fmt.Errorf("%w: NetworksService.GetNetworkDetailsWithContext", ErrNotStubbed)
+}
+
+// GetNetworkAlternativeNames records the call and calls GetNetworkAlternativeNamesFunc.
+func (m *NetworksServiceMock) GetNetworkAlternativeNames(id int) (*NetworkAlternativeNames, error) {
+	m.record("GetNetworkAlternativeNames", id)
+	if m.GetNetworkAlternativeNamesFunc != nil {
+		return m.GetNetworkAlternativeNamesFunc(id)
+	}
+	if m.GetNetworkAlternativeNamesWithContextFunc != nil {
+		return m.GetNetworkAlternativeNamesWithContextFunc(context.Background(), id)
+	}
+	var r0 *NetworkAlternativeNames
+	return r0, fmt.Errorf("%w: NetworksService.GetNetworkAlternativeNames", ErrNotStubbed)
+}
+
+// GetNetworkAlternativeNamesWithContext records the call and calls GetNetworkAlternativeNamesWithContextFunc.
+func (m *NetworksServiceMock) GetNetworkAlternativeNamesWithContext(ctx context.Context, id int) (*NetworkAlternativeNames, error) {
+	m.record("GetNetworkAlternativeNamesWithContext", ctx, id)
+	if m.GetNetworkAlternativeNamesWithContextFunc != nil {
+		return m.GetNetworkAlternativeNamesWithContextFunc(ctx, id)
+	}
+	var r0 *NetworkAlternativeNames
+	return r0, fmt.Errorf("%w: NetworksService.GetNetworkAlternativeNamesWithContext", ErrNotStubbed)
+}
+
+// GetNetworkImages records the call and calls GetNetworkImagesFunc.
+func (m *NetworksServiceMock) GetNetworkImages(id int) (*NetworkImages, error) {
+	m.record("GetNetworkImages", id)
+	if m.GetNetworkImagesFunc != nil {
+		return m.GetNetworkImagesFunc(id)
+	}
+	if m.GetNetworkImagesWithContextFunc != nil {
+		return m.GetNetworkImagesWithContextFunc(context.Background(), id)
+	}
+	var r0 *NetworkImages
+	return r0, fmt.Errorf("%w: NetworksService.GetNetworkImages", ErrNotStubbed)
+}
+
+// GetNetworkImagesWithContext records the call and calls GetNetworkImagesWithContextFunc.
+func (m *NetworksServiceMock) GetNetworkImagesWithContext(ctx context.Context, id int) (*NetworkImages, error) {
+	m.record("GetNetworkImagesWithContext", ctx, id)
+	if m.GetNetworkImagesWithContextFunc != nil {
+		return m.GetNetworkImagesWithContextFunc(ctx, id)
+	}
+	var r0 *NetworkImages
+	return r0, fmt.Errorf("%w: NetworksService.GetNetworkImagesWithContext", ErrNotStubbed)
+}
+
+// PeopleServiceMock type is a PeopleService recording its calls.
+//
+// Set the Func fields to stub the methods. A method without
+// a Func falls back to the Func of its WithContext variant,
+// then returns zero values and ErrNotStubbed.
+type PeopleServiceMock struct {
+	MockRecorder
+
+	GetPersonDetailsFunc                    func(int, map[string]string) (*PersonDetails, error)
+	GetPersonDetailsWithContextFunc         func(context.Context, int, map[string]string) (*PersonDetails, error)
+	GetPersonChangesFunc                    func(int, map[string]string) (*PersonChanges, error)
+	GetPersonChangesWithContextFunc         func(context.Context, int, map[string]string) (*PersonChanges, error)
+	GetPersonMovieCreditsFunc               func(int, map[string]string) (*PersonMovieCredits, error)
+	GetPersonMovieCreditsWithContextFunc    func(context.Context, int, map[string]string) (*PersonMovieCredits, error)
+	GetPersonTVCreditsFunc                  func(int, map[string]string) (*PersonTVCredits, error)
+	GetPersonTVCreditsWithContextFunc       func(context.Context, int, map[string]string) (*PersonTVCredits, error)
+	GetPersonCombinedCreditsFunc            func(int, map[string]string) (*PersonCombinedCredits, error)
+	GetPersonCombinedCreditsWithContextFunc func(context.Context, int, map[string]string) (*PersonCombinedCredits, error)
+	GetPersonExternalIDsFunc                func(int, map[string]string) (*PersonExternalIDs, error)
+	GetPersonExternalIDsWithContextFunc     func(context.Context, int, map[string]string) (*PersonExternalIDs, error)
+	GetPersonImagesFunc                     func(int) (*PersonImages, error)
+	GetPersonImagesWithContextFunc          func(context.Context, int) (*PersonImages, error)
+	GetPersonTranslationsFunc               func(int, map[string]string) (*PersonTranslations, error)
+	GetPersonTranslationsWithContextFunc    func(context.Context, int, map[string]string) (*PersonTranslations, error)
+	GetPersonLatestFunc                     func(map[string]string) (*PersonLatest, error)
+	GetPersonLatestWithContextFunc          func(context.Context, map[string]string) (*PersonLatest, error)
+	GetPersonPopularFunc                    func(map[string]string) (*PersonPopular, error)
+	GetPersonPopularWithContextFunc         func(context.Context, map[string]string) (*PersonPopular, error)
+}
+
+var _ PeopleService = (*PeopleServiceMock)(nil)
+
+// GetPersonDetails records the call and calls GetPersonDetailsFunc.
+func (m *PeopleServiceMock) GetPersonDetails(id int, urlOptions map[string]string) (*PersonDetails, error) {
+	m.record("GetPersonDetails", id, urlOptions)
+	if m.GetPersonDetailsFunc != nil {
+		return m.GetPersonDetailsFunc(id, urlOptions)
+	}
+	if m.GetPersonDetailsWithContextFunc != nil {
+		return m.GetPersonDetailsWithContextFunc(context.Background(), id, urlOptions)
+	}
+	var r0 *PersonDetails
+	return r0, fmt.Errorf("%w: PeopleService.GetPersonDetails", ErrNotStubbed)
+}
+
+// GetPersonDetailsWithContext records the call and calls GetPersonDetailsWithContextFunc.
+func (m *PeopleServiceMock) GetPersonDetailsWithContext(ctx context.Context, id int, urlOptions map[string]string) (*PersonDetails, error) {
+	m.record("GetPersonDetailsWithContext", ctx, id, urlOptions)
+	if m.GetPersonDetailsWithContextFunc != nil {
+		return m.GetPersonDetailsWithContextFunc(ctx, id, urlOptions)
+	}
+	var r0 *PersonDetails
+	return r0, fmt.Errorf("%w: PeopleService.GetPersonDetailsWithContext", ErrNotStubbed)
+}
+
+// GetPersonChanges records the call and calls GetPersonChangesFunc.
+func (m *PeopleServiceMock) GetPersonChanges(id int, urlOptions map[string]string) (*PersonChanges, error) {
+	m.record("GetPersonChanges", id, urlOptions)
+	if m.GetPersonChangesFunc != nil {
+		return m.GetPersonChangesFunc(id, urlOptions)
+	}
+	if m.GetPersonChangesWithContextFunc != nil {
+		return m.GetPersonChangesWithContextFunc(context.Background(), id, urlOptions)
+	}
+	var r0 *PersonChanges
+	return r0, fmt.Errorf("%w: PeopleService.GetPersonChanges", ErrNotStubbed)
+}
+
+// GetPersonChangesWithContext records the call and calls GetPersonChangesWithContextFunc.
+func (m *PeopleServiceMock) GetPersonChangesWithContext(ctx context.Context, id int, urlOptions map[string]string) (*PersonChanges, error) {
+	m.record("GetPersonChangesWithContext", ctx, id, urlOptions)
+	if m.GetPersonChangesWithContextFunc != nil {
+		return m.GetPersonChangesWithContextFunc(ctx, id, urlOptions)
+	}
+	var r0 *PersonChanges
+	return r0, fmt.Errorf("%w: PeopleService.GetPersonChangesWithContext", ErrNotStubbed)
+}
+
+// GetPersonMovieCredits records the call and calls GetPersonMovieCreditsFunc.
+func (m *PeopleServiceMock) GetPersonMovieCredits(id int, urlOptions map[string]string) (*PersonMovieCredits, error) {
+	m.record("GetPersonMovieCredits", id, urlOptions)
+	if m.GetPersonMovieCreditsFunc != nil {
+		return m.GetPersonMovieCreditsFunc(id, urlOptions)
+	}
+	if m.GetPersonMovieCreditsWithContextFunc != nil {
+		return m.GetPersonMovieCreditsWithContextFunc(context.Background(), id, urlOptions)
+	}
+	var r0 *PersonMovieCredits
+	return r0, fmt.Errorf("%w: PeopleService.GetPersonMovieCredits", ErrNotStubbed)
+}
+
+// GetPersonMovieCreditsWithContext records the call and calls GetPersonMovieCreditsWithContextFunc.
+func (m *PeopleServiceMock) GetPersonMovieCreditsWithContext(ctx context.Context, id int, urlOptions map[string]string) (*PersonMovieCredits, error) {
+	m.record("GetPersonMovieCreditsWithContext", ctx, id, urlOptions)
+	if m.GetPersonMovieCreditsWithContextFunc != nil {
+		return m.GetPersonMovieCreditsWithContextFunc(ctx, id, urlOptions)
+	}
+	var r0 *PersonMovieCredits
+	return r0, fmt.Errorf("%w: PeopleService.GetPersonMovieCreditsWithContext", ErrNotStubbed)
+}
+
+// GetPersonTVCredits records the call and calls GetPersonTVCreditsFunc.
+func (m *PeopleServiceMock) GetPersonTVCredits(id int, urlOptions map[string]string) (*PersonTVCredits, error) {
+	m.record("GetPersonTVCredits", id, urlOptions)
+	if m.GetPersonTVCreditsFunc != nil {
+		return m.GetPersonTVCreditsFunc(id, urlOptions)
+	}
+	if m.GetPersonTVCreditsWithContextFunc != nil {
+		return m.GetPersonTVCreditsWithContextFunc(context.Background(), id, urlOptions)
+	}
+	var r0 *PersonTVCredits
+	return r0, fmt.Errorf("%w: PeopleService.GetPersonTVCredits", ErrNotStubbed)
+}
+
+// GetPersonTVCreditsWithContext records the call and calls GetPersonTVCreditsWithContextFunc.
+func (m *PeopleServiceMock) GetPersonTVCreditsWithContext(ctx context.Context, id int, urlOptions map[string]string) (*PersonTVCredits, error) {
+	m.record("GetPersonTVCreditsWithContext", ctx, id, urlOptions)
+	if m.GetPersonTVCreditsWithContextFunc != nil {
+		return m.GetPersonTVCreditsWithContextFunc(ctx, id, urlOptions)
+	}
+	var r0 *PersonTVCredits
+	return r0, fmt.Errorf("%w: PeopleService.GetPersonTVCreditsWithContext", ErrNotStubbed)
+}
+
+// GetPersonCombinedCredits records the call and calls GetPersonCombinedCreditsFunc.
+func (m *PeopleServiceMock) GetPersonCombinedCredits(id int, urlOptions map[string]string) (*PersonCombinedCredits, error) {
+	m.record("GetPersonCombinedCredits", id, urlOptions)
+	if m.GetPersonCombinedCreditsFunc != nil {
+		return m.GetPersonCombinedCreditsFunc(id, urlOptions)
+	}
+	if m.GetPersonCombinedCreditsWithContextFunc != nil {
+		return m.GetPersonCombinedCreditsWithContextFunc(context.Background(), id, urlOptions)
+	}
+	var r0 *PersonCombinedCredits
+	return r0, fmt.Errorf("%w: PeopleService.GetPersonCombinedCredits", ErrNotStubbed)
+}
+
+// GetPersonCombinedCreditsWithContext records the call and calls GetPersonCombinedCreditsWithContextFunc.
+func (m *PeopleServiceMock) GetPersonCombinedCreditsWithContext(ctx context.Context, id int, urlOptions map[string]string) (*PersonCombinedCredits, error) {
+	m.record("GetPersonCombinedCreditsWithContext", ctx, id, urlOptions)
+	if m.GetPersonCombinedCreditsWithContextFunc != nil {
+		return m.GetPersonCombinedCreditsWithContextFunc(ctx, id, urlOptions)
+	}
+	var r0 *PersonCombinedCredits
+	return r0, fmt.Errorf("%w: PeopleService.GetPersonCombinedCreditsWithContext", ErrNotStubbed)
+}
+
+// GetPersonExternalIDs records the call and calls GetPersonExternalIDsFunc.
+func (m *PeopleServiceMock) GetPersonExternalIDs(id int, urlOptions map[string]string) (*PersonExternalIDs, error) {
+	m.record("GetPersonExternalIDs", id, urlOptions)
+	if m.GetPersonExternalIDsFunc != nil {
+		return m.GetPersonExternalIDsFunc(id, urlOptions)
+	}
+	if m.GetPersonExternalIDsWithContextFunc != nil {
+		return m.GetPersonExternalIDsWithContextFunc(context.Background(), id, urlOptions)
+	}
+	var r0 *PersonExternalIDs
+	return r0, fmt.Errorf("%w: PeopleService.GetPersonExternalIDs", ErrNotStubbed)
+}
+
+// GetPersonExternalIDsWithContext records the call and calls GetPersonExternalIDsWithContextFunc.
+func (m *PeopleServiceMock) GetPersonExternalIDsWithContext(ctx context.Context, id int, urlOptions map[string]string) (*PersonExternalIDs, error) {
+	m.record("GetPersonExternalIDsWithContext", ctx, id, urlOptions)
+	if m.GetPersonExternalIDsWithContextFunc != nil {
+		return m.GetPersonExternalIDsWithContextFunc(ctx, id, urlOptions)
+	}
+	var r0 *PersonExternalIDs
+	return r0, fmt.Errorf("%w: PeopleService.GetPersonExternalIDsWithContext", ErrNotStubbed)
+}
+
+// GetPersonImages records the call and calls GetPersonImagesFunc.
+func (m *PeopleServiceMock) GetPersonImages(id int) (*PersonImages, error) {
+	m.record("GetPersonImages", id)
+	if m.GetPersonImagesFunc != nil {
+		return m.GetPersonImagesFunc(id)
+	}
+	if m.GetPersonImagesWithContextFunc != nil {
+		return m.GetPersonImagesWithContextFunc(context.Background(), id)
+	}
+	var r0 *PersonImages
+	return r0, fmt.Errorf("%w: PeopleService.GetPersonImages", ErrNotStubbed)
+}
+
+// GetPersonImagesWithContext records the call and calls GetPersonImagesWithContextFunc.
+func (m *PeopleServiceMock) GetPersonImagesWithContext(ctx context.Context, id int) (*PersonImages, error) {
+	m.record("GetPersonImagesWithContext", ctx, id)
+	if m.GetPersonImagesWithContextFunc != nil {
+		return m.GetPersonImagesWithContextFunc(ctx, id)
+	}
+	var r0 *PersonImages
+	return r0, fmt.Errorf("%w: PeopleService.GetPersonImagesWithContext", ErrNotStubbed)
+}
+
+// GetPersonTranslations records the call and calls GetPersonTranslationsFunc.
+func (m *PeopleServiceMock) GetPersonTranslations(id int, urlOptions map[string]string) (*PersonTranslations, error) {
+	m.record("GetPersonTranslations", id, urlOptions)
+	if m.GetPersonTranslationsFunc != nil {
+		return m.GetPersonTranslationsFunc(id, urlOptions)
+	}
+	if m.GetPersonTranslationsWithContextFunc != nil {
+		return m.GetPersonTranslationsWithContextFunc(context.Background(), id, urlOptions)
+	}
+	var r0 *PersonTranslations
+	return r0, fmt.Errorf("%w: PeopleService.GetPersonTranslations", ErrNotStubbed)
+}
+
+// GetPersonTranslationsWithContext records the call and calls GetPersonTranslationsWithContextFunc.
+func (m *PeopleServiceMock) GetPersonTranslationsWithContext(ctx context.Context, id int, urlOptions map[string]string) (*PersonTranslations, error) {
+	m.record("GetPersonTranslationsWithContext", ctx, id, urlOptions)
+	if m.GetPersonTranslationsWithContextFunc != nil {
+		return m.GetPersonTranslationsWithContextFunc(ctx, id, urlOptions)
+	}
+	var r0 *PersonTranslations
+	return r0, fmt.Errorf("%w: PeopleService.GetPersonTranslationsWithContext", ErrNotStubbed)
+}
+
+// GetPersonLatest records the call and calls GetPersonLatestFunc.
+func (m *PeopleServiceMock) GetPersonLatest(urlOptions map[string]string) (*PersonLatest, error) {
+	m.record("GetPersonLatest", urlOptions)
+	if m.GetPersonLatestFunc != nil {
+		return m.GetPersonLatestFunc(urlOptions)
+	}
+	if m.GetPersonLatestWithContextFunc != nil {
+		return m.GetPersonLatestWithContextFunc(context.Background(), urlOptions)
+	}
+	var r0 *PersonLatest
+	return r0, fmt.Errorf("%w: PeopleService.GetPersonLatest", ErrNotStubbed)
+}
+
+// GetPersonLatestWithContext records the call and calls GetPersonLatestWithContextFunc.
+func (m *PeopleServiceMock) GetPersonLatestWithContext(ctx context.Context, urlOptions map[string]string) (*PersonLatest, error) {
+	m.record("GetPersonLatestWithContext", ctx, urlOptions)
+	if m.GetPersonLatestWithContextFunc != nil {
+		return m.GetPersonLatestWithContextFunc(ctx, urlOptions)
+	}
+	var r0 *PersonLatest
+	return r0, fmt.Errorf("%w: PeopleService.GetPersonLatestWithContext", ErrNotStubbed)
+}
+
+// GetPersonPopular records the call and calls GetPersonPopularFunc.
+func (m *PeopleServiceMock) GetPersonPopular(urlOptions map[string]string) (*PersonPopular, error) {
+	m.record("GetPersonPopular", urlOptions)
+	if m.GetPersonPopularFunc != nil {
+		return m.GetPersonPopularFunc(urlOptions)
+	}
+	if m.GetPersonPopularWithContextFunc != nil {
+		return m.GetPersonPopularWithContextFunc(context.Background(), urlOptions)
+	}
+	var r0 *PersonPopular
+	return r0, fmt.Errorf("%w: PeopleService.GetPersonPopular", ErrNotStubbed)
+}
+
+// GetPersonPopularWithContext records the call and calls GetPersonPopularWithContextFunc.
+func (m *PeopleServiceMock) GetPersonPopularWithContext(ctx context.Context, urlOptions map[string]string) (*PersonPopular, error) {
+	m.record("GetPersonPopularWithContext", ctx, urlOptions)
+	if m.GetPersonPopularWithContextFunc != nil {
+		return m.GetPersonPopularWithContextFunc(ctx, urlOptions)
+	}
+	var r0 *PersonPopular
+	return r0, fmt.Errorf("%w: PeopleService.GetPersonPopularWithContext", ErrNotStubbed)
+}
+
+// ReviewsServiceMock type is a ReviewsService recording its calls.
+//
+// Set the Func fields to stub the methods. A method without
+// a Func falls back to the Func of its WithContext variant,
+// then returns zero values and ErrNotStubbed.
+type ReviewsServiceMock struct {
+	MockRecorder
+
+	GetReviewDetailsFunc            func(string) (*ReviewDetails, error)
+	GetReviewDetailsWithContextFunc func(context.Context, string) (*ReviewDetails, error)
+}
+
+var _ ReviewsService = (*ReviewsServiceMock)(nil)
+
+// GetReviewDetails records the call and calls GetReviewDetailsFunc.
+func (m *ReviewsServiceMock) GetReviewDetails(id string) (*ReviewDetails, error) {
+	m.record("GetReviewDetails", id)
+	if m.GetReviewDetailsFunc != nil {
+		return m.GetReviewDetailsFunc(id)
+	}
+	if m.GetReviewDetailsWithContextFunc != nil {
+		return m.GetReviewDetailsWithContextFunc(context.Background(), id)
+	}
+	var r0 *ReviewDetails
+	return r0, fmt.Errorf("%w: ReviewsService.GetReviewDetails", ErrNotStubbed)
+}
+
+// GetReviewDetailsWithContext records the call and calls GetReviewDetailsWithContextFunc.
+func (m *ReviewsServiceMock) GetReviewDetailsWithContext(ctx context.Context, id string) (*ReviewDetails, error) {
+	m.record("GetReviewDetailsWithContext", ctx, id)
+	if m.GetReviewDetailsWithContextFunc != nil {
+		return m.GetReviewDetailsWithContextFunc(ctx, id)
+	}
+	var r0 *ReviewDetails
+	return r0, fmt.Errorf("%w: ReviewsService.GetReviewDetailsWithContext", ErrNotStubbed)
+}
+
+// SearchServiceMock type is a SearchService recording its calls.
+//
+// Set the Func fields to stub the methods. A method without
+// a Func falls back to the Func of its WithContext variant,
+// then returns zero values and ErrNotStubbed.
+type SearchServiceMock struct {
+	MockRecorder
+
+	GetSearchCompaniesFunc              func(string, map[string]string) (*SearchCompanies, error)
+	GetSearchCompaniesWithContextFunc   func(context.Context, string, map[string]string) (*SearchCompanies, error)
+	GetSearchCollectionsFunc            func(string, map[string]string) (*SearchCollections, error)
+	GetSearchCollectionsWithContextFunc func(context.Context, string, map[string]string) (*SearchCollections, error)
+	GetSearchKeywordsFunc               func(string, map[string]string) (*SearchKeywords, error)
+	GetSearchKeywordsWithContextFunc    func(context.Context, string, map[string]string) (*SearchKeywords, error)
+	GetSearchMoviesFunc                 func(string, map[string]string) (*SearchMovies, error)
+	GetSearchMoviesWithContextFunc      func(context.Context, string, map[string]string) (*SearchMovies, error)
+	GetSearchMultiFunc                  func(string, map[string]string) (*SearchMulti, error)
+	GetSearchMultiWithContextFunc       func(context.Context, string, map[string]string) (*SearchMulti, error)
+	GetSearchPeopleFunc                 func(string, map[string]string) (*SearchPeople, error)
+	GetSearchPeopleWithContextFunc      func(context.Context, string, map[string]string) (*SearchPeople, error)
+	GetSearchTVShowFunc                 func(string, map[string]string) (*SearchTVShows, error)
+	GetSearchTVShowWithContextFunc      func(context.Context, string, map[string]string) (*SearchTVShows, error)
+}
+
+var _ SearchService = (*SearchServiceMock)(nil)
+
+// GetSearchCompanies records the call and calls GetSearchCompaniesFunc.
+func (m *SearchServiceMock) GetSearchCompanies(query string, urlOptions map[string]string) (*SearchCompanies, error) {
+	m.record("GetSearchCompanies", query, urlOptions)
+	if m.GetSearchCompaniesFunc != nil {
+		return m.GetSearchCompaniesFunc(query, urlOptions)
+	}
+	if m.GetSearchCompaniesWithContextFunc != nil {
+		return m.GetSearchCompaniesWithContextFunc(context.Background(), query, urlOptions)
+	}
+	var r0 *SearchCompanies
+	return r0, fmt.Errorf("%w: SearchService.GetSearchCompanies", ErrNotStubbed)
+}
+
+// GetSearchCompaniesWithContext records the call and calls GetSearchCompaniesWithContextFunc.
+func (m *SearchServiceMock) GetSearchCompaniesWithContext(ctx context.Context, query string, urlOptions map[string]string) (*SearchCompanies, error) {
+	m.record("GetSearchCompaniesWithContext", ctx, query, urlOptions)
+	if m.GetSearchCompaniesWithContextFunc != nil {
+		return m.GetSearchCompaniesWithContextFunc(ctx, query, urlOptions)
+	}
+	var r0 *SearchCompanies
+	return r0, fmt.Errorf("%w: SearchService.GetSearchCompaniesWithContext", ErrNotStubbed)
+}
+
+// GetSearchCollections records the call and calls GetSearchCollectionsFunc.
+func (m *SearchServiceMock) GetSearchCollections(query string, urlOptions map[string]string) (*SearchCollections, error) {
+	m.record("GetSearchCollections", query, urlOptions)
+	if m.GetSearchCollectionsFunc != nil {
+		return m.GetSearchCollectionsFunc(query, urlOptions)
+	}
+	if m.GetSearchCollectionsWithContextFunc != nil {
+		return m.GetSearchCollectionsWithContextFunc(context.Background(), query, urlOptions)
+	}
+	var r0 *SearchCollections
+	return r0, fmt.Errorf("%w: SearchService.GetSearchCollections", ErrNotStubbed)
+}
+
+// GetSearchCollectionsWithContext records the call and calls GetSearchCollectionsWithContextFunc.
+func (m *SearchServiceMock) GetSearchCollectionsWithContext(ctx context.Context, query string, urlOptions map[string]string) (*SearchCollections, error) {
+	m.record("GetSearchCollectionsWithContext", ctx, query, urlOptions)
+	if m.GetSearchCollectionsWithContextFunc != nil {
+		return m.GetSearchCollectionsWithContextFunc(ctx, query, urlOptions)
+	}
+	var r0 *SearchCollections
+	return r0, fmt.Errorf("%w: SearchService.GetSearchCollectionsWithContext", ErrNotStubbed)
+}
+
+// GetSearchKeywords records the call and calls GetSearchKeywordsFunc.
+func (m *SearchServiceMock) GetSearchKeywords(query string, urlOptions map[string]string) (*SearchKeywords, error) {
+	m.record("GetSearchKeywords", query, urlOptions)
+	if m.GetSearchKeywordsFunc != nil {
+		return m.GetSearchKeywordsFunc(query, urlOptions)
+	}
+	if m.GetSearchKeywordsWithContextFunc != nil {
+		return m.GetSearchKeywordsWithContextFunc(context.Background(), query, urlOptions)
+	}
+	var r0 *SearchKeywords
+	return r0, fmt.Errorf("%w: SearchService.GetSearchKeywords", ErrNotStubbed)
+}
+
+// GetSearchKeywordsWithContext records the call and calls GetSearchKeywordsWithContextFunc.
+func (m *SearchServiceMock) GetSearchKeywordsWithContext(ctx context.Context, query string, urlOptions map[string]string) (*SearchKeywords, error) {
+	m.record("GetSearchKeywordsWithContext", ctx, query, urlOptions)
+	if m.GetSearchKeywordsWithContextFunc != nil {
+		return m.GetSearchKeywordsWithContextFunc(ctx, query, urlOptions)
+	}
+	var r0 *SearchKeywords
+	return r0, fmt.Errorf("%w: SearchService.GetSearchKeywordsWithContext", ErrNotStubbed)
+}
+
+// GetSearchMovies records the call and calls GetSearchMoviesFunc.
+func (m *SearchServiceMock) GetSearchMovies(query string, urlOptions map[string]string) (*SearchMovies, error) {
+	m.record("GetSearchMovies", query, urlOptions)
+	if m.GetSearchMoviesFunc != nil {
+		return m.GetSearchMoviesFunc(query, urlOptions)
+	}
+	if m.GetSearchMoviesWithContextFunc != nil {
+		return m.GetSearchMoviesWithContextFunc(context.Background(), query, urlOptions)
+	}
+	var r0 *SearchMovies
+	return r0, fmt.Errorf("%w: SearchService.GetSearchMovies", ErrNotStubbed)
+}
+
+// GetSearchMoviesWithContext records the call and calls GetSearchMoviesWithContextFunc.
+func (m *SearchServiceMock) GetSearchMoviesWithContext(ctx context.Context, query string, urlOptions map[string]string) (*SearchMovies, error) {
+	m.record("GetSearchMoviesWithContext", ctx, query, urlOptions)
+	if m.GetSearchMoviesWithContextFunc != nil {
+		return m.GetSearchMoviesWithContextFunc(ctx, query, urlOptions)
+	}
+	var r0 *SearchMovies
+	return r0, fmt.Errorf("%w: SearchService.GetSearchMoviesWithContext", ErrNotStubbed)
+}
+
+// GetSearchMulti records the call and calls GetSearchMultiFunc.
+func (m *SearchServiceMock) GetSearchMulti(query string, urlOptions map[string]string) (*SearchMulti, error) {
+	m.record("GetSearchMulti", query, urlOptions)
+	if m.GetSearchMultiFunc != nil {
+		return m.GetSearchMultiFunc(query, urlOptions)
+	}
+	if m.GetSearchMultiWithContextFunc != nil {
+		return m.GetSearchMultiWithContextFunc(context.Background(), query, urlOptions)
+	}
+	var r0 *SearchMulti
+	return r0, fmt.Errorf("%w: SearchService.GetSearchMulti", ErrNotStubbed)
+}
+
+// GetSearchMultiWithContext records the call and calls GetSearchMultiWithContextFunc.
+func (m *SearchServiceMock) GetSearchMultiWithContext(ctx context.Context, query string, urlOptions map[string]string) (*SearchMulti, error) {
+	m.record("GetSearchMultiWithContext", ctx, query, urlOptions)
+	if m.GetSearchMultiWithContextFunc != nil {
+		return m.GetSearchMultiWithContextFunc(ctx, query, urlOptions)
+	}
+	var r0 *SearchMulti
+	return r0, fmt.Errorf("%w: SearchService.GetSearchMultiWithContext", ErrNotStubbed)
+}
+
+// GetSearchPeople records the call and calls GetSearchPeopleFunc.
+func (m *SearchServiceMock) GetSearchPeople(query string, urlOptions map[string]string) (*SearchPeople, error) {
+	m.record("GetSearchPeople", query, urlOptions)
+	if m.GetSearchPeopleFunc != nil {
+		return m.GetSearchPeopleFunc(query, urlOptions)
+	}
+	if m.GetSearchPeopleWithContextFunc != nil {
+		return m.GetSearchPeopleWithContextFunc(context.Background(), query, urlOptions)
+	}
+	var r0 *SearchPeople
+	return r0, fmt.Errorf("%w: SearchService.GetSearchPeople", ErrNotStubbed)
+}
+
+// GetSearchPeopleWithContext records the call and calls GetSearchPeopleWithContextFunc.
+func (m *SearchServiceMock) GetSearchPeopleWithContext(ctx context.Context, query string, urlOptions map[string]string) (*SearchPeople, error) {
+	m.record("GetSearchPeopleWithContext", ctx, query, urlOptions)
+	if m.GetSearchPeopleWithContextFunc != nil {
+		return m.GetSearchPeopleWithContextFunc(ctx, query, urlOptions)
+	}
+	var r0 *SearchPeople
+	return r0, fmt.Errorf("%w: SearchService.GetSearchPeopleWithContext", ErrNotStubbed)
+}
+
+// GetSearchTVShow records the call and calls GetSearchTVShowFunc.
+func (m *SearchServiceMock) GetSearchTVShow(query string, urlOptions map[string]string) (*SearchTVShows, error) {
+	m.record("GetSearchTVShow", query, urlOptions)
+	if m.GetSearchTVShowFunc != nil {
+		return m.GetSearchTVShowFunc(query, urlOptions)
+	}
+	if m.GetSearchTVShowWithContextFunc != nil {
+		return m.GetSearchTVShowWithContextFunc(context.Background(), query, urlOptions)
+	}
+	var r0 *SearchTVShows
+	return r0, fmt.Errorf("%w: SearchService.GetSearchTVShow", ErrNotStubbed)
+}
+
+// GetSearchTVShowWithContext records the call and calls GetSearchTVShowWithContextFunc.
+func (m *SearchServiceMock) GetSearchTVShowWithContext(ctx context.Context, query string, urlOptions map[string]string) (*SearchTVShows, error) {
+	m.record("GetSearchTVShowWithContext", ctx, query, urlOptions)
+	if m.GetSearchTVShowWithContextFunc != nil {
+		return m.GetSearchTVShowWithContextFunc(ctx, query, urlOptions)
+	}
+	var r0 *SearchTVShows
+	return r0, fmt.Errorf("%w: SearchService.GetSearchTVShowWithContext", ErrNotStubbed)
+}
+
+// TrendingServiceMock type is a TrendingService recording its calls.
+//
+// Set the Func fields to stub the methods. A method without
+// a Func falls back to the Func of its WithContext variant,
+// then returns zero values and ErrNotStubbed.
+type TrendingServiceMock struct {
+	MockRecorder
+
+	GetTrendingFunc            func(string, string, map[string]string) (*Trending, error)
+	GetTrendingWithContextFunc func(context.Context, string, string, map[string]string) (*Trending, error)
+}
+
+var _ TrendingService = (*TrendingServiceMock)(nil)
+
+// GetTrending records the call and calls GetTrendingFunc.
+func (m *TrendingServiceMock) GetTrending(mediaType string, timeWindow string, urlOptions map[string]string) (*Trending, error) {
+	m.record("GetTrending", mediaType, timeWindow, urlOptions)
+	if m.GetTrendingFunc != nil {
+		return m.GetTrendingFunc(mediaType, timeWindow, urlOptions)
+	}
+	if m.GetTrendingWithContextFunc != nil {
+		return m.GetTrendingWithContextFunc(context.Background(), mediaType, timeWindow, urlOptions)
+	}
+	var r0 *Trending
+	return r0, fmt.Errorf("%w: TrendingService.GetTrending", ErrNotStubbed)
+}
+
+// GetTrendingWithContext records the call and calls GetTrendingWithContextFunc.
+func (m *TrendingServiceMock) GetTrendingWithContext(ctx context.Context, mediaType string, timeWindow string, urlOptions map[string]string) (*Trending, error) {
+	m.record("GetTrendingWithContext", ctx, mediaType, timeWindow, urlOptions)
+	if m.GetTrendingWithContextFunc != nil {
+		return m.GetTrendingWithContextFunc(ctx, mediaType, timeWindow, urlOptions)
+	}
+	var r0 *Trending
+	return r0, fmt.Errorf("%w: TrendingService.GetTrendingWithContext", ErrNotStubbed)
+}
+
+// TVServiceMock type is a TVService recording its calls.
+//
+// Set the Func fields to stub the methods. A method without
+// a Func falls back to the Func of its WithContext variant,
+// then returns zero values and ErrNotStubbed.
+type TVServiceMock struct {
+	MockRecorder
+
+	GetTVDetailsFunc                         func(int, map[string]string) (*TVDetails, error)
+	GetTVDetailsWithContextFunc              func(context.Context, int, map[string]string) (*TVDetails, error)
+	GetTVAccountStatesFunc                   func(int, map[string]string) (*TVAccountStates, error)
+	GetTVAccountStatesWithContextFunc        func(context.Context, int, map[string]string) (*TVAccountStates, error)
+	GetTVAggregateCreditsFunc                func(int, map[string]string) (*TVAggregateCredits, error)
+	GetTVAggregateCreditsWithContextFunc     func(context.Context, int, map[string]string) (*TVAggregateCredits, error)
+	GetTVAlternativeTitlesFunc               func(int, map[string]string) (*TVAlternativeTitles, error)
+	GetTVAlternativeTitlesWithContextFunc    func(context.Context, int, map[string]string) (*TVAlternativeTitles, error)
+	GetTVChangesFunc                         func(int, map[string]string) (*TVChanges, error)
+	GetTVChangesWithContextFunc              func(context.Context, int, map[string]string) (*TVChanges, error)
+	GetTVContentRatingsFunc                  func(int, map[string]string) (*TVContentRatings, error)
+	GetTVContentRatingsWithContextFunc       func(context.Context, int, map[string]string) (*TVContentRatings, error)
+	GetTVCreditsFunc                         func(int, map[string]string) (*TVCredits, error)
+	GetTVCreditsWithContextFunc              func(context.Context, int, map[string]string) (*TVCredits, error)
+	GetTVEpisodeGroupsFunc                   func(int, map[string]string) (*TVEpisodeGroups, error)
+	GetTVEpisodeGroupsWithContextFunc        func(context.Context, int, map[string]string) (*TVEpisodeGroups, error)
+	GetTVExternalIDsFunc                     func(int, map[string]string) (*TVExternalIDs, error)
+	GetTVExternalIDsWithContextFunc          func(context.Context, int, map[string]string) (*TVExternalIDs, error)
+	GetTVImagesFunc                          func(int, map[string]string) (*TVImages, error)
+	GetTVImagesWithContextFunc               func(context.Context, int, map[string]string) (*TVImages, error)
+	GetTVKeywordsFunc                        func(int) (*TVKeywords, error)
+	GetTVKeywordsWithContextFunc             func(context.Context, int) (*TVKeywords, error)
+	GetTVRecommendationsFunc                 func(int, map[string]string) (*TVRecommendations, error)
+	GetTVRecommendationsWithContextFunc      func(context.Context, int, map[string]string) (*TVRecommendations, error)
+	GetTVReviewsFunc                         func(int, map[string]string) (*TVReviews, error)
+	GetTVReviewsWithContextFunc              func(context.Context, int, map[string]string) (*TVReviews, error)
+	GetTVScreenedTheatricallyFunc            func(int) (*TVScreenedTheatrically, error)
+	GetTVScreenedTheatricallyWithContextFunc func(context.Context, int) (*TVScreenedTheatrically, error)
+	GetTVSimilarFunc                         func(int, map[string]string) (*TVSimilar, error)
+	GetTVSimilarWithContextFunc              func(context.Context, int, map[string]string) (*TVSimilar, error)
+	GetTVWatchProvidersFunc                  func(int, map[string]string) (*WatchProviderResults, error)
+	GetTVWatchProvidersWithContextFunc       func(context.Context, int, map[string]string) (*WatchProviderResults, error)
+	GetTVTranslationsFunc                    func(int, map[string]string) (*TVTranslations, error)
+	GetTVTranslationsWithContextFunc         func(context.Context, int, map[string]string) (*TVTranslations, error)
+	GetTVVideosFunc                          func(int, map[string]string) (*VideoResults, error)
+	GetTVVideosWithContextFunc               func(context.Context, int, map[string]string) (*VideoResults, error)
+	GetTVLatestFunc                          func(map[string]string) (*TVLatest, error)
+	GetTVLatestWithContextFunc               func(context.Context, map[string]string) (*TVLatest, error)
+	GetTVAiringTodayFunc                     func(map[string]string) (*TVAiringToday, error)
+	GetTVAiringTodayWithContextFunc          func(context.Context, map[string]string) (*TVAiringToday, error)
+	GetTVOnTheAirFunc                        func(map[string]string) (*TVOnTheAir, error)
+	GetTVOnTheAirWithContextFunc             func(context.Context, map[string]string) (*TVOnTheAir, error)
+	GetTVPopularFunc                         func(map[string]string) (*TVPopular, error)
+	GetTVPopularWithContextFunc              func(context.Context, map[string]string) (*TVPopular, error)
+	GetTVTopRatedFunc                        func(map[string]string) (*TVTopRated, error)
+	GetTVTopRatedWithContextFunc             func(context.Context, map[string]string) (*TVTopRated, error)
+	PostTVShowRatingFunc                     func(int, float32, map[string]string) (*Response, error)
+	PostTVShowRatingWithContextFunc          func(context.Context, int, float32, map[string]string) (*Response, error)
+	DeleteTVShowRatingFunc                   func(int, map[string]string) (*Response, error)
+	DeleteTVShowRatingWithContextFunc        func(context.Context, int, map[string]string) (*Response, error)
+	GetTVEpisodeGroupsDetailsFunc            func(string, map[string]string) (*TVEpisodeGroupsDetails, error)
+	GetTVEpisodeGroupsDetailsWithContextFunc func(context.Context, string, map[string]string) (*TVEpisodeGroupsDetails, error)
+}
+
+var _ TVService = (*TVServiceMock)(nil)
+
+// GetTVDetails records the call and calls GetTVDetailsFunc.
+func (m *TVServiceMock) GetTVDetails(id int, urlOptions map[string]string) (*TVDetails, error) {
+	m.record("GetTVDetails", id, urlOptions)
+	if m.GetTVDetailsFunc != nil {
+		return m.GetTVDetailsFunc(id, urlOptions)
+	}
+	if m.GetTVDetailsWithContextFunc != nil {
+		return m.GetTVDetailsWithContextFunc(context.Background(), id, urlOptions)
+	}
+	var r0 *TVDetails
+	return r0, fmt.Errorf("%w: TVService.GetTVDetails", ErrNotStubbed)
+}
+
+// GetTVDetailsWithContext records the call and calls GetTVDetailsWithContextFunc.
+func (m *TVServiceMock) GetTVDetailsWithContext(ctx context.Context, id int, urlOptions map[string]string) (*TVDetails, error) {
+	m.record("GetTVDetailsWithContext", ctx, id, urlOptions)
+	if m.GetTVDetailsWithContextFunc != nil {
+		return m.GetTVDetailsWithContextFunc(ctx, id, urlOptions)
+	}
+	var r0 *TVDetails
+	return r0, fmt.Errorf("%w: TVService.GetTVDetailsWithContext", ErrNotStubbed)
+}
+
+// GetTVAccountStates records the call and calls GetTVAccountStatesFunc.
+func (m *TVServiceMock) GetTVAccountStates(id int, urlOptions map[string]string) (*TVAccountStates, error) {
+	m.record("GetTVAccountStates", id, urlOptions)
+	if m.GetTVAccountStatesFunc != nil {
+		return m.GetTVAccountStatesFunc(id, urlOptions)
+	}
+	if m.GetTVAccountStatesWithContextFunc != nil {
+		return m.GetTVAccountStatesWithContextFunc(context.Background(), id, urlOptions)
+	}
+	var r0 *TVAccountStates
+	return r0, fmt.Errorf("%w: TVService.GetTVAccountStates", ErrNotStubbed)
+}
+
+// GetTVAccountStatesWithContext records the call and calls GetTVAccountStatesWithContextFunc.
+func (m *TVServiceMock) GetTVAccountStatesWithContext(ctx context.Context, id int, urlOptions map[string]string) (*TVAccountStates, error) {
+	m.record("GetTVAccountStatesWithContext", ctx, id, urlOptions)
+	if m.GetTVAccountStatesWithContextFunc != nil {
+		return m.GetTVAccountStatesWithContextFunc(ctx, id, urlOptions)
+	}
+	var r0 *TVAccountStates
+	return r0, fmt.Errorf("%w: TVService.GetTVAccountStatesWithContext", ErrNotStubbed)
+}
+
+// GetTVAggregateCredits records the call and calls GetTVAggregateCreditsFunc.
+func (m *TVServiceMock) GetTVAggregateCredits(id int, urlOptions map[string]string) (*TVAggregateCredits, error) {
+	m.record("GetTVAggregateCredits", id, urlOptions)
+	if m.GetTVAggregateCreditsFunc != nil {
+		return m.GetTVAggregateCreditsFunc(id, urlOptions)
+	}
+	if m.GetTVAggregateCreditsWithContextFunc != nil {
+		return m.GetTVAggregateCreditsWithContextFunc(context.Background(), id, urlOptions)
+	}
+	var r0 *TVAggregateCredits
+	return r0, fmt.Errorf("%w: TVService.GetTVAggregateCredits", ErrNotStubbed)
+}
+
+// GetTVAggregateCreditsWithContext records the call and calls GetTVAggregateCreditsWithContextFunc.
+func (m *TVServiceMock) GetTVAggregateCreditsWithContext(ctx context.Context, id int, urlOptions map[string]string) (*TVAggregateCredits, error) {
+	m.record("GetTVAggregateCreditsWithContext", ctx, id, urlOptions)
+	if m.GetTVAggregateCreditsWithContextFunc != nil {
+		return m.GetTVAggregateCreditsWithContextFunc(ctx, id, urlOptions)
+	}
+	var r0 *TVAggregateCredits
+	return r0, fmt.Errorf("%w: TVService.GetTVAggregateCreditsWithContext", ErrNotStubbed)
+}
+
+// GetTVAlternativeTitles records the call and calls GetTVAlternativeTitlesFunc.
+func (m *TVServiceMock) GetTVAlternativeTitles(id int, urlOptions map[string]string) (*TVAlternativeTitles, error) {
+	m.record("GetTVAlternativeTitles", id, urlOptions)
+	if m.GetTVAlternativeTitlesFunc != nil {
+		return m.GetTVAlternativeTitlesFunc(id, urlOptions)
+	}
+	if m.GetTVAlternativeTitlesWithContextFunc != nil {
+		return m.GetTVAlternativeTitlesWithContextFunc(context.Background(), id, urlOptions)
+	}
+	var r0 *TVAlternativeTitles
+	return r0, fmt.Errorf("%w: TVService.GetTVAlternativeTitles", ErrNotStubbed)
+}
+
+// GetTVAlternativeTitlesWithContext records the call and calls GetTVAlternativeTitlesWithContextFunc.
+func (m *TVServiceMock) GetTVAlternativeTitlesWithContext(ctx context.Context, id int, urlOptions map[string]string) (*TVAlternativeTitles, error) {
+	m.record("GetTVAlternativeTitlesWithContext", ctx, id, urlOptions)
+	if m.GetTVAlternativeTitlesWithContextFunc != nil {
+		return m.GetTVAlternativeTitlesWithContextFunc(ctx, id, urlOptions)
+	}
+	var r0 *TVAlternativeTitles
+	return r0, fmt.Errorf("%w: TVService.GetTVAlternativeTitlesWithContext", ErrNotStubbed)
+}
+
+// GetTVChanges records the call and calls GetTVChangesFunc.
+func (m *TVServiceMock) GetTVChanges(id int, urlOptions map[string]string) (*TVChanges, error) {
+	m.record("GetTVChanges", id, urlOptions)
+	if m.GetTVChangesFunc != nil {
+		return m.GetTVChangesFunc(id, urlOptions)
+	}
+	if m.GetTVChangesWithContextFunc != nil {
+		return m.GetTVChangesWithContextFunc(context.Background(), id, urlOptions)
+	}
+	var r0 *TVChanges
+	return r0, fmt.Errorf("%w: TVService.GetTVChanges", ErrNotStubbed)
+}
+
+// GetTVChangesWithContext records the call and calls GetTVChangesWithContextFunc.
+func (m *TVServiceMock) GetTVChangesWithContext(ctx context.Context, id int, urlOptions map[string]string) (*TVChanges, error) {
+	m.record("GetTVChangesWithContext", ctx, id, urlOptions)
+	if m.GetTVChangesWithContextFunc != nil {
+		return m.GetTVChangesWithContextFunc(ctx, id, urlOptions)
+	}
+	var r0 *TVChanges
+	return r0, fmt.Errorf("%w: TVService.GetTVChangesWithContext", ErrNotStubbed)
+}
+
+// GetTVContentRatings records the call and calls GetTVContentRatingsFunc.
+func (m *TVServiceMock) GetTVContentRatings(id int, urlOptions map[string]string) (*TVContentRatings, error) {
+	m.record("GetTVContentRatings", id, urlOptions)
+	if m.GetTVContentRatingsFunc != nil {
+		return m.GetTVContentRatingsFunc(id, urlOptions)
+	}
+	if m.GetTVContentRatingsWithContextFunc != nil {
+		return m.GetTVContentRatingsWithContextFunc(context.Background(), id, urlOptions)
+	}
+	var r0 *TVContentRatings
+	return r0, fmt.Errorf("%w: TVService.GetTVContentRatings", ErrNotStubbed)
+}
+
+// GetTVContentRatingsWithContext records the call and calls GetTVContentRatingsWithContextFunc.
+func (m *TVServiceMock) GetTVContentRatingsWithContext(ctx context.Context, id int, urlOptions map[string]string) (*TVContentRatings, error) {
+	m.record("GetTVContentRatingsWithContext", ctx, id, urlOptions)
+	if m.GetTVContentRatingsWithContextFunc != nil {
+		return m.GetTVContentRatingsWithContextFunc(ctx, id, urlOptions)
+	}
+	var r0 *TVContentRatings
+	return r0, fmt.Errorf("%w: TVService.GetTVContentRatingsWithContext", ErrNotStubbed)
+}
+
+// GetTVCredits records the call and calls GetTVCreditsFunc.
+func (m *TVServiceMock) GetTVCredits(id int, urlOptions map[string]string) (*TVCredits, error) {
+	m.record("GetTVCredits", id, urlOptions)
+	if m.GetTVCreditsFunc != nil {
+		return m.GetTVCreditsFunc(id, urlOptions)
+	}
+	if m.GetTVCreditsWithContextFunc != nil {
+		return m.GetTVCreditsWithContextFunc(context.Background(), id, urlOptions)
+	}
+	var r0 *TVCredits
+	return r0, fmt.Errorf("%w: TVService.GetTVCredits", ErrNotStubbed)
+}
+
+// GetTVCreditsWithContext records the call and calls GetTVCreditsWithContextFunc.
+func (m *TVServiceMock) GetTVCreditsWithContext(ctx context.Context, id int, urlOptions map[string]string) (*TVCredits, error) {
+	m.record("GetTVCreditsWithContext", ctx, id, urlOptions)
+	if m.GetTVCreditsWithContextFunc != nil {
+		return m.GetTVCreditsWithContextFunc(ctx, id, urlOptions)
+	}
+	var r0 *TVCredits
+	return r0, fmt.Errorf("%w: TVService.GetTVCreditsWithContext", ErrNotStubbed)
+}
+
+// GetTVEpisodeGroups records the call and calls GetTVEpisodeGroupsFunc.
+func (m *TVServiceMock) GetTVEpisodeGroups(id int, urlOptions map[string]string) (*TVEpisodeGroups, error) {
+	m.record("GetTVEpisodeGroups", id, urlOptions)
+	if m.GetTVEpisodeGroupsFunc != nil {
+		return m.GetTVEpisodeGroupsFunc(id, urlOptions)
+	}
+	if m.GetTVEpisodeGroupsWithContextFunc != nil {
+		return m.GetTVEpisodeGroupsWithContextFunc(context.Background(), id, urlOptions)
+	}
+	var r0 *TVEpisodeGroups
+	return r0, fmt.Errorf("%w: TVService.GetTVEpisodeGroups", ErrNotStubbed)
+}
+
+// GetTVEpisodeGroupsWithContext records the call and calls GetTVEpisodeGroupsWithContextFunc.
+func (m *TVServiceMock) GetTVEpisodeGroupsWithContext(ctx context.Context, id int, urlOptions map[string]string) (*TVEpisodeGroups, error) {
+	m.record("GetTVEpisodeGroupsWithContext", ctx, id, urlOptions)
+	if m.GetTVEpisodeGroupsWithContextFunc != nil {
+		return m.GetTVEpisodeGroupsWithContextFunc(ctx, id, urlOptions)
+	}
+	var r0 *TVEpisodeGroups
+	return r0, fmt.Errorf("%w: TVService.GetTVEpisodeGroupsWithContext", ErrNotStubbed)
+}
+
+// GetTVExternalIDs records the call and calls GetTVExternalIDsFunc.
+func (m *TVServiceMock) GetTVExternalIDs(id int, urlOptions map[string]string) (*TVExternalIDs, error) {
+	m.record("GetTVExternalIDs", id, urlOptions)
+	if m.GetTVExternalIDsFunc != nil {
+		return m.GetTVExternalIDsFunc(id, urlOptions)
+	}
+	if m.GetTVExternalIDsWithContextFunc != nil {
+		return m.GetTVExternalIDsWithContextFunc(context.Background(), id, urlOptions)
+	}
+	var r0 *TVExternalIDs
+	return r0, fmt.Errorf("%w: TVService.GetTVExternalIDs", ErrNotStubbed)
+}
+
+// GetTVExternalIDsWithContext records the call and calls GetTVExternalIDsWithContextFunc.
+func (m *TVServiceMock) GetTVExternalIDsWithContext(ctx context.Context, id int, urlOptions map[string]string) (*TVExternalIDs, error) {
+	m.record("GetTVExternalIDsWithContext", ctx, id, urlOptions)
+	if m.GetTVExternalIDsWithContextFunc != nil {
+		return m.GetTVExternalIDsWithContextFunc(ctx, id, urlOptions)
+	}
+	var r0 *TVExternalIDs
+	return r0, fmt.Errorf("%w: TVService.GetTVExternalIDsWithContext", ErrNotStubbed)
+}
+
+// GetTVImages records the call and calls GetTVImagesFunc.
+func (m *TVServiceMock) GetTVImages(id int, urlOptions map[string]string) (*TVImages, error) {
+	m.record("GetTVImages", id, urlOptions)
+	if m.GetTVImagesFunc != nil {
+		return m.GetTVImagesFunc(id, urlOptions)
+	}
+	if m.GetTVImagesWithContextFunc != nil {
+		return m.GetTVImagesWithContextFunc(context.Background(), id, urlOptions)
+	}
+	var r0 *TVImages
+	return r0, fmt.Errorf("%w: TVService.GetTVImages", ErrNotStubbed)
+}
+
+// GetTVImagesWithContext records the call and calls GetTVImagesWithContextFunc.
+func (m *TVServiceMock) GetTVImagesWithContext(ctx context.Context, id int, urlOptions map[string]string) (*TVImages, error) {
+	m.record("GetTVImagesWithContext", ctx, id, urlOptions)
+	if m.GetTVImagesWithContextFunc != nil {
+		return m.GetTVImagesWithContextFunc(ctx, id, urlOptions)
+	}
+	var r0 *TVImages
+	return r0, fmt.Errorf("%w: TVService.GetTVImagesWithContext", ErrNotStubbed)
+}
+
+// GetTVKeywords records the call and calls GetTVKeywordsFunc.
+func (m *TVServiceMock) GetTVKeywords(id int) (*TVKeywords, error) {
+	m.record("GetTVKeywords", id)
+	if m.GetTVKeywordsFunc != nil {
+		return m.GetTVKeywordsFunc(id)
+	}
+	if m.GetTVKeywordsWithContextFunc != nil {
+		return m.GetTVKeywordsWithContextFunc(context.Background(), id)
+	}
+	var r0 *TVKeywords
+	return r0, fmt.Errorf("%w: TVService.GetTVKeywords", ErrNotStubbed)
+}
+
+// GetTVKeywordsWithContext records the call and calls GetTVKeywordsWithContextFunc.
+func (m *TVServiceMock) GetTVKeywordsWithContext(ctx context.Context, id int) (*TVKeywords, error) {
+	m.record("GetTVKeywordsWithContext", ctx, id)
+	if m.GetTVKeywordsWithContextFunc != nil {
+		return m.GetTVKeywordsWithContextFunc(ctx, id)
+	}
+	var r0 *TVKeywords
+	return r0, fmt.Errorf("%w: TVService.GetTVKeywordsWithContext", ErrNotStubbed)
+}
+
+// GetTVRecommendations records the call and calls GetTVRecommendationsFunc.
+func (m *TVServiceMock) GetTVRecommendations(id int, urlOptions map[string]string) (*TVRecommendations, error) {
+	m.record("GetTVRecommendations", id, urlOptions)
+	if m.GetTVRecommendationsFunc != nil {
+		return m.GetTVRecommendationsFunc(id, urlOptions)
+	}
+	if m.GetTVRecommendationsWithContextFunc != nil {
+		return m.GetTVRecommendationsWithContextFunc(context.Background(), id, urlOptions)
+	}
+	var r0 *TVRecommendations
+	return r0, fmt.Errorf("%w: TVService.GetTVRecommendations", ErrNotStubbed)
+}
+
+// GetTVRecommendationsWithContext records the call and calls GetTVRecommendationsWithContextFunc.
+func (m *TVServiceMock) GetTVRecommendationsWithContext(ctx context.Context, id int, urlOptions map[string]string) (*TVRecommendations, error) {
+	m.record("GetTVRecommendationsWithContext", ctx, id, urlOptions)
+	if m.GetTVRecommendationsWithContextFunc != nil {
+		return m.GetTVRecommendationsWithContextFunc(ctx, id, urlOptions)
+	}
+	var r0 *TVRecommendations
+	return r0, fmt.Errorf("%w: TVService.GetTVRecommendationsWithContext", ErrNotStubbed)
+}
+
+// GetTVReviews records the call and calls GetTVReviewsFunc.
+func (m *TVServiceMock) GetTVReviews(id int, urlOptions map[string]string) (*TVReviews, error) {
+	m.record("GetTVReviews", id, urlOptions)
+	if m.GetTVReviewsFunc != nil {
+		return m.GetTVReviewsFunc(id, urlOptions)
+	}
+	if m.GetTVReviewsWithContextFunc != nil {
+		return m.GetTVReviewsWithContextFunc(context.Background(), id, urlOptions)
+	}
+	var r0 *TVReviews
+	return r0, fmt.Errorf("%w: TVService.GetTVReviews", ErrNotStubbed)
+}
+
+// GetTVReviewsWithContext records the call and calls GetTVReviewsWithContextFunc.
+func (m *TVServiceMock) GetTVReviewsWithContext(ctx context.Context, id int, urlOptions map[string]string) (*TVReviews, error) {
+	m.record("GetTVReviewsWithContext", ctx, id, urlOptions)
+	if m.GetTVReviewsWithContextFunc != nil {
+		return m.GetTVReviewsWithContextFunc(ctx, id, urlOptions)
+	}
+	var r0 *TVReviews
+	return r0, fmt.Errorf("%w: TVService.GetTVReviewsWithContext", ErrNotStubbed)
+}
+
+// GetTVScreenedTheatrically records the call and calls GetTVScreenedTheatricallyFunc.
+func (m *TVServiceMock) GetTVScreenedTheatrically(id int) (*TVScreenedTheatrically, error) {
+	m.record("GetTVScreenedTheatrically", id)
+	if m.GetTVScreenedTheatricallyFunc != nil {
+		return m.GetTVScreenedTheatricallyFunc(id)
+	}
+	if m.GetTVScreenedTheatricallyWithContextFunc != nil {
+		return m.GetTVScreenedTheatricallyWithContextFunc(context.Background(), id)
+	}
+	var r0 *TVScreenedTheatrically
+	return r0, fmt.Errorf("%w: TVService.GetTVScreenedTheatrically", ErrNotStubbed)
+}
+
+// GetTVScreenedTheatricallyWithContext records the call and calls GetTVScreenedTheatricallyWithContextFunc.
+func (m *TVServiceMock) GetTVScreenedTheatricallyWithContext(ctx context.Context, id int) (*TVScreenedTheatrically, error) {
+	m.record("GetTVScreenedTheatricallyWithContext", ctx, id)
+	if m.GetTVScreenedTheatricallyWithContextFunc != nil {
+		return m.GetTVScreenedTheatricallyWithContextFunc(ctx, id)
+	}
+	var r0 *TVScreenedTheatrically
+	return r0, fmt.Errorf("%w: TVService.GetTVScreenedTheatricallyWithContext", ErrNotStubbed)
+}
+
+// GetTVSimilar records the call and calls GetTVSimilarFunc.
+func (m *TVServiceMock) GetTVSimilar(id int, urlOptions map[string]string) (*TVSimilar, error) {
+	m.record("GetTVSimilar", id, urlOptions)
+	if m.GetTVSimilarFunc != nil {
+		return m.GetTVSimilarFunc(id, urlOptions)
+	}
+	if m.GetTVSimilarWithContextFunc != nil {
+		return m.GetTVSimilarWithContextFunc(context.Background(), id, urlOptions)
+	}
+	var r0 *TVSimilar
+	return r0, fmt.Errorf("%w: TVService.GetTVSimilar", ErrNotStubbed)
+}
+
+// GetTVSimilarWithContext records the call and calls GetTVSimilarWithContextFunc.
+func (m *TVServiceMock) GetTVSimilarWithContext(ctx context.Context, id int, urlOptions map[string]string) (*TVSimilar, error) {
+	m.record("GetTVSimilarWithContext", ctx, id, urlOptions)
+	if m.GetTVSimilarWithContextFunc != nil {
+		return m.GetTVSimilarWithContextFunc(ctx, id, urlOptions)
+	}
+	var r0 *TVSimilar
+	return r0, fmt.Errorf("%w: TVService.GetTVSimilarWithContext", ErrNotStubbed)
+}
+
+// GetTVWatchProviders records the call and calls GetTVWatchProvidersFunc.
+func (m *TVServiceMock) GetTVWatchProviders(id int, urlOptions map[string]string) (*WatchProviderResults, error) {
+	m.record("GetTVWatchProviders", id, urlOptions)
+	if m.GetTVWatchProvidersFunc != nil {
+		return m.GetTVWatchProvidersFunc(id, urlOptions)
+	}
+	if m.GetTVWatchProvidersWithContextFunc != nil {
+		return m.GetTVWatchProvidersWithContextFunc(context.Background(), id, urlOptions)
+	}
+	var r0 *WatchProviderResults
+	return r0, fmt.Errorf("%w: TVService.GetTVWatchProviders", ErrNotStubbed)
+}
+
+// GetTVWatchProvidersWithContext records the call and calls GetTVWatchProvidersWithContextFunc.
+func (m *TVServiceMock) GetTVWatchProvidersWithContext(ctx context.Context, id int, urlOptions map[string]string) (*WatchProviderResults, error) {
+	m.record("GetTVWatchProvidersWithContext", ctx, id, urlOptions)
+	if m.GetTVWatchProvidersWithContextFunc != nil {
+		return m.GetTVWatchProvidersWithContextFunc(ctx, id, urlOptions)
+	}
+	var r0 *WatchProviderResults
+	return r0, fmt.Errorf("%w: TVService.GetTVWatchProvidersWithContext", ErrNotStubbed)
+}
+
+// GetTVTranslations records the call and calls GetTVTranslationsFunc.
+func (m *TVServiceMock) GetTVTranslations(id int, urlOptions map[string]string) (*TVTranslations, error) {
+	m.record("GetTVTranslations", id, urlOptions)
+	if m.GetTVTranslationsFunc != nil {
+		return m.GetTVTranslationsFunc(id, urlOptions)
+	}
+	if m.GetTVTranslationsWithContextFunc != nil {
+		return m.GetTVTranslationsWithContextFunc(context.Background(), id, urlOptions)
+	}
+	var r0 *TVTranslations
+	return r0, fmt.Errorf("%w: TVService.GetTVTranslations", ErrNotStubbed)
+}
+
+// GetTVTranslationsWithContext records the call and calls GetTVTranslationsWithContextFunc.
+func (m *TVServiceMock) GetTVTranslationsWithContext(ctx context.Context, id int, urlOptions map[string]string) (*TVTranslations, error) {
+	m.record("GetTVTranslationsWithContext", ctx, id, urlOptions)
+	if m.GetTVTranslationsWithContextFunc != nil {
+		return m.GetTVTranslationsWithContextFunc(ctx, id, urlOptions)
+	}
+	var r0 *TVTranslations
+	return r0, fmt.Errorf("%w: TVService.GetTVTranslationsWithContext", ErrNotStubbed)
+}
+
+// GetTVVideos records the call and calls GetTVVideosFunc.
+func (m *TVServiceMock) GetTVVideos(id int, urlOptions map[string]string) (*VideoResults, error) {
+	m.record("GetTVVideos", id, urlOptions)
+	if m.GetTVVideosFunc != nil {
+		return m.GetTVVideosFunc(id, urlOptions)
+	}
+	if m.GetTVVideosWithContextFunc != nil {
+		return m.GetTVVideosWithContextFunc(context.Background(), id, urlOptions)
+	}
+	var r0 *VideoResults
+	return r0, fmt.Errorf("%w: TVService.GetTVVideos", ErrNotStubbed)
+}
+
+// GetTVVideosWithContext records the call and calls GetTVVideosWithContextFunc.
+func (m *TVServiceMock) GetTVVideosWithContext(ctx context.Context, id int, urlOptions map[string]string) (*VideoResults, error) {
+	m.record("GetTVVideosWithContext", ctx, id, urlOptions)
+	if m.GetTVVideosWithContextFunc != nil {
+		return m.GetTVVideosWithContextFunc(ctx, id, urlOptions)
+	}
+	var r0 *VideoResults
+	return r0, fmt.Errorf("%w: TVService.GetTVVideosWithContext", ErrNotStubbed)
+}
+
+// GetTVLatest records the call and calls GetTVLatestFunc.
+func (m *TVServiceMock) GetTVLatest(urlOptions map[string]string) (*TVLatest, error) {
+	m.record("GetTVLatest", urlOptions)
+	if m.GetTVLatestFunc != nil {
+		return m.GetTVLatestFunc(urlOptions)
+	}
+	if m.GetTVLatestWithContextFunc != nil {
+		return m.GetTVLatestWithContextFunc(context.Background(), urlOptions)
+	}
+	var r0 *TVLatest
+	return r0, fmt.Errorf("%w: TVService.GetTVLatest", ErrNotStubbed)
+}
+
+// GetTVLatestWithContext records the call and calls GetTVLatestWithContextFunc.
+func (m *TVServiceMock) GetTVLatestWithContext(ctx context.Context, urlOptions map[string]string) (*TVLatest, error) {
+	m.record("GetTVLatestWithContext", ctx, urlOptions)
+	if m.GetTVLatestWithContextFunc != nil {
+		return m.GetTVLatestWithContextFunc(ctx, urlOptions)
+	}
+	var r0 *TVLatest
+	return r0, fmt.Errorf("%w: TVService.GetTVLatestWithContext", ErrNotStubbed)
+}
+
+// GetTVAiringToday records the call and calls GetTVAiringTodayFunc.
+func (m *TVServiceMock) GetTVAiringToday(urlOptions map[string]string) (*TVAiringToday, error) {
+	m.record("GetTVAiringToday", urlOptions)
+	if m.GetTVAiringTodayFunc != nil {
+		return m.GetTVAiringTodayFunc(urlOptions)
+	}
+	if m.GetTVAiringTodayWithContextFunc != nil {
+		return m.GetTVAiringTodayWithContextFunc(context.Background(), urlOptions)
+	}
+	var r0 *TVAiringToday
+	return r0, fmt.Errorf("%w: TVService.GetTVAiringToday", ErrNotStubbed)
+}
+
+// GetTVAiringTodayWithContext records the call and calls GetTVAiringTodayWithContextFunc.
+func (m *TVServiceMock) GetTVAiringTodayWithContext(ctx context.Context, urlOptions map[string]string) (*TVAiringToday, error) {
+	m.record("GetTVAiringTodayWithContext", ctx, urlOptions)
+	if m.GetTVAiringTodayWithContextFunc != nil {
+		return m.GetTVAiringTodayWithContextFunc(ctx, urlOptions)
+	}
+	var r0 *TVAiringToday
+	return r0, fmt.Errorf("%w: TVService.GetTVAiringTodayWithContext", ErrNotStubbed)
+}
+
+// GetTVOnTheAir records the call and calls GetTVOnTheAirFunc.
+func (m *TVServiceMock) GetTVOnTheAir(urlOptions map[string]string) (*TVOnTheAir, error) {
+	m.record("GetTVOnTheAir", urlOptions)
+	if m.GetTVOnTheAirFunc != nil {
+		return m.GetTVOnTheAirFunc(urlOptions)
+	}
+	if m.GetTVOnTheAirWithContextFunc != nil {
+		return m.GetTVOnTheAirWithContextFunc(context.Background(), urlOptions)
+	}
+	var r0 *TVOnTheAir
+	return r0, fmt.Errorf("%w: TVService.GetTVOnTheAir", ErrNotStubbed)
+}
+
+// GetTVOnTheAirWithContext records the call and calls GetTVOnTheAirWithContextFunc.
+func (m *TVServiceMock) GetTVOnTheAirWithContext(ctx context.Context, urlOptions map[string]string) (*TVOnTheAir, error) {
+	m.record("GetTVOnTheAirWithContext", ctx, urlOptions)
+	if m.GetTVOnTheAirWithContextFunc != nil {
+		return m.GetTVOnTheAirWithContextFunc(ctx, urlOptions)
+	}
+	var r0 *TVOnTheAir
+	return r0, fmt.Errorf("%w: TVService.GetTVOnTheAirWithContext", ErrNotStubbed)
+}
+
+// GetTVPopular records the call and calls GetTVPopularFunc.
+func (m *TVServiceMock) GetTVPopular(urlOptions map[string]string) (*TVPopular, error) {
+	m.record("GetTVPopular", urlOptions)
+	if m.GetTVPopularFunc != nil {
+		return m.GetTVPopularFunc(urlOptions)
+	}
+	if m.GetTVPopularWithContextFunc != nil {
+		return m.GetTVPopularWithContextFunc(context.Background(), urlOptions)
+	}
+	var r0 *TVPopular
+	return r0, fmt.Errorf("%w: TVService.GetTVPopular", ErrNotStubbed)
+}
+
+// GetTVPopularWithContext records the call and calls GetTVPopularWithContextFunc.
+func (m *TVServiceMock) GetTVPopularWithContext(ctx context.Context, urlOptions map[string]string) (*TVPopular, error) {
+	m.record("GetTVPopularWithContext", ctx, urlOptions)
+	if m.GetTVPopularWithContextFunc != nil {
+		return m.GetTVPopularWithContextFunc(ctx, urlOptions)
+	}
+	var r0 *TVPopular
+	return r0, fmt.Errorf("%w: TVService.GetTVPopularWithContext", ErrNotStubbed)
+}
+
+// GetTVTopRated records the call and calls GetTVTopRatedFunc.
+func (m *TVServiceMock) GetTVTopRated(urlOptions map[string]string) (*TVTopRated, error) {
+	m.record("GetTVTopRated", urlOptions)
+	if m.GetTVTopRatedFunc != nil {
+		return m.GetTVTopRatedFunc(urlOptions)
+	}
+	if m.GetTVTopRatedWithContextFunc != nil {
+		return m.GetTVTopRatedWithContextFunc(context.Background(), urlOptions)
+	}
+	var r0 *TVTopRated
+	return r0, fmt.Errorf("%w: TVService.GetTVTopRated", ErrNotStubbed)
+}
+
+// GetTVTopRatedWithContext records the call and calls GetTVTopRatedWithContextFunc.
+func (m *TVServiceMock) GetTVTopRatedWithContext(ctx context.Context, urlOptions map[string]string) (*TVTopRated, error) {
+	m.record("GetTVTopRatedWithContext", ctx, urlOptions)
+	if m.GetTVTopRatedWithContextFunc != nil {
+		return m.GetTVTopRatedWithContextFunc(ctx, urlOptions)
+	}
+	var r0 *TVTopRated
+	return r0, fmt.Errorf("%w: TVService.GetTVTopRatedWithContext", ErrNotStubbed)
+}
+
+// PostTVShowRating records the call and calls PostTVShowRatingFunc.
+func (m *TVServiceMock) PostTVShowRating(id int, rating float32, urlOptions map[string]string) (*Response, error) {
+	m.record("PostTVShowRating", id, rating, urlOptions)
+	if m.PostTVShowRatingFunc != nil {
+		return m.PostTVShowRatingFunc(id, rating, urlOptions)
+	}
+	if m.PostTVShowRatingWithContextFunc != nil {
+		return m.PostTVShowRatingWithContextFunc(context.Background(), id, rating, urlOptions)
+	}
+	var r0 *Response
+	return r0, fmt.Errorf("%w: TVService.PostTVShowRating", ErrNotStubbed)
+}
+
+// PostTVShowRatingWithContext records the call and calls PostTVShowRatingWithContextFunc.
+func (m *TVServiceMock) PostTVShowRatingWithContext(ctx context.Context, id int, rating float32, urlOptions map[string]string) (*Response, error) {
+	m.record("PostTVShowRatingWithContext", ctx, id, rating, urlOptions)
+	if m.PostTVShowRatingWithContextFunc != nil {
+		return m.PostTVShowRatingWithContextFunc(ctx, id, rating, urlOptions)
+	}
+	var r0 *Response
+	return r0, fmt.Errorf("%w: TVService.PostTVShowRatingWithContext", ErrNotStubbed)
+}
+
+// DeleteTVShowRating records the call and calls DeleteTVShowRatingFunc.
+func (m *TVServiceMock) DeleteTVShowRating(id int, urlOptions map[string]string) (*Response, error) {
+	m.record("DeleteTVShowRating", id, urlOptions)
+	if m.DeleteTVShowRatingFunc != nil {
+		return m.DeleteTVShowRatingFunc(id, urlOptions)
+	}
+	if m.DeleteTVShowRatingWithContextFunc != nil {
+		return m.DeleteTVShowRatingWithContextFunc(context.Background(), id, urlOptions)
+	}
+	var r0 *Response
+	return r0, fmt.Errorf("%w: TVService.DeleteTVShowRating", ErrNotStubbed)
+}
+
+// DeleteTVShowRatingWithContext records the call and calls DeleteTVShowRatingWithContextFunc.
+func (m *TVServiceMock) DeleteTVShowRatingWithContext(ctx context.Context, id int, urlOptions map[string]string) (*Response, error) {
+	m.record("DeleteTVShowRatingWithContext", ctx, id, urlOptions)
+	if m.DeleteTVShowRatingWithContextFunc != nil {
+		return m.DeleteTVShowRatingWithContextFunc(ctx, id, urlOptions)
+	}
+	var r0 *Response
+	return r0, fmt.Errorf("%w: TVService.DeleteTVShowRatingWithContext", ErrNotStubbed)
+}
+
+// GetTVEpisodeGroupsDetails records the call and calls GetTVEpisodeGroupsDetailsFunc.
+func (m *TVServiceMock) GetTVEpisodeGroupsDetails(id string, urlOptions map[string]string) (*TVEpisodeGroupsDetails, error) {
+	m.record("GetTVEpisodeGroupsDetails", id, urlOptions)
+	if m.GetTVEpisodeGroupsDetailsFunc != nil {
+		return m.GetTVEpisodeGroupsDetailsFunc(id, urlOptions)
+	}
+	if m.GetTVEpisodeGroupsDetailsWithContextFunc != nil {
+		return m.GetTVEpisodeGroupsDetailsWithContextFunc(context.Background(), id, urlOptions)
+	}
+	var r0 *TVEpisodeGroupsDetails
+	return r0, fmt.Errorf("%w: TVService.GetTVEpisodeGroupsDetails", ErrNotStubbed)
+}
+
+// GetTVEpisodeGroupsDetailsWithContext records the call and calls GetTVEpisodeGroupsDetailsWithContextFunc.
+func (m *TVServiceMock) GetTVEpisodeGroupsDetailsWithContext(ctx context.Context, id string, urlOptions map[string]string) (*TVEpisodeGroupsDetails, error) {
+	m.record("GetTVEpisodeGroupsDetailsWithContext", ctx, id, urlOptions)
+	if m.GetTVEpisodeGroupsDetailsWithContextFunc != nil {
+		return m.GetTVEpisodeGroupsDetailsWithContextFunc(ctx, id, urlOptions)
+	}
+	var r0 *TVEpisodeGroupsDetails
+	return r0, fmt.Errorf("%w: TVService.GetTVEpisodeGroupsDetailsWithContext", ErrNotStubbed)
+}
+
+// TVEpisodesServiceMock type is a TVEpisodesService recording its calls.
+//
+// Set the Func fields to stub the methods. A method without
+// a Func falls back to the Func of its WithContext variant,
+// then returns zero values and ErrNotStubbed.
+type TVEpisodesServiceMock struct {
+	MockRecorder
+
+	GetTVEpisodeDetailsFunc                  func(int, int, int, map[string]string) (*TVEpisodeDetails, error)
+	GetTVEpisodeDetailsWithContextFunc       func(context.Context, int, int, int, map[string]string) (*TVEpisodeDetails, error)
+	GetTVEpisodeChangesFunc                  func(int, map[string]string) (*TVEpisodeChanges, error)
+	GetTVEpisodeChangesWithContextFunc       func(context.Context, int, map[string]string) (*TVEpisodeChanges, error)
+	GetTVEpisodeCreditsFunc                  func(int, int, int) (*TVEpisodeCredits, error)
+	GetTVEpisodeCreditsWithContextFunc       func(context.Context, int, int, int) (*TVEpisodeCredits, error)
+	GetTVEpisodeExternalIDsFunc              func(int, int, int) (*TVEpisodeExternalIDs, error)
+	GetTVEpisodeExternalIDsWithContextFunc   func(context.Context, int, int, int) (*TVEpisodeExternalIDs, error)
+	GetTVEpisodeImagesFunc                   func(int, int, int) (*TVEpisodeImages, error)
+	GetTVEpisodeImagesWithContextFunc        func(context.Context, int, int, int) (*TVEpisodeImages, error)
+	GetTVEpisodeTranslationsFunc             func(int, int, int) (*TVEpisodeTranslations, error)
+	GetTVEpisodeTranslationsWithContextFunc  func(context.Context, int, int, int) (*TVEpisodeTranslations, error)
+	GetTVEpisodeAccountStatesFunc            func(int, int, int, map[string]string) (*TVEpisodeAccountStates, error)
+	GetTVEpisodeAccountStatesWithContextFunc func(context.Context, int, int, int, map[string]string) (*TVEpisodeAccountStates, error)
+	PostTVEpisodeRatingFunc                  func(int, int, int, float32, map[string]string) (*TVEpisodeRate, error)
+	PostTVEpisodeRatingWithContextFunc       func(context.Context, int, int, int, float32, map[string]string) (*TVEpisodeRate, error)
+	DeleteTVEpisodeRatingFunc                func(int, int, int, map[string]string) (*TVEpisodeRate, error)
+	DeleteTVEpisodeRatingWithContextFunc     func(context.Context, int, int, int, map[string]string) (*TVEpisodeRate, error)
+	GetTVEpisodeVideosFunc                   func(int, int, int, map[string]string) (*VideoResults, error)
+	GetTVEpisodeVideosWithContextFunc        func(context.Context, int, int, int, map[string]string) (*VideoResults, error)
+}
+
+var _ TVEpisodesService = (*TVEpisodesServiceMock)(nil)
+
+// GetTVEpisodeDetails records the call and calls GetTVEpisodeDetailsFunc.
+func (m *TVEpisodesServiceMock) GetTVEpisodeDetails(id int, seasonNumber int, episodeNumber int, urlOptions map[string]string) (*TVEpisodeDetails, error) {
+	m.record("GetTVEpisodeDetails", id, seasonNumber, episodeNumber, urlOptions)
+	if m.GetTVEpisodeDetailsFunc != nil {
+		return m.GetTVEpisodeDetailsFunc(id, seasonNumber, episodeNumber, urlOptions)
+	}
+	if m.GetTVEpisodeDetailsWithContextFunc != nil {
+		return m.GetTVEpisodeDetailsWithContextFunc(context.Background(), id, seasonNumber, episodeNumber, urlOptions)
+	}
+	var r0 *TVEpisodeDetails
+	return r0, fmt.Errorf("%w: TVEpisodesService.GetTVEpisodeDetails", ErrNotStubbed)
+}
+
+// GetTVEpisodeDetailsWithContext records the call and calls GetTVEpisodeDetailsWithContextFunc.
+func (m *TVEpisodesServiceMock) GetTVEpisodeDetailsWithContext(ctx context.Context, id int, seasonNumber int, episodeNumber int, urlOptions map[string]string) (*TVEpisodeDetails, error) {
+	m.record("GetTVEpisodeDetailsWithContext", ctx, id, seasonNumber, episodeNumber, urlOptions)
+	if m.GetTVEpisodeDetailsWithContextFunc != nil {
+		return m.GetTVEpisodeDetailsWithContextFunc(ctx, id, seasonNumber, episodeNumber, urlOptions)
+	}
+	var r0 *TVEpisodeDetails
+	return r0, fmt.Errorf("%w: TVEpisodesService.GetTVEpisodeDetailsWithContext", ErrNotStubbed)
+}
+
+// GetTVEpisodeChanges records the call and calls GetTVEpisodeChangesFunc.
+func (m *TVEpisodesServiceMock) GetTVEpisodeChanges(id int, urlOptions map[string]string) (*TVEpisodeChanges, error) {
+	m.record("GetTVEpisodeChanges", id, urlOptions)
+	if m.GetTVEpisodeChangesFunc != nil {
+		return m.GetTVEpisodeChangesFunc(id, urlOptions)
+	}
+	if m.GetTVEpisodeChangesWithContextFunc != nil {
+		return m.GetTVEpisodeChangesWithContextFunc(context.Background(), id, urlOptions)
+	}
+	var r0 *TVEpisodeChanges
+	return r0, fmt.Errorf("%w: TVEpisodesService.GetTVEpisodeChanges", ErrNotStubbed)
+}
+
+// GetTVEpisodeChangesWithContext records the call and calls GetTVEpisodeChangesWithContextFunc.
+func (m *TVEpisodesServiceMock) GetTVEpisodeChangesWithContext(ctx context.Context, id int, urlOptions map[string]string) (*TVEpisodeChanges, error) {
+	m.record("GetTVEpisodeChangesWithContext", ctx, id, urlOptions)
+	if m.GetTVEpisodeChangesWithContextFunc != nil {
+		return m.GetTVEpisodeChangesWithContextFunc(ctx, id, urlOptions)
+	}
+	var r0 *TVEpisodeChanges
+	return r0, fmt.Errorf("%w: TVEpisodesService.GetTVEpisodeChangesWithContext", ErrNotStubbed)
+}
+
+// GetTVEpisodeCredits records the call and calls GetTVEpisodeCreditsFunc.
+func (m *TVEpisodesServiceMock) GetTVEpisodeCredits(id int, seasonNumber int, episodeNumber int) (*TVEpisodeCredits, error) {
+	m.record("GetTVEpisodeCredits", id, seasonNumber, episodeNumber)
+	if m.GetTVEpisodeCreditsFunc != nil {
+		return m.GetTVEpisodeCreditsFunc(id, seasonNumber, episodeNumber)
+	}
+	if m.GetTVEpisodeCreditsWithContextFunc != nil {
+		return m.GetTVEpisodeCreditsWithContextFunc(context.Background(), id, seasonNumber, episodeNumber)
+	}
+	var r0 *TVEpisodeCredits
+	return r0, fmt.Errorf("%w: TVEpisodesService.GetTVEpisodeCredits", ErrNotStubbed)
+}
+
+// GetTVEpisodeCreditsWithContext records the call and calls GetTVEpisodeCreditsWithContextFunc.
+func (m *TVEpisodesServiceMock) GetTVEpisodeCreditsWithContext(ctx context.Context, id int, seasonNumber int, episodeNumber int) (*TVEpisodeCredits, error) {
+	m.record("GetTVEpisodeCreditsWithContext", ctx, id, seasonNumber, episodeNumber)
+	if m.GetTVEpisodeCreditsWithContextFunc != nil {
+		return m.GetTVEpisodeCreditsWithContextFunc(ctx, id, seasonNumber, episodeNumber)
+	}
+	var r0 *TVEpisodeCredits
+	return r0, fmt.Errorf("%w: TVEpisodesService.GetTVEpisodeCreditsWithContext", ErrNotStubbed)
+}
+
+// GetTVEpisodeExternalIDs records the call and calls GetTVEpisodeExternalIDsFunc.
+func (m *TVEpisodesServiceMock) GetTVEpisodeExternalIDs(id int, seasonNumber int, episodeNumber int) (*TVEpisodeExternalIDs, error) {
+	m.record("GetTVEpisodeExternalIDs", id, seasonNumber, episodeNumber)
+	if m.GetTVEpisodeExternalIDsFunc != nil {
+		return m.GetTVEpisodeExternalIDsFunc(id, seasonNumber, episodeNumber)
+	}
+	if m.GetTVEpisodeExternalIDsWithContextFunc != nil {
+		return m.GetTVEpisodeExternalIDsWithContextFunc(context.Background(), id, seasonNumber, episodeNumber)
+	}
+	var r0 *TVEpisodeExternalIDs
+	return r0, fmt.Errorf("%w: TVEpisodesService.GetTVEpisodeExternalIDs", ErrNotStubbed)
+}
+
+// GetTVEpisodeExternalIDsWithContext records the call and calls GetTVEpisodeExternalIDsWithContextFunc.
+func (m *TVEpisodesServiceMock) GetTVEpisodeExternalIDsWithContext(ctx context.Context, id int, seasonNumber int, episodeNumber int) (*TVEpisodeExternalIDs, error) {
+	m.record("GetTVEpisodeExternalIDsWithContext", ctx, id, seasonNumber, episodeNumber)
+	if m.GetTVEpisodeExternalIDsWithContextFunc != nil {
+		return m.GetTVEpisodeExternalIDsWithContextFunc(ctx, id, seasonNumber, episodeNumber)
+	}
+	var r0 *TVEpisodeExternalIDs
+	return r0, fmt.Errorf("%w: TVEpisodesService.GetTVEpisodeExternalIDsWithContext", ErrNotStubbed)
+}
+
+// GetTVEpisodeImages records the call and calls GetTVEpisodeImagesFunc.
+func (m *TVEpisodesServiceMock) GetTVEpisodeImages(id int, seasonNumber int, episodeNumber int) (*TVEpisodeImages, error) {
+	m.record("GetTVEpisodeImages", id, seasonNumber, episodeNumber)
+	if m.GetTVEpisodeImagesFunc != nil {
+		return m.GetTVEpisodeImagesFunc(id, seasonNumber, episodeNumber)
+	}
+	if m.GetTVEpisodeImagesWithContextFunc != nil {
+		return m.GetTVEpisodeImagesWithContextFunc(context.Background(), id, seasonNumber, episodeNumber)
+	}
+	var r0 *TVEpisodeImages
+	return r0, fmt.Errorf("%w: TVEpisodesService.GetTVEpisodeImages", ErrNotStubbed)
+}
+
+// GetTVEpisodeImagesWithContext records the call and calls GetTVEpisodeImagesWithContextFunc.
+func (m *TVEpisodesServiceMock) GetTVEpisodeImagesWithContext(ctx context.Context, id int, seasonNumber int, episodeNumber int) (*TVEpisodeImages, error) {
+	m.record("GetTVEpisodeImagesWithContext", ctx, id, seasonNumber, episodeNumber)
+	if m.GetTVEpisodeImagesWithContextFunc != nil {
+		return m.GetTVEpisodeImagesWithContextFunc(ctx, id, seasonNumber, episodeNumber)
+	}
+	var r0 *TVEpisodeImages
+	return r0, fmt.Errorf("%w: TVEpisodesService.GetTVEpisodeImagesWithContext", ErrNotStubbed)
+}
+
+// GetTVEpisodeTranslations records the call and calls GetTVEpisodeTranslationsFunc.
+func (m *TVEpisodesServiceMock) GetTVEpisodeTranslations(id int, seasonNumber int, episodeNumber int) (*TVEpisodeTranslations, error) {
+	m.record("GetTVEpisodeTranslations", id, seasonNumber, episodeNumber)
+	if m.GetTVEpisodeTranslationsFunc != nil {
+		return m.GetTVEpisodeTranslationsFunc(id, seasonNumber, episodeNumber)
+	}
+	if m.GetTVEpisodeTranslationsWithContextFunc != nil {
+		return m.GetTVEpisodeTranslationsWithContextFunc(context.Background(), id, seasonNumber, episodeNumber)
+	}
+	var r0 *TVEpisodeTranslations
+	return r0, fmt.Errorf("%w: TVEpisodesService.GetTVEpisodeTranslations", ErrNotStubbed)
+}
+
+// GetTVEpisodeTranslationsWithContext records the call and calls GetTVEpisodeTranslationsWithContextFunc.
+func (m *TVEpisodesServiceMock) GetTVEpisodeTranslationsWithContext(ctx context.Context, id int, seasonNumber int, episodeNumber int) (*TVEpisodeTranslations, error) {
+	m.record("GetTVEpisodeTranslationsWithContext", ctx, id, seasonNumber, episodeNumber)
+	if m.GetTVEpisodeTranslationsWithContextFunc != nil {
+		return m.GetTVEpisodeTranslationsWithContextFunc(ctx, id, seasonNumber, episodeNumber)
+	}
+	var r0 *TVEpisodeTranslations
+	return r0, fmt.Errorf("%w: TVEpisodesService.GetTVEpisodeTranslationsWithContext", ErrNotStubbed)
+}
+
+// GetTVEpisodeAccountStates records the call and calls GetTVEpisodeAccountStatesFunc.
+func (m *TVEpisodesServiceMock) GetTVEpisodeAccountStates(id int, seasonNumber int, episodeNumber int, urlOptions map[string]string) (*TVEpisodeAccountStates, error) {
+	m.record("GetTVEpisodeAccountStates", id, seasonNumber, episodeNumber, urlOptions)
+	if m.GetTVEpisodeAccountStatesFunc != nil {
+		return m.GetTVEpisodeAccountStatesFunc(id, seasonNumber, episodeNumber, urlOptions)
+	}
+	if m.GetTVEpisodeAccountStatesWithContextFunc != nil {
+		return m.GetTVEpisodeAccountStatesWithContextFunc(context.Background(), id, seasonNumber, episodeNumber, urlOptions)
+	}
+	var r0 *TVEpisodeAccountStates
+	return r0, fmt.Errorf("%w: TVEpisodesService.GetTVEpisodeAccountStates", ErrNotStubbed)
+}
+
+// GetTVEpisodeAccountStatesWithContext records the call and calls GetTVEpisodeAccountStatesWithContextFunc.
+func (m *TVEpisodesServiceMock) GetTVEpisodeAccountStatesWithContext(ctx context.Context, id int, seasonNumber int, episodeNumber int, urlOptions map[string]string) (*TVEpisodeAccountStates, error) {
+	m.record("GetTVEpisodeAccountStatesWithContext", ctx, id, seasonNumber, episodeNumber, urlOptions)
+	if m.GetTVEpisodeAccountStatesWithContextFunc != nil {
+		return m.GetTVEpisodeAccountStatesWithContextFunc(ctx, id, seasonNumber, episodeNumber, urlOptions)
+	}
+	var r0 *TVEpisodeAccountStates
+	return r0, fmt.Errorf("%w: TVEpisodesService.GetTVEpisodeAccountStatesWithContext", ErrNotStubbed)
+}
+
+// PostTVEpisodeRating records the call and calls PostTVEpisodeRatingFunc.
+func (m *TVEpisodesServiceMock) PostTVEpisodeRating(id int, seasonNumber int, episodeNumber int, rating float32, urlOptions map[string]string) (*TVEpisodeRate, error) {
+	m.record("PostTVEpisodeRating", id, seasonNumber, episodeNumber, rating, urlOptions)
+	if m.PostTVEpisodeRatingFunc != nil {
+		return m.PostTVEpisodeRatingFunc(id, seasonNumber, episodeNumber, rating, urlOptions)
+	}
+	if m.PostTVEpisodeRatingWithContextFunc != nil {
+		return m.PostTVEpisodeRatingWithContextFunc(context.Background(), id, seasonNumber, episodeNumber, rating, urlOptions)
+	}
+	var r0 *TVEpisodeRate
+	return r0, fmt.Errorf("%w: TVEpisodesService.PostTVEpisodeRating", ErrNotStubbed)
+}
+
+// PostTVEpisodeRatingWithContext records the call and calls PostTVEpisodeRatingWithContextFunc.
+func (m *TVEpisodesServiceMock) PostTVEpisodeRatingWithContext(ctx context.Context, id int, seasonNumber int, episodeNumber int, rating float32, urlOptions map[string]string) (*TVEpisodeRate, error) {
+	m.record("PostTVEpisodeRatingWithContext", ctx, id, seasonNumber, episodeNumber, rating, urlOptions)
+	if m.PostTVEpisodeRatingWithContextFunc != nil {
+		return m.PostTVEpisodeRatingWithContextFunc(ctx, id, seasonNumber, episodeNumber, rating, urlOptions)
+	}
+	var r0 *TVEpisodeRate
+	return r0, fmt.Errorf("%w: TVEpisodesService.PostTVEpisodeRatingWithContext", ErrNotStubbed)
+}
+
+// DeleteTVEpisodeRating records the call and calls DeleteTVEpisodeRatingFunc.
+func (m *TVEpisodesServiceMock) DeleteTVEpisodeRating(id int, seasonNumber int, episodeNumber int, urlOptions map[string]string) (*TVEpisodeRate, error) {
+	m.record("DeleteTVEpisodeRating", id, seasonNumber, episodeNumber, urlOptions)
+	if m.DeleteTVEpisodeRatingFunc != nil {
+		return m.DeleteTVEpisodeRatingFunc(id, seasonNumber, episodeNumber, urlOptions)
+	}
+	if m.DeleteTVEpisodeRatingWithContextFunc != nil {
+		return m.DeleteTVEpisodeRatingWithContextFunc(context.Background(), id, seasonNumber, episodeNumber, urlOptions)
+	}
+	var r0 *TVEpisodeRate
+	return r0, fmt.Errorf("%w: TVEpisodesService.DeleteTVEpisodeRating", ErrNotStubbed)
+}
+
+// DeleteTVEpisodeRatingWithContext records the call and calls DeleteTVEpisodeRatingWithContextFunc.
+func (m *TVEpisodesServiceMock) DeleteTVEpisodeRatingWithContext(ctx context.Context, id int, seasonNumber int, episodeNumber int, urlOptions map[string]string) (*TVEpisodeRate, error) {
+	m.record("DeleteTVEpisodeRatingWithContext", ctx, id, seasonNumber, episodeNumber, urlOptions)
+	if m.DeleteTVEpisodeRatingWithContextFunc != nil {
+		return m.DeleteTVEpisodeRatingWithContextFunc(ctx, id, seasonNumber, episodeNumber, urlOptions)
+	}
+	var r0 *TVEpisodeRate
+	return r0, fmt.Errorf("%w: TVEpisodesService.DeleteTVEpisodeRatingWithContext", ErrNotStubbed)
+}
+
+// GetTVEpisodeVideos records the call and calls GetTVEpisodeVideosFunc.
+func (m *TVEpisodesServiceMock) GetTVEpisodeVideos(id int, seasonNumber int, episodeNumber int, urlOptions map[string]string) (*VideoResults, error) {
+	m.record("GetTVEpisodeVideos", id, seasonNumber, episodeNumber, urlOptions)
+	if m.GetTVEpisodeVideosFunc != nil {
+		return m.GetTVEpisodeVideosFunc(id, seasonNumber, episodeNumber, urlOptions)
+	}
+	if m.GetTVEpisodeVideosWithContextFunc != nil {
+		return m.GetTVEpisodeVideosWithContextFunc(context.Background(), id, seasonNumber, episodeNumber, urlOptions)
+	}
+	var r0 *VideoResults
+	return r0, fmt.Errorf("%w: TVEpisodesService.GetTVEpisodeVideos", ErrNotStubbed)
+}
+
+// GetTVEpisodeVideosWithContext records the call and calls GetTVEpisodeVideosWithContextFunc.
+func (m *TVEpisodesServiceMock) GetTVEpisodeVideosWithContext(ctx context.Context, id int, seasonNumber int, episodeNumber int, urlOptions map[string]string) (*VideoResults, error) {
+	m.record("GetTVEpisodeVideosWithContext", ctx, id, seasonNumber, episodeNumber, urlOptions)
+	if m.GetTVEpisodeVideosWithContextFunc != nil {
+		return m.GetTVEpisodeVideosWithContextFunc(ctx, id, seasonNumber, episodeNumber, urlOptions)
+	}
+	var r0 *VideoResults
+	return r0, fmt.Errorf("%w: TVEpisodesService.GetTVEpisodeVideosWithContext", ErrNotStubbed)
+}
+
+// TVSeasonsServiceMock type is a TVSeasonsService recording its calls.
+//
+// Set the Func fields to stub the methods. A method without
+// a Func falls back to the Func of its WithContext variant,
+// then returns zero values and ErrNotStubbed.
+type TVSeasonsServiceMock struct {
+	MockRecorder
+
+	GetTVSeasonDetailsFunc                  func(int, int, map[string]string) (*TVSeasonDetails, error)
+	GetTVSeasonDetailsWithContextFunc       func(context.Context, int, int, map[string]string) (*TVSeasonDetails, error)
+	GetTVSeasonAccountStatesFunc            func(int, int, map[string]string) (*TVSeasonAccountStates, error)
+	GetTVSeasonAccountStatesWithContextFunc func(context.Context, int, int, map[string]string) (*TVSeasonAccountStates, error)
+	GetTVSeasonChangesFunc                  func(int, map[string]string) (*TVSeasonChanges, error)
+	GetTVSeasonChangesWithContextFunc       func(context.Context, int, map[string]string) (*TVSeasonChanges, error)
+	GetTVSeasonCreditsFunc                  func(int, int, map[string]string) (*TVSeasonCredits, error)
+	GetTVSeasonCreditsWithContextFunc       func(context.Context, int, int, map[string]string) (*TVSeasonCredits, error)
+	GetTVSeasonExternalIDsFunc              func(int, int, map[string]string) (*TVSeasonExternalIDs, error)
+	GetTVSeasonExternalIDsWithContextFunc   func(context.Context, int, int, map[string]string) (*TVSeasonExternalIDs, error)
+	GetTVSeasonImagesFunc                   func(int, int, map[string]string) (*TVSeasonImages, error)
+	GetTVSeasonImagesWithContextFunc        func(context.Context, int, int, map[string]string) (*TVSeasonImages, error)
+	GetTVSeasonVideosFunc                   func(int, int, map[string]string) (*VideoResults, error)
+	GetTVSeasonVideosWithContextFunc        func(context.Context, int, int, map[string]string) (*VideoResults, error)
+	GetTVSeasonTranslationsFunc             func(int, int) (*TVSeasonTranslations, error)
+	GetTVSeasonTranslationsWithContextFunc  func(context.Context, int, int) (*TVSeasonTranslations, error)
+}
+
+var _ TVSeasonsService = (*TVSeasonsServiceMock)(nil)
+
+// GetTVSeasonDetails records the call and calls GetTVSeasonDetailsFunc.
+func (m *TVSeasonsServiceMock) GetTVSeasonDetails(id int, seasonNumber int, urlOptions map[string]string) (*TVSeasonDetails, error) {
+	m.record("GetTVSeasonDetails", id, seasonNumber, urlOptions)
+	if m.GetTVSeasonDetailsFunc != nil {
+		return m.GetTVSeasonDetailsFunc(id, seasonNumber, urlOptions)
+	}
+	if m.GetTVSeasonDetailsWithContextFunc != nil {
+		return m.GetTVSeasonDetailsWithContextFunc(context.Background(), id, seasonNumber, urlOptions)
+	}
+	var r0 *TVSeasonDetails
+	return r0, fmt.Errorf("%w: TVSeasonsService.GetTVSeasonDetails", ErrNotStubbed)
+}
+
+// GetTVSeasonDetailsWithContext records the call and calls GetTVSeasonDetailsWithContextFunc.
+func (m *TVSeasonsServiceMock) GetTVSeasonDetailsWithContext(ctx context.Context, id int, seasonNumber int, urlOptions map[string]string) (*TVSeasonDetails, error) {
+	m.record("GetTVSeasonDetailsWithContext", ctx, id, seasonNumber, urlOptions)
+	if m.GetTVSeasonDetailsWithContextFunc != nil {
+		return m.GetTVSeasonDetailsWithContextFunc(ctx, id, seasonNumber, urlOptions)
+	}
+	var r0 *TVSeasonDetails
+	return r0, fmt.Errorf("%w: TVSeasonsService.GetTVSeasonDetailsWithContext", ErrNotStubbed)
+}
+
+// GetTVSeasonAccountStates records the call and calls GetTVSeasonAccountStatesFunc.
+func (m *TVSeasonsServiceMock) GetTVSeasonAccountStates(id int, seasonNumber int, urlOptions map[string]string) (*TVSeasonAccountStates, error) {
+	m.record("GetTVSeasonAccountStates", id, seasonNumber, urlOptions)
+	if m.GetTVSeasonAccountStatesFunc != nil {
+		return m.GetTVSeasonAccountStatesFunc(id, seasonNumber, urlOptions)
+	}
+	if m.GetTVSeasonAccountStatesWithContextFunc != nil {
+		return m.GetTVSeasonAccountStatesWithContextFunc(context.Background(), id, seasonNumber, urlOptions)
+	}
+	var r0 *TVSeasonAccountStates
+	return r0, fmt.Errorf("%w: TVSeasonsService.GetTVSeasonAccountStates", ErrNotStubbed)
+}
+
+// GetTVSeasonAccountStatesWithContext records the call and calls GetTVSeasonAccountStatesWithContextFunc.
+func (m *TVSeasonsServiceMock) GetTVSeasonAccountStatesWithContext(ctx context.Context, id int, seasonNumber int, urlOptions map[string]string) (*TVSeasonAccountStates, error) {
+	m.record("GetTVSeasonAccountStatesWithContext", ctx, id, seasonNumber, urlOptions)
+	if m.GetTVSeasonAccountStatesWithContextFunc != nil {
+		return m.GetTVSeasonAccountStatesWithContextFunc(ctx, id, seasonNumber, urlOptions)
+	}
+	var r0 *TVSeasonAccountStates
+	return r0, fmt.Errorf("%w: TVSeasonsService.GetTVSeasonAccountStatesWithContext", ErrNotStubbed)
+}
+
+// GetTVSeasonChanges records the call and calls GetTVSeasonChangesFunc.
+func (m *TVSeasonsServiceMock) GetTVSeasonChanges(id int, urlOptions map[string]string) (*TVSeasonChanges, error) {
+	m.record("GetTVSeasonChanges", id, urlOptions)
+	if m.GetTVSeasonChangesFunc != nil {
+		return m.GetTVSeasonChangesFunc(id, urlOptions)
+	}
+	if m.GetTVSeasonChangesWithContextFunc != nil {
+		return m.GetTVSeasonChangesWithContextFunc(context.Background(), id, urlOptions)
+	}
+	var r0 *TVSeasonChanges
+	return r0, fmt.Errorf("%w: TVSeasonsService.GetTVSeasonChanges", ErrNotStubbed)
+}
+
+// GetTVSeasonChangesWithContext records the call and calls GetTVSeasonChangesWithContextFunc.
+func (m *TVSeasonsServiceMock) GetTVSeasonChangesWithContext(ctx context.Context, id int, urlOptions map[string]string) (*TVSeasonChanges, error) {
+	m.record("GetTVSeasonChangesWithContext", ctx, id, urlOptions)
+	if m.GetTVSeasonChangesWithContextFunc != nil {
+		return m.GetTVSeasonChangesWithContextFunc(ctx, id, urlOptions)
+	}
+	var r0 *TVSeasonChanges
+	return r0, fmt.Errorf("%w: TVSeasonsService.GetTVSeasonChangesWithContext", ErrNotStubbed)
+}
+
+// GetTVSeasonCredits records the call and calls GetTVSeasonCreditsFunc.
+func (m *TVSeasonsServiceMock) GetTVSeasonCredits(id int, seasonNumber int, urlOptions map[string]string) (*TVSeasonCredits, error) {
+	m.record("GetTVSeasonCredits", id, seasonNumber, urlOptions)
+	if m.GetTVSeasonCreditsFunc != nil {
+		return m.GetTVSeasonCreditsFunc(id, seasonNumber, urlOptions)
+	}
+	if m.GetTVSeasonCreditsWithContextFunc != nil {
+		return m.GetTVSeasonCreditsWithContextFunc(context.Background(), id, seasonNumber, urlOptions)
+	}
+	var r0 *TVSeasonCredits
+	return r0, fmt.Errorf("%w: TVSeasonsService.GetTVSeasonCredits", ErrNotStubbed)
+}
+
+// GetTVSeasonCreditsWithContext records the call and calls GetTVSeasonCreditsWithContextFunc.
+func (m *TVSeasonsServiceMock) GetTVSeasonCreditsWithContext(ctx context.Context, id int, seasonNumber int, urlOptions map[string]string) (*TVSeasonCredits, error) {
+	m.record("GetTVSeasonCreditsWithContext", ctx, id, seasonNumber, urlOptions)
+	if m.GetTVSeasonCreditsWithContextFunc != nil {
+		return m.GetTVSeasonCreditsWithContextFunc(ctx, id, seasonNumber, urlOptions)
+	}
+	var r0 *TVSeasonCredits
+	return r0, fmt.Errorf("%w: TVSeasonsService.GetTVSeasonCreditsWithContext", ErrNotStubbed)
+}
+
+// GetTVSeasonExternalIDs records the call and calls GetTVSeasonExternalIDsFunc.
+func (m *TVSeasonsServiceMock) GetTVSeasonExternalIDs(id int, seasonNumber int, urlOptions map[string]string) (*TVSeasonExternalIDs, error) {
+	m.record("GetTVSeasonExternalIDs", id, seasonNumber, urlOptions)
+	if m.GetTVSeasonExternalIDsFunc != nil {
+		return m.GetTVSeasonExternalIDsFunc(id, seasonNumber, urlOptions)
+	}
+	if m.GetTVSeasonExternalIDsWithContextFunc != nil {
+		return m.GetTVSeasonExternalIDsWithContextFunc(context.Background(), id, seasonNumber, urlOptions)
+	}
+	var r0 *TVSeasonExternalIDs
+	return r0, fmt.Errorf("%w: TVSeasonsService.GetTVSeasonExternalIDs", ErrNotStubbed)
+}
+
+// GetTVSeasonExternalIDsWithContext records the call and calls GetTVSeasonExternalIDsWithContextFunc.
+func (m *TVSeasonsServiceMock) GetTVSeasonExternalIDsWithContext(ctx context.Context, id int, seasonNumber int, urlOptions map[string]string) (*TVSeasonExternalIDs, error) {
+	m.record("GetTVSeasonExternalIDsWithContext", ctx, id, seasonNumber, urlOptions)
+	if m.GetTVSeasonExternalIDsWithContextFunc != nil {
+		return m.GetTVSeasonExternalIDsWithContextFunc(ctx, id, seasonNumber, urlOptions)
+	}
+	var r0 *TVSeasonExternalIDs
+	return r0, fmt.Errorf("%w: TVSeasonsService.GetTVSeasonExternalIDsWithContext", ErrNotStubbed)
+}
+
+// GetTVSeasonImages records the call and calls GetTVSeasonImagesFunc.
+func (m *TVSeasonsServiceMock) GetTVSeasonImages(id int, seasonNumber int, urlOptions map[string]string) (*TVSeasonImages, error) {
+	m.record("GetTVSeasonImages", id, seasonNumber, urlOptions)
+	if m.GetTVSeasonImagesFunc != nil {
+		return m.GetTVSeasonImagesFunc(id, seasonNumber, urlOptions)
+	}
+	if m.GetTVSeasonImagesWithContextFunc != nil {
+		return m.GetTVSeasonImagesWithContextFunc(context.Background(), id, seasonNumber, urlOptions)
+	}
+	var r0 *TVSeasonImages
+	return r0, fmt.Errorf("%w: TVSeasonsService.GetTVSeasonImages", ErrNotStubbed)
+}
+
+// GetTVSeasonImagesWithContext records the call and calls GetTVSeasonImagesWithContextFunc.
+func (m *TVSeasonsServiceMock) GetTVSeasonImagesWithContext(ctx context.Context, id int, seasonNumber int, urlOptions map[string]string) (*TVSeasonImages, error) {
+	m.record("GetTVSeasonImagesWithContext", ctx, id, seasonNumber, urlOptions)
+	if m.GetTVSeasonImagesWithContextFunc != nil {
+		return m.GetTVSeasonImagesWithContextFunc(ctx, id, seasonNumber, urlOptions)
+	}
+	var r0 *TVSeasonImages
+	return r0, fmt.Errorf("%w: TVSeasonsService.GetTVSeasonImagesWithContext", ErrNotStubbed)
+}
+
+// GetTVSeasonVideos records the call and calls GetTVSeasonVideosFunc.
+func (m *TVSeasonsServiceMock) GetTVSeasonVideos(id int, seasonNumber int, urlOptions map[string]string) (*VideoResults, error) {
+	m.record("GetTVSeasonVideos", id, seasonNumber, urlOptions)
+	if m.GetTVSeasonVideosFunc != nil {
+		return m.GetTVSeasonVideosFunc(id, seasonNumber, urlOptions)
+	}
+	if m.GetTVSeasonVideosWithContextFunc != nil {
+		return m.GetTVSeasonVideosWithContextFunc(context.Background(), id, seasonNumber, urlOptions)
+	}
+	var r0 *VideoResults
+	return r0, fmt.Errorf("%w: TVSeasonsService.GetTVSeasonVideos", ErrNotStubbed)
+}
+
+// GetTVSeasonVideosWithContext records the call and calls GetTVSeasonVideosWithContextFunc.
+func (m *TVSeasonsServiceMock) GetTVSeasonVideosWithContext(ctx context.Context, id int, seasonNumber int, urlOptions map[string]string) (*VideoResults, error) {
+	m.record("GetTVSeasonVideosWithContext", ctx, id, seasonNumber, urlOptions)
+	if m.GetTVSeasonVideosWithContextFunc != nil {
+		return m.GetTVSeasonVideosWithContextFunc(ctx, id, seasonNumber, urlOptions)
+	}
+	var r0 *VideoResults
+	return r0, fmt.Errorf("%w: TVSeasonsService.GetTVSeasonVideosWithContext", ErrNotStubbed)
+}
+
+// GetTVSeasonTranslations records the call and calls GetTVSeasonTranslationsFunc.
+func (m *TVSeasonsServiceMock) GetTVSeasonTranslations(id int, seasonNumber int) (*TVSeasonTranslations, error) {
+	m.record("GetTVSeasonTranslations", id, seasonNumber)
+	if m.GetTVSeasonTranslationsFunc != nil {
+		return m.GetTVSeasonTranslationsFunc(id, seasonNumber)
+	}
+	if m.GetTVSeasonTranslationsWithContextFunc != nil {
+		return m.GetTVSeasonTranslationsWithContextFunc(context.Background(), id, seasonNumber)
+	}
+	var r0 *TVSeasonTranslations
+	return r0, fmt.Errorf("%w: TVSeasonsService.GetTVSeasonTranslations", ErrNotStubbed)
+}
+
+// GetTVSeasonTranslationsWithContext records the call and calls GetTVSeasonTranslationsWithContextFunc.
+func (m *TVSeasonsServiceMock) GetTVSeasonTranslationsWithContext(ctx context.Context, id int, seasonNumber int) (*TVSeasonTranslations, error) {
+	m.record("GetTVSeasonTranslationsWithContext", ctx, id, seasonNumber)
+	if m.GetTVSeasonTranslationsWithContextFunc != nil {
+		return m.GetTVSeasonTranslationsWithContextFunc(ctx, id, seasonNumber)
+	}
+	var r0 *TVSeasonTranslations
+	return r0, fmt.Errorf("%w: TVSeasonsService.GetTVSeasonTranslationsWithContext", ErrNotStubbed)
+}
+
+// WatchProvidersServiceMock type is a WatchProvidersService recording its calls.
+//
+// Set the Func fields to stub the methods. A method without
+// a Func falls back to the Func of its WithContext variant,
+// then returns zero values and ErrNotStubbed.
+type WatchProvidersServiceMock struct {
+	MockRecorder
+
+	GetAvailableWatchProviderRegionsFunc            func(map[string]string) (*WatchRegionList, error)
+	GetAvailableWatchProviderRegionsWithContextFunc func(context.Context, map[string]string) (*WatchRegionList, error)
+	GetWatchProvidersMovieFunc                      func(map[string]string) (*WatchProviderList, error)
+	GetWatchProvidersMovieWithContextFunc           func(context.Context, map[string]string) (*WatchProviderList, error)
+	GetWatchProvidersTvFunc                         func(map[string]string) (*WatchProviderList, error)
+	GetWatchProvidersTvWithContextFunc              func(context.Context, map[string]string) (*WatchProviderList, error)
+}
+
+var _ WatchProvidersService = (*WatchProvidersServiceMock)(nil)
+
+// GetAvailableWatchProviderRegions records the call and calls GetAvailableWatchProviderRegionsFunc.
+func (m *WatchProvidersServiceMock) GetAvailableWatchProviderRegions(urlOptions map[string]string) (*WatchRegionList, error) {
+	m.record("GetAvailableWatchProviderRegions", urlOptions)
+	if m.GetAvailableWatchProviderRegionsFunc != nil {
+		return m.GetAvailableWatchProviderRegionsFunc(urlOptions)
+	}
+	if m.GetAvailableWatchProviderRegionsWithContextFunc != nil {
+		return m.GetAvailableWatchProviderRegionsWithContextFunc(context.Background(), urlOptions)
+	}
+	var r0 *WatchRegionList
+	return r0, fmt.Errorf("%w: WatchProvidersService.GetAvailableWatchProviderRegions", ErrNotStubbed)
+}
+
+// GetAvailableWatchProviderRegionsWithContext records the call and calls GetAvailableWatchProviderRegionsWithContextFunc.
+func (m *WatchProvidersServiceMock) GetAvailableWatchProviderRegionsWithContext(ctx context.Context, urlOptions map[string]string) (*WatchRegionList, error) {
+	m.record("GetAvailableWatchProviderRegionsWithContext", ctx, urlOptions)
+	if m.GetAvailableWatchProviderRegionsWithContextFunc != nil {
+		return m.GetAvailableWatchProviderRegionsWithContextFunc(ctx, urlOptions)
+	}
+	var r0 *WatchRegionList
+	return r0, fmt.Errorf("%w: WatchProvidersService.GetAvailableWatchProviderRegionsWithContext", ErrNotStubbed)
+}
+
+// GetWatchProvidersMovie records the call and calls GetWatchProvidersMovieFunc.
+func (m *WatchProvidersServiceMock) GetWatchProvidersMovie(urlOptions map[string]string) (*WatchProviderList, error) {
+	m.record("GetWatchProvidersMovie", urlOptions)
+	if m.GetWatchProvidersMovieFunc != nil {
+		return m.GetWatchProvidersMovieFunc(urlOptions)
+	}
+	if m.GetWatchProvidersMovieWithContextFunc != nil {
+		return m.GetWatchProvidersMovieWithContextFunc(context.Background(), urlOptions)
+	}
+	var r0 *WatchProviderList
+	return r0, fmt.Errorf("%w: WatchProvidersService.GetWatchProvidersMovie", ErrNotStubbed)
+}
+
+// GetWatchProvidersMovieWithContext records the call and calls GetWatchProvidersMovieWithContextFunc.
+func (m *WatchProvidersServiceMock) GetWatchProvidersMovieWithContext(ctx context.Context, urlOptions map[string]string) (*WatchProviderList, error) {
+	m.record("GetWatchProvidersMovieWithContext", ctx, urlOptions)
+	if m.GetWatchProvidersMovieWithContextFunc != nil {
+		return m.GetWatchProvidersMovieWithContextFunc(ctx, urlOptions)
+	}
+	var r0 *WatchProviderList
+	return r0, fmt.Errorf("%w: WatchProvidersService.GetWatchProvidersMovieWithContext", ErrNotStubbed)
+}
+
+// GetWatchProvidersTv records the call and calls GetWatchProvidersTvFunc.
+func (m *WatchProvidersServiceMock) GetWatchProvidersTv(urlOptions map[string]string) (*WatchProviderList, error) {
+	m.record("GetWatchProvidersTv", urlOptions)
+	if m.GetWatchProvidersTvFunc != nil {
+		return m.GetWatchProvidersTvFunc(urlOptions)
+	}
+	if m.GetWatchProvidersTvWithContextFunc != nil {
+		return m.GetWatchProvidersTvWithContextFunc(context.Background(), urlOptions)
+	}
+	var r0 *WatchProviderList
+	return r0, fmt.Errorf("%w: WatchProvidersService.GetWatchProvidersTv", ErrNotStubbed)
+}
+
+// GetWatchProvidersTvWithContext records the call and calls GetWatchProvidersTvWithContextFunc.
+func (m *WatchProvidersServiceMock) GetWatchProvidersTvWithContext(ctx context.Context, urlOptions map[string]string) (*WatchProviderList, error) {
+	m.record("GetWatchProvidersTvWithContext", ctx, urlOptions)
+	if m.GetWatchProvidersTvWithContextFunc != nil {
+		return m.GetWatchProvidersTvWithContextFunc(ctx, urlOptions)
+	}
+	var r0 *WatchProviderList
+	return r0, fmt.Errorf("%w: WatchProvidersService.GetWatchProvidersTvWithContext", ErrNotStubbed)
+}
@@ -1,10 +1,9 @@
 package tmdb
 
 import (
+	"context"
 	"fmt"
 	"net/http"
-
-	json "github.com/goccy/go-json"
 )
 
 // MovieDetails type is a struct for movie details JSON response.
@@ -135,18 +134,28 @@ type MovieWatchProvidersAppend struct {
 func (c *Client) GetMovieDetails(
 	id int,
 	urlOptions map[string]string,
+) (*MovieDetails, error) {
+	return c.GetMovieDetailsWithContext(context.Background(), id, urlOptions)
+}
+
+// GetMovieDetailsWithContext is like GetMovieDetails but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetMovieDetailsWithContext(
+	ctx context.Context,
+	id int,
+	urlOptions map[string]string,
 ) (*MovieDetails, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%s%d?api_key=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		movieURL,
 		id,
 		c.apiKey,
 		options,
 	)
 	movieDetails := MovieDetails{}
-	if err := c.get(tmdbURL, &movieDetails); err != nil {
+	if err := c.get(ctx, "GetMovieDetails", tmdbURL, &movieDetails); err != nil {
 		return nil, err
 	}
 	return &movieDetails, nil
@@ -154,10 +163,10 @@ func (c *Client) GetMovieDetails(
 
 // MovieAccountStates type is a struct for account states JSON response.
 type MovieAccountStates struct {
-	ID        int64           `json:"id"`
-	Favorite  bool            `json:"favorite"`
-	Rated     json.RawMessage `json:"rated"`
-	Watchlist bool            `json:"watchlist"`
+	ID        int64      `json:"id"`
+	Favorite  bool       `json:"favorite"`
+	Rated     RatedValue `json:"rated"`
+	Watchlist bool       `json:"watchlist"`
 }
 
 // GetMovieAccountStates grab the following account states for a session:
@@ -172,18 +181,28 @@ type MovieAccountStates struct {
 func (c *Client) GetMovieAccountStates(
 	id int,
 	urlOptions map[string]string,
+) (*MovieAccountStates, error) {
+	return c.GetMovieAccountStatesWithContext(context.Background(), id, urlOptions)
+}
+
+// GetMovieAccountStatesWithContext is like GetMovieAccountStates but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetMovieAccountStatesWithContext(
+	ctx context.Context,
+	id int,
+	urlOptions map[string]string,
 ) (*MovieAccountStates, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%s%d/account_states?api_key=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		movieURL,
 		id,
 		c.apiKey,
 		options,
 	)
 	movieAccountStates := MovieAccountStates{}
-	if err := c.get(tmdbURL, &movieAccountStates); err != nil {
+	if err := c.get(ctx, "GetMovieAccountStates", tmdbURL, &movieAccountStates); err != nil {
 		return nil, err
 	}
 	return &movieAccountStates, nil
@@ -201,18 +220,32 @@ type MovieAlternativeTitles struct {
 func (c *Client) GetMovieAlternativeTitles(
 	id int,
 	urlOptions map[string]string,
+) (*MovieAlternativeTitles, error) {
+	return c.GetMovieAlternativeTitlesWithContext(
+		context.Background(),
+		id,
+		urlOptions,
+	)
+}
+
+// GetMovieAlternativeTitlesWithContext is like GetMovieAlternativeTitles but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetMovieAlternativeTitlesWithContext(
+	ctx context.Context,
+	id int,
+	urlOptions map[string]string,
 ) (*MovieAlternativeTitles, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%s%d/alternative_titles?api_key=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		movieURL,
 		id,
 		c.apiKey,
 		options,
 	)
 	movieAlternativeTitles := MovieAlternativeTitles{}
-	if err := c.get(tmdbURL, &movieAlternativeTitles); err != nil {
+	if err := c.get(ctx, "GetMovieAlternativeTitles", tmdbURL, &movieAlternativeTitles); err != nil {
 		return nil, err
 	}
 	return &movieAlternativeTitles, nil
@@ -220,17 +253,7 @@ func (c *Client) GetMovieAlternativeTitles(
 
 // MovieChanges type is a struct for changes JSON response.
 type MovieChanges struct {
-	Changes []struct {
-		Key   string `json:"key"`
-		Items []struct {
-			ID            json.RawMessage `json:"id"`
-			Action        json.RawMessage `json:"action"`
-			Time          json.RawMessage `json:"time"`
-			Iso639_1      json.RawMessage `json:"iso_639_1"`
-			Value         json.RawMessage `json:"value"`
-			OriginalValue json.RawMessage `json:"original_value"`
-		} `json:"items"`
-	} `json:"changes"`
+	Changes []Change `json:"changes"`
 }
 
 // GetMovieChanges get the changes for a movie.
@@ -243,18 +266,28 @@ type MovieChanges struct {
 func (c *Client) GetMovieChanges(
 	id int,
 	urlOptions map[string]string,
+) (*MovieChanges, error) {
+	return c.GetMovieChangesWithContext(context.Background(), id, urlOptions)
+}
+
+// GetMovieChangesWithContext is like GetMovieChanges but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetMovieChangesWithContext(
+	ctx context.Context,
+	id int,
+	urlOptions map[string]string,
 ) (*MovieChanges, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%s%d/changes?api_key=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		movieURL,
 		id,
 		c.apiKey,
 		options,
 	)
 	movieChanges := MovieChanges{}
-	if err := c.get(tmdbURL, &movieChanges); err != nil {
+	if err := c.get(ctx, "GetMovieChanges", tmdbURL, &movieChanges); err != nil {
 		return nil, err
 	}
 	return &movieChanges, nil
@@ -298,17 +331,27 @@ type MovieCredits struct {
 func (c *Client) GetMovieCredits(
 	id int,
 	urlOptions map[string]string,
+) (*MovieCredits, error) {
+	return c.GetMovieCreditsWithContext(context.Background(), id, urlOptions)
+}
+
+// GetMovieCreditsWithContext is like GetMovieCredits but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetMovieCreditsWithContext(
+	ctx context.Context,
+	id int,
+	urlOptions map[string]string,
 ) (*MovieCredits, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf("%s%s%d/credits?api_key=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		movieURL,
 		id,
 		c.apiKey,
 		options,
 	)
 	movieCredits := MovieCredits{}
-	if err := c.get(tmdbURL, &movieCredits); err != nil {
+	if err := c.get(ctx, "GetMovieCredits", tmdbURL, &movieCredits); err != nil {
 		return nil, err
 	}
 	return &movieCredits, nil
@@ -336,18 +379,28 @@ type MovieExternalIDs struct {
 func (c *Client) GetMovieExternalIDs(
 	id int,
 	urlOptions map[string]string,
+) (*MovieExternalIDs, error) {
+	return c.GetMovieExternalIDsWithContext(context.Background(), id, urlOptions)
+}
+
+// GetMovieExternalIDsWithContext is like GetMovieExternalIDs but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetMovieExternalIDsWithContext(
+	ctx context.Context,
+	id int,
+	urlOptions map[string]string,
 ) (*MovieExternalIDs, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%s%d/external_ids?api_key=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		movieURL,
 		id,
 		c.apiKey,
 		options,
 	)
 	movieExternalIDs := MovieExternalIDs{}
-	if err := c.get(tmdbURL, &movieExternalIDs); err != nil {
+	if err := c.get(ctx, "GetMovieExternalIDs", tmdbURL, &movieExternalIDs); err != nil {
 		return nil, err
 	}
 	return &movieExternalIDs, nil
@@ -378,18 +431,28 @@ type MovieImages struct {
 func (c *Client) GetMovieImages(
 	id int,
 	urlOptions map[string]string,
+) (*MovieImages, error) {
+	return c.GetMovieImagesWithContext(context.Background(), id, urlOptions)
+}
+
+// GetMovieImagesWithContext is like GetMovieImages but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetMovieImagesWithContext(
+	ctx context.Context,
+	id int,
+	urlOptions map[string]string,
 ) (*MovieImages, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%s%d/images?api_key=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		movieURL,
 		id,
 		c.apiKey,
 		options,
 	)
 	movieImages := MovieImages{}
-	if err := c.get(tmdbURL, &movieImages); err != nil {
+	if err := c.get(ctx, "GetMovieImages", tmdbURL, &movieImages); err != nil {
 		return nil, err
 	}
 	return &movieImages, nil
@@ -408,15 +471,24 @@ type MovieKeywords struct {
 //
 // https://developers.themoviedb.org/3/movies/get-movie-keywords
 func (c *Client) GetMovieKeywords(id int) (*MovieKeywords, error) {
+	return c.GetMovieKeywordsWithContext(context.Background(), id)
+}
+
+// GetMovieKeywordsWithContext is like GetMovieKeywords but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetMovieKeywordsWithContext(
+	ctx context.Context,
+	id int,
+) (*MovieKeywords, error) {
 	tmdbURL := fmt.Sprintf(
 		"%s%s%d/keywords?api_key=%s",
-		baseURL,
+		c.GetBaseURL(),
 		movieURL,
 		id,
 		c.apiKey,
 	)
 	movieKeywords := MovieKeywords{}
-	if err := c.get(tmdbURL, &movieKeywords); err != nil {
+	if err := c.get(ctx, "GetMovieKeywords", tmdbURL, &movieKeywords); err != nil {
 		return nil, err
 	}
 	return &movieKeywords, nil
@@ -433,16 +505,25 @@ type MovieReleaseDates struct {
 // https://developers.themoviedb.org/3/movies/get-movie-release-dates
 func (c *Client) GetMovieReleaseDates(
 	id int,
+) (*MovieReleaseDates, error) {
+	return c.GetMovieReleaseDatesWithContext(context.Background(), id)
+}
+
+// GetMovieReleaseDatesWithContext is like GetMovieReleaseDates but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetMovieReleaseDatesWithContext(
+	ctx context.Context,
+	id int,
 ) (*MovieReleaseDates, error) {
 	tmdbURL := fmt.Sprintf(
 		"%s%s%d/release_dates?api_key=%s",
-		baseURL,
+		c.GetBaseURL(),
 		movieURL,
 		id,
 		c.apiKey,
 	)
 	movieReleaseDates := MovieReleaseDates{}
-	if err := c.get(tmdbURL, &movieReleaseDates); err != nil {
+	if err := c.get(ctx, "GetMovieReleaseDates", tmdbURL, &movieReleaseDates); err != nil {
 		return nil, err
 	}
 	return &movieReleaseDates, nil
@@ -454,18 +535,28 @@ func (c *Client) GetMovieReleaseDates(
 func (c *Client) GetMovieVideos(
 	id int,
 	urlOptions map[string]string,
+) (*VideoResults, error) {
+	return c.GetMovieVideosWithContext(context.Background(), id, urlOptions)
+}
+
+// GetMovieVideosWithContext is like GetMovieVideos but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetMovieVideosWithContext(
+	ctx context.Context,
+	id int,
+	urlOptions map[string]string,
 ) (*VideoResults, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%s%d/videos?api_key=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		movieURL,
 		id,
 		c.apiKey,
 		options,
 	)
 	movieVideos := VideoResults{}
-	if err := c.get(tmdbURL, &movieVideos); err != nil {
+	if err := c.get(ctx, "GetMovieVideos", tmdbURL, &movieVideos); err != nil {
 		return nil, err
 	}
 	return &movieVideos, nil
@@ -477,18 +568,32 @@ func (c *Client) GetMovieVideos(
 func (c *Client) GetMovieWatchProviders(
 	id int,
 	urlOptions map[string]string,
+) (*WatchProviderResults, error) {
+	return c.GetMovieWatchProvidersWithContext(
+		context.Background(),
+		id,
+		urlOptions,
+	)
+}
+
+// GetMovieWatchProvidersWithContext is like GetMovieWatchProviders but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetMovieWatchProvidersWithContext(
+	ctx context.Context,
+	id int,
+	urlOptions map[string]string,
 ) (*WatchProviderResults, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%s%d/watch/providers?api_key=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		movieURL,
 		id,
 		c.apiKey,
 		options,
 	)
 	movieWatchProviders := WatchProviderResults{}
-	if err := c.get(tmdbURL, &movieWatchProviders); err != nil {
+	if err := c.get(ctx, "GetMovieWatchProviders", tmdbURL, &movieWatchProviders); err != nil {
 		return nil, err
 	}
 	return &movieWatchProviders, nil
@@ -506,18 +611,28 @@ type MovieTranslations struct {
 func (c *Client) GetMovieTranslations(
 	id int,
 	urlOptions map[string]string,
+) (*MovieTranslations, error) {
+	return c.GetMovieTranslationsWithContext(context.Background(), id, urlOptions)
+}
+
+// GetMovieTranslationsWithContext is like GetMovieTranslations but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetMovieTranslationsWithContext(
+	ctx context.Context,
+	id int,
+	urlOptions map[string]string,
 ) (*MovieTranslations, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%s%d/translations?api_key=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		movieURL,
 		id,
 		c.apiKey,
 		options,
 	)
 	movieTranslations := MovieTranslations{}
-	if err := c.get(tmdbURL, &movieTranslations); err != nil {
+	if err := c.get(ctx, "GetMovieTranslations", tmdbURL, &movieTranslations); err != nil {
 		return nil, err
 	}
 	return &movieTranslations, nil
@@ -535,18 +650,32 @@ type MovieRecommendations struct {
 func (c *Client) GetMovieRecommendations(
 	id int,
 	urlOptions map[string]string,
+) (*MovieRecommendations, error) {
+	return c.GetMovieRecommendationsWithContext(
+		context.Background(),
+		id,
+		urlOptions,
+	)
+}
+
+// GetMovieRecommendationsWithContext is like GetMovieRecommendations but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetMovieRecommendationsWithContext(
+	ctx context.Context,
+	id int,
+	urlOptions map[string]string,
 ) (*MovieRecommendations, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%s%d/recommendations?api_key=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		movieURL,
 		id,
 		c.apiKey,
 		options,
 	)
 	movieRecommendations := MovieRecommendations{}
-	if err := c.get(tmdbURL, &movieRecommendations); err != nil {
+	if err := c.get(ctx, "GetMovieRecommendations", tmdbURL, &movieRecommendations); err != nil {
 		return nil, err
 	}
 	return &movieRecommendations, nil
@@ -566,18 +695,28 @@ type MovieSimilar struct {
 func (c *Client) GetMovieSimilar(
 	id int,
 	urlOptions map[string]string,
+) (*MovieSimilar, error) {
+	return c.GetMovieSimilarWithContext(context.Background(), id, urlOptions)
+}
+
+// GetMovieSimilarWithContext is like GetMovieSimilar but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetMovieSimilarWithContext(
+	ctx context.Context,
+	id int,
+	urlOptions map[string]string,
 ) (*MovieSimilar, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%s%d/similar?api_key=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		movieURL,
 		id,
 		c.apiKey,
 		options,
 	)
 	movieSimilar := MovieSimilar{}
-	if err := c.get(tmdbURL, &movieSimilar); err != nil {
+	if err := c.get(ctx, "GetMovieSimilar", tmdbURL, &movieSimilar); err != nil {
 		return nil, err
 	}
 	return &movieSimilar, nil
@@ -596,18 +735,28 @@ type MovieReviews struct {
 func (c *Client) GetMovieReviews(
 	id int,
 	urlOptions map[string]string,
+) (*MovieReviews, error) {
+	return c.GetMovieReviewsWithContext(context.Background(), id, urlOptions)
+}
+
+// GetMovieReviewsWithContext is like GetMovieReviews but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetMovieReviewsWithContext(
+	ctx context.Context,
+	id int,
+	urlOptions map[string]string,
 ) (*MovieReviews, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%s%d/reviews?api_key=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		movieURL,
 		id,
 		c.apiKey,
 		options,
 	)
 	movieReviews := MovieReviews{}
-	if err := c.get(tmdbURL, &movieReviews); err != nil {
+	if err := c.get(ctx, "GetMovieReviews", tmdbURL, &movieReviews); err != nil {
 		return nil, err
 	}
 	return &movieReviews, nil
@@ -626,18 +775,28 @@ type MovieLists struct {
 func (c *Client) GetMovieLists(
 	id int,
 	urlOptions map[string]string,
+) (*MovieLists, error) {
+	return c.GetMovieListsWithContext(context.Background(), id, urlOptions)
+}
+
+// GetMovieListsWithContext is like GetMovieLists but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetMovieListsWithContext(
+	ctx context.Context,
+	id int,
+	urlOptions map[string]string,
 ) (*MovieLists, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%s%d/lists?api_key=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		movieURL,
 		id,
 		c.apiKey,
 		options,
 	)
 	movieLists := MovieLists{}
-	if err := c.get(tmdbURL, &movieLists); err != nil {
+	if err := c.get(ctx, "GetMovieLists", tmdbURL, &movieLists); err != nil {
 		return nil, err
 	}
 	return &movieLists, nil
@@ -655,17 +814,26 @@ type MovieLatest struct {
 // https://developers.themoviedb.org/3/movies/get-latest-movie
 func (c *Client) GetMovieLatest(
 	urlOptions map[string]string,
+) (*MovieLatest, error) {
+	return c.GetMovieLatestWithContext(context.Background(), urlOptions)
+}
+
+// GetMovieLatestWithContext is like GetMovieLatest but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetMovieLatestWithContext(
+	ctx context.Context,
+	urlOptions map[string]string,
 ) (*MovieLatest, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%slatest?api_key=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		movieURL,
 		c.apiKey,
 		options,
 	)
 	movieLastest := MovieLatest{}
-	if err := c.get(tmdbURL, &movieLastest); err != nil {
+	if err := c.get(ctx, "GetMovieLatest", tmdbURL, &movieLastest); err != nil {
 		return nil, err
 	}
 	return &movieLastest, nil
@@ -693,17 +861,26 @@ type MovieNowPlaying struct {
 // https://developers.themoviedb.org/3/movies/get-now-playing
 func (c *Client) GetMovieNowPlaying(
 	urlOptions map[string]string,
+) (*MovieNowPlaying, error) {
+	return c.GetMovieNowPlayingWithContext(context.Background(), urlOptions)
+}
+
+// GetMovieNowPlayingWithContext is like GetMovieNowPlaying but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetMovieNowPlayingWithContext(
+	ctx context.Context,
+	urlOptions map[string]string,
 ) (*MovieNowPlaying, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%snow_playing?api_key=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		movieURL,
 		c.apiKey,
 		options,
 	)
 	movieNowPlaying := MovieNowPlaying{}
-	if err := c.get(tmdbURL, &movieNowPlaying); err != nil {
+	if err := c.get(ctx, "GetMovieNowPlaying", tmdbURL, &movieNowPlaying); err != nil {
 		return nil, err
 	}
 	return &movieNowPlaying, nil
@@ -722,17 +899,26 @@ type MoviePopular struct {
 // https://developers.themoviedb.org/3/movies/get-popular-movies
 func (c *Client) GetMoviePopular(
 	urlOptions map[string]string,
+) (*MoviePopular, error) {
+	return c.GetMoviePopularWithContext(context.Background(), urlOptions)
+}
+
+// GetMoviePopularWithContext is like GetMoviePopular but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetMoviePopularWithContext(
+	ctx context.Context,
+	urlOptions map[string]string,
 ) (*MoviePopular, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%spopular?api_key=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		movieURL,
 		c.apiKey,
 		options,
 	)
 	moviePopular := MoviePopular{}
-	if err := c.get(tmdbURL, &moviePopular); err != nil {
+	if err := c.get(ctx, "GetMoviePopular", tmdbURL, &moviePopular); err != nil {
 		return nil, err
 	}
 	return &moviePopular, nil
@@ -748,17 +934,26 @@ type MovieTopRated struct {
 // https://developers.themoviedb.org/3/movies/get-top-rated-movies
 func (c *Client) GetMovieTopRated(
 	urlOptions map[string]string,
+) (*MovieTopRated, error) {
+	return c.GetMovieTopRatedWithContext(context.Background(), urlOptions)
+}
+
+// GetMovieTopRatedWithContext is like GetMovieTopRated but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetMovieTopRatedWithContext(
+	ctx context.Context,
+	urlOptions map[string]string,
 ) (*MovieTopRated, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%stop_rated?api_key=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		movieURL,
 		c.apiKey,
 		options,
 	)
 	movieTopRated := MovieTopRated{}
-	if err := c.get(tmdbURL, &movieTopRated); err != nil {
+	if err := c.get(ctx, "GetMovieTopRated", tmdbURL, &movieTopRated); err != nil {
 		return nil, err
 	}
 	return &movieTopRated, nil
@@ -781,17 +976,26 @@ type MovieUpcoming struct {
 // https://developers.themoviedb.org/3/movies/get-upcoming
 func (c *Client) GetMovieUpcoming(
 	urlOptions map[string]string,
+) (*MovieUpcoming, error) {
+	return c.GetMovieUpcomingWithContext(context.Background(), urlOptions)
+}
+
+// GetMovieUpcomingWithContext is like GetMovieUpcoming but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetMovieUpcomingWithContext(
+	ctx context.Context,
+	urlOptions map[string]string,
 ) (*MovieUpcoming, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%supcoming?api_key=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		movieURL,
 		c.apiKey,
 		options,
 	)
 	movieUpcoming := MovieUpcoming{}
-	if err := c.get(tmdbURL, &movieUpcoming); err != nil {
+	if err := c.get(ctx, "GetMovieUpcoming", tmdbURL, &movieUpcoming); err != nil {
 		return nil, err
 	}
 	return &movieUpcoming, nil
@@ -809,11 +1013,27 @@ func (c *Client) PostMovieRating(
 	id int,
 	rating float32,
 	urlOptions map[string]string,
+) (*Response, error) {
+	return c.PostMovieRatingWithContext(
+		context.Background(),
+		id,
+		rating,
+		urlOptions,
+	)
+}
+
+// PostMovieRatingWithContext is like PostMovieRating but uses the
+// given context for cancellation and deadlines.
+func (c *Client) PostMovieRatingWithContext(
+	ctx context.Context,
+	id int,
+	rating float32,
+	urlOptions map[string]string,
 ) (*Response, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%s%d/rating?api_key=%s&session_id=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		movieURL,
 		id,
 		c.apiKey,
@@ -825,6 +1045,8 @@ func (c *Client) PostMovieRating(
 	}{Value: rating}
 	response := Response{}
 	if err := c.request(
+		ctx,
+		"PostMovieRating",
 		tmdbURL,
 		body,
 		http.MethodPost,
@@ -846,11 +1068,21 @@ func (c *Client) PostMovieRating(
 func (c *Client) DeleteMovieRating(
 	id int,
 	urlOptions map[string]string,
+) (*Response, error) {
+	return c.DeleteMovieRatingWithContext(context.Background(), id, urlOptions)
+}
+
+// DeleteMovieRatingWithContext is like DeleteMovieRating but uses the
+// given context for cancellation and deadlines.
+func (c *Client) DeleteMovieRatingWithContext(
+	ctx context.Context,
+	id int,
+	urlOptions map[string]string,
 ) (*Response, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%s%d/rating?api_key=%s&session_id=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		movieURL,
 		id,
 		c.apiKey,
@@ -859,6 +1091,8 @@ func (c *Client) DeleteMovieRating(
 	)
 	response := Response{}
 	if err := c.request(
+		ctx,
+		"DeleteMovieRating",
 		tmdbURL,
 		[]byte{},
 		http.MethodDelete,
@@ -1,6 +1,9 @@
 package tmdb
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 // NetworkDetails type is a struct for details JSON response.
 type NetworkDetails struct {
@@ -17,16 +20,25 @@ type NetworkDetails struct {
 // https://developers.themoviedb.org/3/networks/get-network-details
 func (c *Client) GetNetworkDetails(
 	id int,
+) (*NetworkDetails, error) {
+	return c.GetNetworkDetailsWithContext(context.Background(), id)
+}
+
+// GetNetworkDetailsWithContext is like GetNetworkDetails but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetNetworkDetailsWithContext(
+	ctx context.Context,
+	id int,
 ) (*NetworkDetails, error) {
 	tmdbURL := fmt.Sprintf(
 		"%s%s%d?api_key=%s",
-		baseURL,
+		c.GetBaseURL(),
 		networkURL,
 		id,
 		c.apiKey,
 	)
 	networkDetails := NetworkDetails{}
-	if err := c.get(tmdbURL, &networkDetails); err != nil {
+	if err := c.get(ctx, "GetNetworkDetails", tmdbURL, &networkDetails); err != nil {
 		return nil, err
 	}
 	return &networkDetails, nil
@@ -46,16 +58,25 @@ type NetworkAlternativeNames struct {
 // https://developers.themoviedb.org/3/networks/get-network-alternative-names
 func (c *Client) GetNetworkAlternativeNames(
 	id int,
+) (*NetworkAlternativeNames, error) {
+	return c.GetNetworkAlternativeNamesWithContext(context.Background(), id)
+}
+
+// GetNetworkAlternativeNamesWithContext is like GetNetworkAlternativeNames but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetNetworkAlternativeNamesWithContext(
+	ctx context.Context,
+	id int,
 ) (*NetworkAlternativeNames, error) {
 	tmdbURL := fmt.Sprintf(
 		"%s%s%d/alternative_names?api_key=%s",
-		baseURL,
+		c.GetBaseURL(),
 		networkURL,
 		id,
 		c.apiKey,
 	)
 	networkAltenativeNames := NetworkAlternativeNames{}
-	if err := c.get(tmdbURL, &networkAltenativeNames); err != nil {
+	if err := c.get(ctx, "GetNetworkAlternativeNames", tmdbURL, &networkAltenativeNames); err != nil {
 		return nil, err
 	}
 	return &networkAltenativeNames, nil
@@ -87,16 +108,25 @@ type NetworkImages struct {
 // https://developers.themoviedb.org/3/networks/get-network-images
 func (c *Client) GetNetworkImages(
 	id int,
+) (*NetworkImages, error) {
+	return c.GetNetworkImagesWithContext(context.Background(), id)
+}
+
+// GetNetworkImagesWithContext is like GetNetworkImages but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetNetworkImagesWithContext(
+	ctx context.Context,
+	id int,
 ) (*NetworkImages, error) {
 	tmdbURL := fmt.Sprintf(
 		"%s%s%d/images?api_key=%s",
-		baseURL,
+		c.GetBaseURL(),
 		networkURL,
 		id,
 		c.apiKey,
 	)
 	networkImages := NetworkImages{}
-	if err := c.get(tmdbURL, &networkImages); err != nil {
+	if err := c.get(ctx, "GetNetworkImages", tmdbURL, &networkImages); err != nil {
 		return nil, err
 	}
 	return &networkImages, nil
@@ -0,0 +1,384 @@
+package tmdb
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// option is a typed query parameter for the
+// urlOptions argument of the client methods.
+type option struct {
+	key   string
+	value string
+}
+
+func (o option) set(options map[string]string) {
+	options[o.key] = o.value
+}
+
+// urlOption is implemented by the options of every family.
+type urlOption interface {
+	set(options map[string]string)
+}
+
+// buildOptions builds the urlOptions map from typed options.
+// When an option is repeated, the last one wins.
+func buildOptions[O urlOption](opts []O) map[string]string {
+	options := make(map[string]string, len(opts))
+	for _, opt := range opts {
+		opt.set(options)
+	}
+	return options
+}
+
+func intOption(key string, value int) option {
+	return option{key, strconv.Itoa(value)}
+}
+
+func boolOption(key string, value bool) option {
+	return option{key, strconv.FormatBool(value)}
+}
+
+func listOption(key string, values []string) option {
+	return option{key, strings.Join(values, ",")}
+}
+
+func dateOption(key string, value time.Time) option {
+	return option{key, value.Format(time.DateOnly)}
+}
+
+// Options are grouped in families, one per kind of endpoint,
+// so that an option that an endpoint does not accept, like a
+// timezone for a movie details call, is caught at compile time:
+//
+//	options := tmdb.DetailsOptions(
+//		tmdb.Language("pt-BR"),
+//		tmdb.AppendToResponse("credits", "images"),
+//	)
+//	movie, err := tmdbClient.GetMovieDetails(297802, options)
+
+// DetailsOption type is an option of the details endpoints,
+// like GetMovieDetails, GetTVDetails or GetPersonDetails.
+type DetailsOption interface {
+	urlOption
+	details()
+}
+
+// DetailsOptions builds the urlOptions map of a details endpoint.
+func DetailsOptions(opts ...DetailsOption) map[string]string {
+	return buildOptions(opts)
+}
+
+// ListingOption type is an option of the endpoints listing
+// movies, TV shows or people, like GetMoviePopular,
+// GetTVTopRated or GetMovieRecommendations.
+type ListingOption interface {
+	urlOption
+	listing()
+}
+
+// ListingOptions builds the urlOptions map of a listing endpoint.
+func ListingOptions(opts ...ListingOption) map[string]string {
+	return buildOptions(opts)
+}
+
+// SearchOption type is an option of the search endpoints.
+type SearchOption interface {
+	urlOption
+	search()
+}
+
+// SearchOptions builds the urlOptions map of a search endpoint.
+func SearchOptions(opts ...SearchOption) map[string]string {
+	return buildOptions(opts)
+}
+
+// ChangesOption type is an option of the changes endpoints.
+type ChangesOption interface {
+	urlOption
+	changes()
+}
+
+// ChangesOptions builds the urlOptions map of a changes endpoint.
+func ChangesOptions(opts ...ChangesOption) map[string]string {
+	return buildOptions(opts)
+}
+
+// AccountOption type is an option of the account endpoints,
+// like GetFavoriteMovies or GetMovieWatchlist.
+type AccountOption interface {
+	urlOption
+	account()
+}
+
+// AccountOptions builds the urlOptions map of an account endpoint.
+func AccountOptions(opts ...AccountOption) map[string]string {
+	return buildOptions(opts)
+}
+
+// AiringOption type is an option of GetTVAiringToday
+// and GetTVOnTheAir.
+type AiringOption interface {
+	urlOption
+	airing()
+}
+
+// AiringOptions builds the urlOptions map of an airing endpoint.
+func AiringOptions(opts ...AiringOption) map[string]string {
+	return buildOptions(opts)
+}
+
+// FindOption type is an option of GetFindByID.
+type FindOption interface {
+	urlOption
+	find()
+}
+
+// FindOptions builds the urlOptions map of GetFindByID.
+func FindOptions(opts ...FindOption) map[string]string {
+	return buildOptions(opts)
+}
+
+// WatchProvidersOption type is an option of the watch
+// providers endpoints.
+type WatchProvidersOption interface {
+	urlOption
+	watchProviders()
+}
+
+// WatchProvidersOptions builds the urlOptions
+// map of a watch providers endpoint.
+func WatchProvidersOptions(opts ...WatchProvidersOption) map[string]string {
+	return buildOptions(opts)
+}
+
+// AlternativeTitlesOption type is an option of
+// GetMovieAlternativeTitles.
+type AlternativeTitlesOption interface {
+	urlOption
+	alternativeTitles()
+}
+
+// AlternativeTitlesOptions builds the urlOptions
+// map of GetMovieAlternativeTitles.
+func AlternativeTitlesOptions(opts ...AlternativeTitlesOption) map[string]string {
+	return buildOptions(opts)
+}
+
+// ItemStatusOption type is an option of GetListItemStatus.
+type ItemStatusOption interface {
+	urlOption
+	itemStatus()
+}
+
+// ItemStatusOptions builds the urlOptions map of GetListItemStatus.
+func ItemStatusOptions(opts ...ItemStatusOption) map[string]string {
+	return buildOptions(opts)
+}
+
+// RatingOption type is an option of the rating endpoints,
+// like PostMovieRating or DeleteTVShowRating.
+type RatingOption interface {
+	urlOption
+	rating()
+}
+
+// RatingOptions builds the urlOptions map of a rating endpoint.
+func RatingOptions(opts ...RatingOption) map[string]string {
+	return buildOptions(opts)
+}
+
+// Options of a single family.
+
+type detailsOption struct{ option }
+
+func (detailsOption) details() {}
+
+type searchOption struct{ option }
+
+func (searchOption) search() {}
+
+type changesOption struct{ option }
+
+func (changesOption) changes() {}
+
+type accountOption struct{ option }
+
+func (accountOption) account() {}
+
+type airingOption struct{ option }
+
+func (airingOption) airing() {}
+
+type findOption struct{ option }
+
+func (findOption) find() {}
+
+type watchProvidersOption struct{ option }
+
+func (watchProvidersOption) watchProviders() {}
+
+type alternativeTitlesOption struct{ option }
+
+func (alternativeTitlesOption) alternativeTitles() {}
+
+type itemStatusOption struct{ option }
+
+func (itemStatusOption) itemStatus() {}
+
+type ratingOption struct{ option }
+
+func (ratingOption) rating() {}
+
+// Common options.
+
+// LanguageOption type is the language option, accepted by the
+// details, listing, search, account, airing, find and watch
+// providers endpoints.
+type LanguageOption struct{ option }
+
+func (LanguageOption) details()        {}
+func (LanguageOption) listing()        {}
+func (LanguageOption) search()         {}
+func (LanguageOption) account()        {}
+func (LanguageOption) airing()         {}
+func (LanguageOption) find()           {}
+func (LanguageOption) watchProviders() {}
+
+// Language sets the ISO 639-1 language of the results, e.g. "pt-BR".
+func Language(language string) LanguageOption {
+	return LanguageOption{option{"language", language}}
+}
+
+// RegionOption type is the region option, accepted
+// by the listing and search endpoints.
+type RegionOption struct{ option }
+
+func (RegionOption) listing() {}
+func (RegionOption) search()  {}
+
+// Region sets the ISO 3166-1 region used to filter release dates.
+func Region(region string) RegionOption {
+	return RegionOption{option{"region", region}}
+}
+
+// PageOption type is the page option, accepted by the
+// listing, search, changes, account and airing endpoints.
+type PageOption struct{ option }
+
+func (PageOption) listing() {}
+func (PageOption) search()  {}
+func (PageOption) changes() {}
+func (PageOption) account() {}
+func (PageOption) airing()  {}
+
+// Page sets the page of the results.
+func Page(page int) PageOption {
+	return PageOption{intOption("page", page)}
+}
+
+// Details options.
+
+// AppendToResponse appends sub requests, like "credits"
+// or "images", to a details response.
+func AppendToResponse(values ...string) DetailsOption {
+	return detailsOption{listOption("append_to_response", values)}
+}
+
+// IncludeImageLanguage sets the languages of the appended
+// images, e.g. "en" and "null" for images without text.
+func IncludeImageLanguage(languages ...string) DetailsOption {
+	return detailsOption{listOption("include_image_language", languages)}
+}
+
+// IncludeVideoLanguage sets the languages of the appended videos.
+func IncludeVideoLanguage(languages ...string) DetailsOption {
+	return detailsOption{listOption("include_video_language", languages)}
+}
+
+// Search options.
+
+// IncludeAdult sets whether adult content is included.
+func IncludeAdult(include bool) SearchOption {
+	return searchOption{boolOption("include_adult", include)}
+}
+
+// Year sets the release year of movies.
+func Year(year int) SearchOption {
+	return searchOption{intOption("year", year)}
+}
+
+// PrimaryReleaseYear sets the primary release year of movies.
+func PrimaryReleaseYear(year int) SearchOption {
+	return searchOption{intOption("primary_release_year", year)}
+}
+
+// FirstAirDateYear sets the first air date year of TV shows.
+func FirstAirDateYear(year int) SearchOption {
+	return searchOption{intOption("first_air_date_year", year)}
+}
+
+// Changes options.
+
+// StartDate sets the first day of a changes window.
+func StartDate(date time.Time) ChangesOption {
+	return changesOption{dateOption("start_date", date)}
+}
+
+// EndDate sets the last day of a changes window.
+func EndDate(date time.Time) ChangesOption {
+	return changesOption{dateOption("end_date", date)}
+}
+
+// Account options.
+
+// SortBy sets the sort order, e.g. "created_at.desc".
+func SortBy(sortBy string) AccountOption {
+	return accountOption{option{"sort_by", sortBy}}
+}
+
+// Airing options.
+
+// Timezone sets the timezone of the airing today
+// list, e.g. "America/Sao_Paulo".
+func Timezone(timezone string) AiringOption {
+	return airingOption{option{"timezone", timezone}}
+}
+
+// Find options.
+
+// ExternalSource sets the source of an external id,
+// e.g. "imdb_id" or "tvdb_id".
+func ExternalSource(source string) FindOption {
+	return findOption{option{"external_source", source}}
+}
+
+// Watch providers options.
+
+// WatchRegion sets the ISO 3166-1 region of watch providers.
+func WatchRegion(region string) WatchProvidersOption {
+	return watchProvidersOption{option{"watch_region", region}}
+}
+
+// Alternative titles options.
+
+// Country sets the ISO 3166-1 country of the alternative titles.
+func Country(country string) AlternativeTitlesOption {
+	return alternativeTitlesOption{option{"country", country}}
+}
+
+// List options.
+
+// MovieID sets the movie id of a list item status check.
+func MovieID(id int64) ItemStatusOption {
+	return itemStatusOption{option{"movie_id", strconv.FormatInt(id, 10)}}
+}
+
+// Rating options.
+
+// GuestSessionID sets the guest session id of rating calls,
+// used instead of the client session.
+func GuestSessionID(guestSessionID string) RatingOption {
+	return ratingOption{option{"guest_session_id", guestSessionID}}
+}
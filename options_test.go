@@ -0,0 +1,86 @@
+package tmdb
+
+import (
+	"strings"
+	"time"
+)
+
+func (suite *TMBDTestSuite) TestDetailsOptions() {
+	options := DetailsOptions(
+		Language("pt-BR"),
+		AppendToResponse("credits", "images"),
+		IncludeImageLanguage("en", "null"),
+		IncludeVideoLanguage("en"),
+	)
+	suite.Equal(map[string]string{
+		"language":               "pt-BR",
+		"append_to_response":     "credits,images",
+		"include_image_language": "en,null",
+		"include_video_language": "en",
+	}, options)
+}
+
+func (suite *TMBDTestSuite) TestFamilyOptions() {
+	date := time.Date(2019, time.January, 1, 0, 0, 0, 0, time.UTC)
+	suite.Equal(map[string]string{
+		"language": "pt-BR",
+		"region":   "BR",
+		"page":     "2",
+	}, ListingOptions(Language("pt-BR"), Region("BR"), Page(2)))
+	suite.Equal(map[string]string{
+		"start_date": "2019-01-01",
+		"end_date":   "2019-01-14",
+		"page":       "1",
+	}, ChangesOptions(StartDate(date), EndDate(date.AddDate(0, 0, 13)), Page(1)))
+	suite.Equal(map[string]string{
+		"language": "en-US",
+		"sort_by":  "created_at.desc",
+	}, AccountOptions(Language("en-US"), SortBy("created_at.desc")))
+	suite.Equal(map[string]string{
+		"timezone": "America/Sao_Paulo",
+	}, AiringOptions(Timezone("America/Sao_Paulo")))
+	suite.Equal(map[string]string{
+		"external_source": "imdb_id",
+	}, FindOptions(ExternalSource("imdb_id")))
+	suite.Equal(map[string]string{
+		"watch_region": "BR",
+	}, WatchProvidersOptions(WatchRegion("BR")))
+	suite.Equal(map[string]string{
+		"country": "BR",
+	}, AlternativeTitlesOptions(Country("BR")))
+	suite.Equal(map[string]string{
+		"movie_id": "424783",
+	}, ItemStatusOptions(MovieID(bumblebeeID)))
+	suite.Equal(map[string]string{
+		"guest_session_id": "guest",
+	}, RatingOptions(GuestSessionID("guest")))
+}
+
+func (suite *TMBDTestSuite) TestSearchOptionsEncoding() {
+	typed := SearchOptions(Year(2016), IncludeAdult(true), Page(2))
+	raw := map[string]string{
+		"year":          "2016",
+		"include_adult": "true",
+		"page":          "2",
+	}
+	suite.ElementsMatch(
+		splitOptions(suite.client.fmtOptions(raw)),
+		splitOptions(suite.client.fmtOptions(typed)),
+	)
+}
+
+func (suite *TMBDTestSuite) TestOptionsLastWins() {
+	options := SearchOptions(Page(1), Page(3))
+	suite.Equal("3", options["page"])
+	suite.Empty(DetailsOptions())
+}
+
+func splitOptions(options string) []string {
+	var parts []string
+	for part := range strings.SplitSeq(options, "&") {
+		if part != "" {
+			parts = append(parts, part)
+		}
+	}
+	return parts
+}
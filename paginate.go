@@ -0,0 +1,157 @@
+package tmdb
+
+import (
+	"context"
+	"iter"
+	"maps"
+	"strconv"
+)
+
+// PageMeta returns the pagination metadata. It makes every
+// paginated response type satisfy the Paginated interface.
+func (m PaginatedResultsMeta) PageMeta() PaginatedResultsMeta {
+	return m
+}
+
+// Paginated is the interface implemented by the response types
+// embedding PaginatedResultsMeta, like SearchMovies or Trending.
+type Paginated interface {
+	PageMeta() PaginatedResultsMeta
+}
+
+// PageFetcher fetches one page of a paginated endpoint.
+type PageFetcher[P Paginated] func(ctx context.Context, page int) (P, error)
+
+// PaginateOptions type is a struct to limit the pagination.
+type PaginateOptions struct {
+	// StartPage is the first page fetched, 1 when zero.
+	StartPage int
+	// MaxPages caps the number of pages fetched. Zero means no limit.
+	MaxPages int
+	// MaxItems caps the number of results yielded by Paginate.
+	// Zero means no limit.
+	MaxItems int
+}
+
+// PaginatePages returns an iterator over the pages of a paginated
+// endpoint, stopping after the last page or when a limit is reached.
+//
+// When fetching a page fails the error is yielded. If the loop goes
+// on, the next page is fetched as long as the total number of pages
+// is already known, otherwise the iteration stops. The iteration
+// also stops with the context error once ctx is done.
+func PaginatePages[P Paginated](
+	ctx context.Context,
+	fetch PageFetcher[P],
+	opts PaginateOptions,
+) iter.Seq2[P, error] {
+	return func(yield func(P, error) bool) {
+		var zero P
+		page := max(opts.StartPage, 1)
+		var totalPages int64
+		for fetched := 0; opts.MaxPages == 0 || fetched < opts.MaxPages; fetched++ {
+			if err := ctx.Err(); err != nil {
+				yield(zero, err)
+				return
+			}
+			result, err := fetch(ctx, page)
+			if err != nil {
+				if !yield(zero, err) || totalPages == 0 {
+					return
+				}
+			} else {
+				totalPages = result.PageMeta().TotalPages
+				if !yield(result, nil) {
+					return
+				}
+			}
+			if int64(page) >= totalPages {
+				return
+			}
+			page++
+		}
+	}
+}
+
+// Paginate returns an iterator over the individual results of
+// a paginated endpoint across its pages. The results function
+// extracts the results of a page.
+//
+//	movies := tmdb.Paginate(
+//		ctx,
+//		func(ctx context.Context, page int) (*tmdb.SearchMovies, error) {
+//			return tmdbClient.GetSearchMoviesWithContext(
+//				ctx, "Jack Reacher", tmdb.SearchOptions(tmdb.Page(page)),
+//			)
+//		},
+//		func(page *tmdb.SearchMovies) []tmdb.MovieResult {
+//			return page.Results
+//		},
+//		tmdb.PaginateOptions{MaxItems: 50},
+//	)
+//	for movie, err := range movies {
+//		if err != nil {
+//			break
+//		}
+//		fmt.Println(movie.Title)
+//	}
+//
+// Errors are yielded the same way as PaginatePages.
+func Paginate[P Paginated, R any](
+	ctx context.Context,
+	fetch PageFetcher[P],
+	results func(page P) []R,
+	opts PaginateOptions,
+) iter.Seq2[R, error] {
+	return func(yield func(R, error) bool) {
+		var zero R
+		items := 0
+		for page, err := range PaginatePages(ctx, fetch, opts) {
+			if err != nil {
+				if !yield(zero, err) {
+					return
+				}
+				continue
+			}
+			for _, result := range results(page) {
+				if opts.MaxItems > 0 && items >= opts.MaxItems {
+					return
+				}
+				items++
+				if !yield(result, nil) {
+					return
+				}
+			}
+			if opts.MaxItems > 0 && items >= opts.MaxItems {
+				return
+			}
+		}
+	}
+}
+
+// paginateOptions is like Paginate for the endpoints taking url
+// options, setting the page option of every request.
+func paginateOptions[P Paginated, R any](
+	ctx context.Context,
+	fetch func(ctx context.Context, urlOptions map[string]string) (P, error),
+	urlOptions map[string]string,
+	results func(page P) []R,
+	opts PaginateOptions,
+) iter.Seq2[R, error] {
+	return Paginate(
+		ctx,
+		func(ctx context.Context, page int) (P, error) {
+			return fetch(ctx, withPage(urlOptions, page))
+		},
+		results,
+		opts,
+	)
+}
+
+// withPage returns a copy of urlOptions with the page option set.
+func withPage(urlOptions map[string]string, page int) map[string]string {
+	options := make(map[string]string, len(urlOptions)+1)
+	maps.Copy(options, urlOptions)
+	options["page"] = strconv.Itoa(page)
+	return options
+}
@@ -0,0 +1,154 @@
+package tmdb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+)
+
+func (suite *TMBDTestSuite) newPagesServer(totalPages int, failPage string, hits *int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(hits, 1)
+		page := r.URL.Query().Get("page")
+		if page == failPage {
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte(`{"status_code":11,"status_message":"Internal error.","success":false}`))
+			return
+		}
+		n, _ := strconv.Atoi(page)
+		fmt.Fprintf(
+			w,
+			`{"page":%d,"total_pages":%d,"total_results":%d,"results":[{"id":%d},{"id":%d}]}`,
+			n, totalPages, totalPages*2, n*10+1, n*10+2,
+		)
+	}))
+}
+
+func searchMoviesFetcher(c *Client) PageFetcher[*SearchMovies] {
+	return func(ctx context.Context, page int) (*SearchMovies, error) {
+		return c.GetSearchMoviesWithContext(ctx, "Jack Reacher", SearchOptions(Page(page)))
+	}
+}
+
+func searchMoviesResults(page *SearchMovies) []MovieResult {
+	return page.Results
+}
+
+func (suite *TMBDTestSuite) TestPaginate() {
+	var hits int32
+	ts := suite.newPagesServer(3, "", &hits)
+	defer ts.Close()
+	c, _ := Init(apiKey)
+	c.SetCustomBaseURL(ts.URL)
+	var ids []int64
+	for movie, err := range Paginate(
+		context.Background(),
+		searchMoviesFetcher(c),
+		searchMoviesResults,
+		PaginateOptions{},
+	) {
+		suite.Nil(err)
+		ids = append(ids, movie.ID)
+	}
+	suite.Equal([]int64{11, 12, 21, 22, 31, 32}, ids)
+	suite.Equal(int32(3), hits)
+}
+
+func (suite *TMBDTestSuite) TestPaginateLimits() {
+	var hits int32
+	ts := suite.newPagesServer(10, "", &hits)
+	defer ts.Close()
+	c, _ := Init(apiKey)
+	c.SetCustomBaseURL(ts.URL)
+	count := 0
+	for _, err := range Paginate(
+		context.Background(),
+		searchMoviesFetcher(c),
+		searchMoviesResults,
+		PaginateOptions{MaxItems: 3},
+	) {
+		suite.Nil(err)
+		count++
+	}
+	suite.Equal(3, count)
+	suite.Equal(int32(2), hits)
+
+	var pages []int64
+	for page, err := range PaginatePages(
+		context.Background(),
+		searchMoviesFetcher(c),
+		PaginateOptions{StartPage: 4, MaxPages: 2},
+	) {
+		suite.Nil(err)
+		pages = append(pages, page.Page)
+	}
+	suite.Equal([]int64{4, 5}, pages)
+}
+
+func (suite *TMBDTestSuite) TestPaginateErrors() {
+	var hits int32
+	ts := suite.newPagesServer(3, "2", &hits)
+	defer ts.Close()
+	c, _ := Init(apiKey)
+	c.SetCustomBaseURL(ts.URL)
+	var ids []int64
+	errs := 0
+	for movie, err := range Paginate(
+		context.Background(),
+		searchMoviesFetcher(c),
+		searchMoviesResults,
+		PaginateOptions{},
+	) {
+		if err != nil {
+			errs++
+			continue
+		}
+		ids = append(ids, movie.ID)
+	}
+	suite.Equal(1, errs)
+	suite.Equal([]int64{11, 12, 31, 32}, ids)
+
+	// The first page failing leaves the total unknown.
+	hits = 0
+	errs = 0
+	ts1 := suite.newPagesServer(3, "1", &hits)
+	defer ts1.Close()
+	c.SetCustomBaseURL(ts1.URL)
+	for _, err := range Paginate(
+		context.Background(),
+		searchMoviesFetcher(c),
+		searchMoviesResults,
+		PaginateOptions{},
+	) {
+		suite.Error(err)
+		errs++
+	}
+	suite.Equal(1, errs)
+	suite.Equal(int32(1), hits)
+}
+
+func (suite *TMBDTestSuite) TestPaginateContextCanceled() {
+	var hits int32
+	ts := suite.newPagesServer(10, "", &hits)
+	defer ts.Close()
+	c, _ := Init(apiKey)
+	c.SetCustomBaseURL(ts.URL)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var last error
+	for movie, err := range Paginate(ctx, searchMoviesFetcher(c), searchMoviesResults, PaginateOptions{}) {
+		if err != nil {
+			last = err
+			break
+		}
+		if movie.ID == 22 {
+			cancel()
+		}
+	}
+	suite.True(errors.Is(last, context.Canceled))
+	suite.Equal(int32(2), hits)
+}
@@ -1,6 +1,9 @@
 package tmdb
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 // PersonDetails type is a struct for details JSON response.
 type PersonDetails struct {
@@ -77,18 +80,28 @@ type PersonTranslationsAppend struct {
 func (c *Client) GetPersonDetails(
 	id int,
 	urlOptions map[string]string,
+) (*PersonDetails, error) {
+	return c.GetPersonDetailsWithContext(context.Background(), id, urlOptions)
+}
+
+// GetPersonDetailsWithContext is like GetPersonDetails but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetPersonDetailsWithContext(
+	ctx context.Context,
+	id int,
+	urlOptions map[string]string,
 ) (*PersonDetails, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%s%d?api_key=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		personURL,
 		id,
 		c.apiKey,
 		options,
 	)
 	personDetails := PersonDetails{}
-	if err := c.get(tmdbURL, &personDetails); err != nil {
+	if err := c.get(ctx, "GetPersonDetails", tmdbURL, &personDetails); err != nil {
 		return nil, err
 	}
 	return &personDetails, nil
@@ -96,17 +109,7 @@ func (c *Client) GetPersonDetails(
 
 // PersonChanges type is a struct for changes JSON response.
 type PersonChanges struct {
-	Changes []struct {
-		Key   string `json:"key"`
-		Items []struct {
-			ID            string `json:"id"`
-			Action        string `json:"action"`
-			Time          string `json:"time"`
-			Iso639_1      string `json:"iso_639_1"`
-			Iso3166_1     string `json:"iso_3166_1"`
-			OriginalValue string `json:"original_value"`
-		} `json:"items"`
-	} `json:"changes"`
+	Changes []Change `json:"changes"`
 }
 
 // GetPersonChanges get the changes for a person.
@@ -119,18 +122,28 @@ type PersonChanges struct {
 func (c *Client) GetPersonChanges(
 	id int,
 	urlOptions map[string]string,
+) (*PersonChanges, error) {
+	return c.GetPersonChangesWithContext(context.Background(), id, urlOptions)
+}
+
+// GetPersonChangesWithContext is like GetPersonChanges but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetPersonChangesWithContext(
+	ctx context.Context,
+	id int,
+	urlOptions map[string]string,
 ) (*PersonChanges, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%s%d/changes?api_key=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		personURL,
 		id,
 		c.apiKey,
 		options,
 	)
 	personChanges := PersonChanges{}
-	err := c.get(tmdbURL, &personChanges)
+	err := c.get(ctx, "GetPersonChanges", tmdbURL, &personChanges)
 	if err != nil {
 		return nil, err
 	}
@@ -184,18 +197,28 @@ type PersonMovieCredits struct {
 func (c *Client) GetPersonMovieCredits(
 	id int,
 	urlOptions map[string]string,
+) (*PersonMovieCredits, error) {
+	return c.GetPersonMovieCreditsWithContext(context.Background(), id, urlOptions)
+}
+
+// GetPersonMovieCreditsWithContext is like GetPersonMovieCredits but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetPersonMovieCreditsWithContext(
+	ctx context.Context,
+	id int,
+	urlOptions map[string]string,
 ) (*PersonMovieCredits, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%s%d/movie_credits?api_key=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		personURL,
 		id,
 		c.apiKey,
 		options,
 	)
 	personMovieCredits := PersonMovieCredits{}
-	if err := c.get(tmdbURL, &personMovieCredits); err != nil {
+	if err := c.get(ctx, "GetPersonMovieCredits", tmdbURL, &personMovieCredits); err != nil {
 		return nil, err
 	}
 	return &personMovieCredits, nil
@@ -247,18 +270,28 @@ type PersonTVCredits struct {
 func (c *Client) GetPersonTVCredits(
 	id int,
 	urlOptions map[string]string,
+) (*PersonTVCredits, error) {
+	return c.GetPersonTVCreditsWithContext(context.Background(), id, urlOptions)
+}
+
+// GetPersonTVCreditsWithContext is like GetPersonTVCredits but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetPersonTVCreditsWithContext(
+	ctx context.Context,
+	id int,
+	urlOptions map[string]string,
 ) (*PersonTVCredits, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%s%d/tv_credits?api_key=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		personURL,
 		id,
 		c.apiKey,
 		options,
 	)
 	personTVCredits := PersonTVCredits{}
-	if err := c.get(tmdbURL, &personTVCredits); err != nil {
+	if err := c.get(ctx, "GetPersonTVCredits", tmdbURL, &personTVCredits); err != nil {
 		return nil, err
 	}
 	return &personTVCredits, nil
@@ -317,18 +350,32 @@ type PersonCombinedCredits struct {
 func (c *Client) GetPersonCombinedCredits(
 	id int,
 	urlOptions map[string]string,
+) (*PersonCombinedCredits, error) {
+	return c.GetPersonCombinedCreditsWithContext(
+		context.Background(),
+		id,
+		urlOptions,
+	)
+}
+
+// GetPersonCombinedCreditsWithContext is like GetPersonCombinedCredits but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetPersonCombinedCreditsWithContext(
+	ctx context.Context,
+	id int,
+	urlOptions map[string]string,
 ) (*PersonCombinedCredits, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%s%d/combined_credits?api_key=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		personURL,
 		id,
 		c.apiKey,
 		options,
 	)
 	personCombinedCredits := PersonCombinedCredits{}
-	if err := c.get(tmdbURL, &personCombinedCredits); err != nil {
+	if err := c.get(ctx, "GetPersonCombinedCredits", tmdbURL, &personCombinedCredits); err != nil {
 		return nil, err
 	}
 	return &personCombinedCredits, nil
@@ -356,18 +403,28 @@ type PersonExternalIDs struct {
 func (c *Client) GetPersonExternalIDs(
 	id int,
 	urlOptions map[string]string,
+) (*PersonExternalIDs, error) {
+	return c.GetPersonExternalIDsWithContext(context.Background(), id, urlOptions)
+}
+
+// GetPersonExternalIDsWithContext is like GetPersonExternalIDs but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetPersonExternalIDsWithContext(
+	ctx context.Context,
+	id int,
+	urlOptions map[string]string,
 ) (*PersonExternalIDs, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%s%d/external_ids?api_key=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		personURL,
 		id,
 		c.apiKey,
 		options,
 	)
 	personExternalIDS := PersonExternalIDs{}
-	if err := c.get(tmdbURL, &personExternalIDS); err != nil {
+	if err := c.get(ctx, "GetPersonExternalIDs", tmdbURL, &personExternalIDS); err != nil {
 		return nil, err
 	}
 	return &personExternalIDS, nil
@@ -390,16 +447,25 @@ type PersonImages struct {
 // https://developers.themoviedb.org/3/people/get-person-images
 func (c *Client) GetPersonImages(
 	id int,
+) (*PersonImages, error) {
+	return c.GetPersonImagesWithContext(context.Background(), id)
+}
+
+// GetPersonImagesWithContext is like GetPersonImages but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetPersonImagesWithContext(
+	ctx context.Context,
+	id int,
 ) (*PersonImages, error) {
 	tmdbURL := fmt.Sprintf(
 		"%s%s%d/images?api_key=%s",
-		baseURL,
+		c.GetBaseURL(),
 		personURL,
 		id,
 		c.apiKey,
 	)
 	personImages := PersonImages{}
-	if err := c.get(tmdbURL, &personImages); err != nil {
+	if err := c.get(ctx, "GetPersonImages", tmdbURL, &personImages); err != nil {
 		return nil, err
 	}
 	return &personImages, nil
@@ -417,18 +483,28 @@ type PersonTranslations struct {
 func (c *Client) GetPersonTranslations(
 	id int,
 	urlOptions map[string]string,
+) (*PersonTranslations, error) {
+	return c.GetPersonTranslationsWithContext(context.Background(), id, urlOptions)
+}
+
+// GetPersonTranslationsWithContext is like GetPersonTranslations but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetPersonTranslationsWithContext(
+	ctx context.Context,
+	id int,
+	urlOptions map[string]string,
 ) (*PersonTranslations, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%s%d/translations?api_key=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		personURL,
 		id,
 		c.apiKey,
 		options,
 	)
 	personTranslations := PersonTranslations{}
-	if err := c.get(tmdbURL, &personTranslations); err != nil {
+	if err := c.get(ctx, "GetPersonTranslations", tmdbURL, &personTranslations); err != nil {
 		return nil, err
 	}
 	return &personTranslations, nil
@@ -457,17 +533,26 @@ type PersonLatest struct {
 // https://developers.themoviedb.org/3/people/get-latest-person
 func (c *Client) GetPersonLatest(
 	urlOptions map[string]string,
+) (*PersonLatest, error) {
+	return c.GetPersonLatestWithContext(context.Background(), urlOptions)
+}
+
+// GetPersonLatestWithContext is like GetPersonLatest but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetPersonLatestWithContext(
+	ctx context.Context,
+	urlOptions map[string]string,
 ) (*PersonLatest, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%slatest?api_key=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		personURL,
 		c.apiKey,
 		options,
 	)
 	personLatest := PersonLatest{}
-	if err := c.get(tmdbURL, &personLatest); err != nil {
+	if err := c.get(ctx, "GetPersonLatest", tmdbURL, &personLatest); err != nil {
 		return nil, err
 	}
 	return &personLatest, nil
@@ -509,17 +594,26 @@ type PersonPopular struct {
 // https://developers.themoviedb.org/3/people/get-popular-people
 func (c *Client) GetPersonPopular(
 	urlOptions map[string]string,
+) (*PersonPopular, error) {
+	return c.GetPersonPopularWithContext(context.Background(), urlOptions)
+}
+
+// GetPersonPopularWithContext is like GetPersonPopular but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetPersonPopularWithContext(
+	ctx context.Context,
+	urlOptions map[string]string,
 ) (*PersonPopular, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%spopular?api_key=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		personURL,
 		c.apiKey,
 		options,
 	)
 	personPopular := PersonPopular{}
-	if err := c.get(tmdbURL, &personPopular); err != nil {
+	if err := c.get(ctx, "GetPersonPopular", tmdbURL, &personPopular); err != nil {
 		return nil, err
 	}
 	return &personPopular, nil
@@ -1,6 +1,9 @@
 package tmdb
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 // WatchRegionList type is a struct for watch region list JSON response.
 type WatchRegionList struct {
@@ -29,17 +32,29 @@ type WatchProviderList struct {
 // https://developers.themoviedb.org/3/watch-providers/get-available-regions
 func (c *Client) GetAvailableWatchProviderRegions(
 	urlOptions map[string]string,
+) (*WatchRegionList, error) {
+	return c.GetAvailableWatchProviderRegionsWithContext(
+		context.Background(),
+		urlOptions,
+	)
+}
+
+// GetAvailableWatchProviderRegionsWithContext is like GetAvailableWatchProviderRegions but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetAvailableWatchProviderRegionsWithContext(
+	ctx context.Context,
+	urlOptions map[string]string,
 ) (*WatchRegionList, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%sregions?api_key=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		watchProvidersURL,
 		c.apiKey,
 		options,
 	)
 	watchRegionList := WatchRegionList{}
-	if err := c.get(tmdbURL, &watchRegionList); err != nil {
+	if err := c.get(ctx, "GetAvailableWatchProviderRegions", tmdbURL, &watchRegionList); err != nil {
 		return nil, err
 	}
 	return &watchRegionList, nil
@@ -51,17 +66,26 @@ func (c *Client) GetAvailableWatchProviderRegions(
 // https://developers.themoviedb.org/3/watch-providers/get-movie-providers
 func (c *Client) GetWatchProvidersMovie(
 	urlOptions map[string]string,
+) (*WatchProviderList, error) {
+	return c.GetWatchProvidersMovieWithContext(context.Background(), urlOptions)
+}
+
+// GetWatchProvidersMovieWithContext is like GetWatchProvidersMovie but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetWatchProvidersMovieWithContext(
+	ctx context.Context,
+	urlOptions map[string]string,
 ) (*WatchProviderList, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%smovie?api_key=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		watchProvidersURL,
 		c.apiKey,
 		options,
 	)
 	watchProvider := WatchProviderList{}
-	if err := c.get(tmdbURL, &watchProvider); err != nil {
+	if err := c.get(ctx, "GetWatchProvidersMovie", tmdbURL, &watchProvider); err != nil {
 		return nil, err
 	}
 	return &watchProvider, nil
@@ -73,17 +97,26 @@ func (c *Client) GetWatchProvidersMovie(
 // https://developers.themoviedb.org/3/watch-providers/get-tv-providers
 func (c *Client) GetWatchProvidersTv(
 	urlOptions map[string]string,
+) (*WatchProviderList, error) {
+	return c.GetWatchProvidersTvWithContext(context.Background(), urlOptions)
+}
+
+// GetWatchProvidersTvWithContext is like GetWatchProvidersTv but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetWatchProvidersTvWithContext(
+	ctx context.Context,
+	urlOptions map[string]string,
 ) (*WatchProviderList, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%stv?api_key=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		watchProvidersURL,
 		c.apiKey,
 		options,
 	)
 	watchProvider := WatchProviderList{}
-	if err := c.get(tmdbURL, &watchProvider); err != nil {
+	if err := c.get(ctx, "GetWatchProvidersTv", tmdbURL, &watchProvider); err != nil {
 		return nil, err
 	}
 	return &watchProvider, nil
@@ -0,0 +1,163 @@
+package tmdb
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// RateLimiter type is a token bucket limiter that paces the
+// requests of one or more clients.
+//
+// A RateLimiter is safe for concurrent use by multiple goroutines.
+type RateLimiter struct {
+	mu sync.Mutex
+	// rate is the number of tokens added per second.
+	rate float64
+	// burst is the maximum number of tokens in the bucket.
+	burst float64
+	// tokens is the number of available tokens, negative
+	// when callers are queued waiting for a token.
+	tokens float64
+	// last is the time tokens was last updated. It may be
+	// in the future while the limiter is paused.
+	last  time.Time
+	stats RateLimiterStats
+}
+
+// RateLimiterStats type is a struct for the limiter statistics.
+type RateLimiterStats struct {
+	// Requests is the number of tokens handed out.
+	Requests int64
+	// Waits is the number of requests that had to wait.
+	Waits int64
+	// TotalWait is the sum of the time callers waited.
+	TotalWait time.Duration
+	// MaxWait is the longest time a single caller waited.
+	MaxWait time.Duration
+}
+
+// NewRateLimiter creates a token bucket limiter allowing
+// requestsPerSecond requests on average with bursts of up to
+// burst requests.
+func NewRateLimiter(requestsPerSecond float64, burst int) (*RateLimiter, error) {
+	if requestsPerSecond <= 0 {
+		return nil, errors.New("requests per second must be greater than zero")
+	}
+	if burst < 1 {
+		return nil, errors.New("burst must be greater than zero")
+	}
+	return &RateLimiter{
+		rate:   requestsPerSecond,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}, nil
+}
+
+// Wait blocks until a token is available or the context is done.
+// A nil RateLimiter never blocks.
+func (l *RateLimiter) Wait(ctx context.Context) error {
+	if l == nil {
+		return nil
+	}
+	wait := l.reserve(time.Now())
+	if wait <= 0 {
+		return nil
+	}
+	start := time.Now()
+	err := sleepContext(ctx, wait)
+	l.record(time.Since(start), err != nil)
+	return err
+}
+
+// Pause stops handing out tokens for the duration d. The client
+// calls it when the TMDb API answers with 429 so that every
+// goroutine backs off, not only the one that got rate limited.
+func (l *RateLimiter) Pause(d time.Duration) {
+	if l == nil {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	now := time.Now()
+	l.advance(now)
+	until := now.Add(d)
+	if until.After(l.last) {
+		l.tokens = min(l.tokens, 0)
+		l.last = until
+	}
+}
+
+// Stats returns a snapshot of the limiter statistics.
+func (l *RateLimiter) Stats() RateLimiterStats {
+	if l == nil {
+		return RateLimiterStats{}
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.stats
+}
+
+// advance refills the bucket with the tokens earned until now.
+func (l *RateLimiter) advance(now time.Time) {
+	if now.After(l.last) {
+		elapsed := now.Sub(l.last).Seconds()
+		l.tokens = min(l.burst, l.tokens+elapsed*l.rate)
+		l.last = now
+	}
+}
+
+// reserve takes a token and returns how long the caller
+// must wait before using it.
+func (l *RateLimiter) reserve(now time.Time) time.Duration {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.advance(now)
+	l.tokens--
+	l.stats.Requests++
+	ready := l.last
+	if l.tokens < 0 {
+		deficit := -l.tokens / l.rate
+		ready = ready.Add(time.Duration(deficit * float64(time.Second)))
+	}
+	return ready.Sub(now)
+}
+
+// record updates the statistics after a caller waited,
+// returning the token if the wait was interrupted.
+func (l *RateLimiter) record(waited time.Duration, canceled bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if canceled {
+		l.tokens++
+		l.stats.Requests--
+	}
+	l.stats.Waits++
+	l.stats.TotalWait += waited
+	l.stats.MaxWait = max(l.stats.MaxWait, waited)
+}
+
+// SetClientRateLimit enables a client-side token bucket limiter
+// allowing requestsPerSecond requests on average with bursts of
+// up to burst requests.
+func (c *Client) SetClientRateLimit(requestsPerSecond float64, burst int) error {
+	limiter, err := NewRateLimiter(requestsPerSecond, burst)
+	if err != nil {
+		return err
+	}
+	c.rateLimiter = limiter
+	return nil
+}
+
+// SetRateLimiter sets the limiter used by the client. The same
+// limiter can be shared by several clients using the same api key.
+func (c *Client) SetRateLimiter(limiter *RateLimiter) {
+	c.rateLimiter = limiter
+}
+
+// GetRateLimiterStats gets the statistics of the client limiter.
+func (c *Client) GetRateLimiterStats() RateLimiterStats {
+	return c.rateLimiter.Stats()
+}
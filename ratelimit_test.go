@@ -0,0 +1,89 @@
+package tmdb
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+func (suite *TMBDTestSuite) TestNewRateLimiterFail() {
+	_, err := NewRateLimiter(0, 1)
+	suite.EqualError(err, "requests per second must be greater than zero")
+	_, err = NewRateLimiter(10, 0)
+	suite.EqualError(err, "burst must be greater than zero")
+	suite.Error(suite.client.SetClientRateLimit(-1, 1))
+}
+
+func (suite *TMBDTestSuite) TestRateLimiterPacing() {
+	limiter, err := NewRateLimiter(20, 2)
+	suite.Nil(err)
+	start := time.Now()
+	var wg sync.WaitGroup
+	for range 6 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			suite.Nil(limiter.Wait(context.Background()))
+		}()
+	}
+	wg.Wait()
+	// 2 tokens of burst, the 4 remaining at 50ms each.
+	suite.GreaterOrEqual(time.Since(start), time.Millisecond*190)
+	stats := limiter.Stats()
+	suite.Equal(int64(6), stats.Requests)
+	suite.Equal(int64(4), stats.Waits)
+	suite.Greater(stats.TotalWait, time.Duration(0))
+	suite.GreaterOrEqual(stats.MaxWait, time.Millisecond*190)
+}
+
+func (suite *TMBDTestSuite) TestRateLimiterWaitCanceled() {
+	limiter, _ := NewRateLimiter(1, 1)
+	suite.Nil(limiter.Wait(context.Background()))
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*10)
+	defer cancel()
+	suite.ErrorIs(limiter.Wait(ctx), context.DeadlineExceeded)
+	suite.Equal(int64(1), limiter.Stats().Requests)
+}
+
+func (suite *TMBDTestSuite) TestRateLimiterPause() {
+	limiter, _ := NewRateLimiter(1000, 10)
+	limiter.Pause(time.Millisecond * 100)
+	start := time.Now()
+	suite.Nil(limiter.Wait(context.Background()))
+	suite.GreaterOrEqual(time.Since(start), time.Millisecond*90)
+}
+
+func (suite *TMBDTestSuite) TestNilRateLimiter() {
+	var limiter *RateLimiter
+	suite.Nil(limiter.Wait(context.Background()))
+	limiter.Pause(time.Second)
+	suite.Equal(RateLimiterStats{}, limiter.Stats())
+}
+
+func (suite *TMBDTestSuite) TestClientRateLimit() {
+	var hits int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&hits, 1) == 1 {
+			w.Header().Set("Retry-After", "0")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.Write([]byte(`{"id":424783}`))
+	}))
+	defer ts.Close()
+	c, _ := Init(apiKey)
+	c.SetCustomBaseURL(ts.URL)
+	c.SetClientAutoRetry()
+	suite.Nil(c.SetClientRateLimit(50, 1))
+	for range 3 {
+		_, err := c.GetMovieDetails(bumblebeeID, nil)
+		suite.Nil(err)
+	}
+	stats := c.GetRateLimiterStats()
+	suite.Equal(int64(4), stats.Requests)
+	suite.Greater(stats.Waits, int64(0))
+	suite.Equal(int32(4), atomic.LoadInt32(&hits))
+}
@@ -34,23 +34,29 @@ type MovieResult struct {
 	VoteMetrics
 }
 
+// AccountMovieResult represents a movie of the account favorite,
+// rated and watchlist lists.
+type AccountMovieResult struct {
+	Adult            bool    `json:"adult"`
+	BackdropPath     string  `json:"backdrop_path"`
+	GenreIDs         []int   `json:"genre_ids"`
+	ID               int64   `json:"id"`
+	OriginalLanguage string  `json:"original_language"`
+	OriginalTitle    string  `json:"original_title"`
+	Overview         string  `json:"overview"`
+	ReleaseDate      string  `json:"release_date"`
+	PosterPath       string  `json:"poster_path"`
+	Popularity       float64 `json:"popularity"`
+	Title            string  `json:"title"`
+	Video            bool    `json:"video"`
+	// Rating is only set by the rated movies.
+	Rating RatedValue `json:"rating"`
+	VoteMetrics
+}
+
 // AccountFavoriteMoviesResults Result Types
 type AccountFavoriteMoviesResults struct {
-	Results []struct {
-		Adult            bool    `json:"adult"`
-		BackdropPath     string  `json:"backdrop_path"`
-		GenreIDs         []int   `json:"genre_ids"`
-		ID               int64   `json:"id"`
-		OriginalLanguage string  `json:"original_language"`
-		OriginalTitle    string  `json:"original_title"`
-		Overview         string  `json:"overview"`
-		ReleaseDate      string  `json:"release_date"`
-		PosterPath       string  `json:"poster_path"`
-		Popularity       float64 `json:"popularity"`
-		Title            string  `json:"title"`
-		Video            bool    `json:"video"`
-		VoteMetrics
-	} `json:"results"`
+	Results []AccountMovieResult `json:"results"`
 }
 
 // TVShowResult represents the details of a TV show as returned by the TMDB API.
@@ -73,47 +79,57 @@ type TVShowResult struct {
 	VoteMetrics
 }
 
+// AccountTVShowResult represents a TV show of the account favorite,
+// rated and watchlist lists.
+type AccountTVShowResult struct {
+	BackdropPath     string   `json:"backdrop_path"`
+	FirstAirDate     string   `json:"first_air_date"`
+	GenreIDs         []int64  `json:"genre_ids"`
+	ID               int64    `json:"id"`
+	OriginalLanguage string   `json:"original_language"`
+	OriginalName     string   `json:"original_name"`
+	Overview         string   `json:"overview"`
+	OriginCountry    []string `json:"origin_country"`
+	PosterPath       string   `json:"poster_path"`
+	Popularity       float64  `json:"popularity"`
+	Name             string   `json:"name"`
+	// Rating is only set by the rated TV shows.
+	Rating RatedValue `json:"rating"`
+	VoteMetrics
+}
+
 // AccountFavoriteTVShowsResults Result Types
 type AccountFavoriteTVShowsResults struct {
-	Results []struct {
-		BackdropPath     string   `json:"backdrop_path"`
-		FirstAirDate     string   `json:"first_air_date"`
-		GenreIDs         []int64  `json:"genre_ids"`
-		ID               int64    `json:"id"`
-		OriginalLanguage string   `json:"original_language"`
-		OriginalName     string   `json:"original_name"`
-		Overview         string   `json:"overview"`
-		OriginCountry    []string `json:"origin_country"`
-		PosterPath       string   `json:"poster_path"`
-		Popularity       float64  `json:"popularity"`
-		Name             string   `json:"name"`
-		VoteMetrics
-	} `json:"results"`
+	Results []AccountTVShowResult `json:"results"`
 }
 
 // AccountRatedTVEpisodesResults Result Types
 type AccountRatedTVEpisodesResults struct {
 	Results []struct {
-		AirDate        string  `json:"air_date"`
-		EpisodeNumber  int     `json:"episode_number"`
-		ID             int64   `json:"id"`
-		Name           string  `json:"name"`
-		Overview       string  `json:"overview"`
-		ProductionCode string  `json:"production_code"`
-		SeasonNumber   int     `json:"season_number"`
-		ShowID         int64   `json:"show_id"`
-		StillPath      string  `json:"still_path"`
-		Rating         float32 `json:"rating"`
+		AirDate        string     `json:"air_date"`
+		EpisodeNumber  int        `json:"episode_number"`
+		ID             int64      `json:"id"`
+		Name           string     `json:"name"`
+		Overview       string     `json:"overview"`
+		ProductionCode string     `json:"production_code"`
+		SeasonNumber   int        `json:"season_number"`
+		ShowID         int64      `json:"show_id"`
+		StillPath      string     `json:"still_path"`
+		Rating         RatedValue `json:"rating"`
 		VoteMetrics
 	} `json:"results"`
 }
 
+// ChangesResult represents an id of the movie, TV
+// or person change lists.
+type ChangesResult struct {
+	ID    int64 `json:"id"`
+	Adult bool  `json:"adult"`
+}
+
 // ChangesMovieResults Result Types
 type ChangesMovieResults struct {
-	Results []struct {
-		ID    int64 `json:"id"`
-		Adult bool  `json:"adult"`
-	} `json:"results"`
+	Results []ChangesResult `json:"results"`
 }
 
 // CompanyAlternativeNamesResult Result Types
@@ -250,47 +266,51 @@ type SearchTVShowsResults struct {
 	Results []TVShowResult `json:"results"`
 }
 
+// TrendingResult represents a movie, TV show or
+// person of the trending lists.
+type TrendingResult struct {
+	Adult              bool     `json:"adult,omitempty"`
+	Gender             int      `json:"gender,omitempty"`
+	BackdropPath       string   `json:"backdrop_path,omitempty"`
+	GenreIDs           []int64  `json:"genre_ids,omitempty"`
+	ID                 int64    `json:"id"`
+	OriginalLanguage   string   `json:"original_language"`
+	OriginalTitle      string   `json:"original_title,omitempty"`
+	Overview           string   `json:"overview,omitempty"`
+	PosterPath         string   `json:"poster_path,omitempty"`
+	ReleaseDate        string   `json:"release_date,omitempty"`
+	Title              string   `json:"title,omitempty"`
+	Video              bool     `json:"video,omitempty"`
+	Popularity         float32  `json:"popularity,omitempty"`
+	FirstAirDate       string   `json:"first_air_date,omitempty"`
+	Name               string   `json:"name,omitempty"`
+	OriginCountry      []string `json:"origin_country,omitempty"`
+	OriginalName       string   `json:"original_name,omitempty"`
+	KnownForDepartment string   `json:"known_for_department,omitempty"`
+	ProfilePath        string   `json:"profile_path,omitempty"`
+	MediaType          string   `json:"media_type,omitempty"`
+	KnownFor           []struct {
+		Adult            bool    `json:"adult"`
+		BackdropPath     string  `json:"backdrop_path"`
+		GenreIds         []int   `json:"genre_ids"`
+		ID               int     `json:"id"`
+		OriginalLanguage string  `json:"original_language"`
+		OriginalTitle    string  `json:"original_title"`
+		Overview         string  `json:"overview"`
+		PosterPath       string  `json:"poster_path"`
+		ReleaseDate      string  `json:"release_date"`
+		Title            string  `json:"title"`
+		Video            bool    `json:"video"`
+		Popularity       float64 `json:"popularity"`
+		MediaType        string  `json:"media_type"`
+		VoteMetrics
+	} `json:"known_for,omitempty"`
+	VoteMetrics
+}
+
 // TrendingResults Result Types
 type TrendingResults struct {
-	Results []struct {
-		Adult              bool     `json:"adult,omitempty"`
-		Gender             int      `json:"gender,omitempty"`
-		BackdropPath       string   `json:"backdrop_path,omitempty"`
-		GenreIDs           []int64  `json:"genre_ids,omitempty"`
-		ID                 int64    `json:"id"`
-		OriginalLanguage   string   `json:"original_language"`
-		OriginalTitle      string   `json:"original_title,omitempty"`
-		Overview           string   `json:"overview,omitempty"`
-		PosterPath         string   `json:"poster_path,omitempty"`
-		ReleaseDate        string   `json:"release_date,omitempty"`
-		Title              string   `json:"title,omitempty"`
-		Video              bool     `json:"video,omitempty"`
-		Popularity         float32  `json:"popularity,omitempty"`
-		FirstAirDate       string   `json:"first_air_date,omitempty"`
-		Name               string   `json:"name,omitempty"`
-		OriginCountry      []string `json:"origin_country,omitempty"`
-		OriginalName       string   `json:"original_name,omitempty"`
-		KnownForDepartment string   `json:"known_for_department,omitempty"`
-		ProfilePath        string   `json:"profile_path,omitempty"`
-		MediaType          string   `json:"media_type,omitempty"`
-		KnownFor           []struct {
-			Adult            bool    `json:"adult"`
-			BackdropPath     string  `json:"backdrop_path"`
-			GenreIds         []int   `json:"genre_ids"`
-			ID               int     `json:"id"`
-			OriginalLanguage string  `json:"original_language"`
-			OriginalTitle    string  `json:"original_title"`
-			Overview         string  `json:"overview"`
-			PosterPath       string  `json:"poster_path"`
-			ReleaseDate      string  `json:"release_date"`
-			Title            string  `json:"title"`
-			Video            bool    `json:"video"`
-			Popularity       float64 `json:"popularity"`
-			MediaType        string  `json:"media_type"`
-			VoteMetrics
-		} `json:"known_for,omitempty"`
-		VoteMetrics
-	} `json:"results"`
+	Results []TrendingResult `json:"results"`
 }
 
 // MovieReleaseDatesResults Result Types
@@ -0,0 +1,252 @@
+package tmdb
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"slices"
+	"syscall"
+	"time"
+)
+
+// RetryPolicy type is a struct to configure how failed
+// requests are retried.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts,
+	// including the first one. Zero means no limit.
+	MaxAttempts int
+	// MaxElapsedTime is the maximum time spent retrying
+	// since the first attempt. Zero means no limit.
+	MaxElapsedTime time.Duration
+	// InitialBackoff is the wait before the first retry.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the wait between two attempts.
+	// Zero means no cap.
+	MaxBackoff time.Duration
+	// Multiplier grows the backoff after each attempt.
+	// Values lower than 1 are treated as 1.
+	Multiplier float64
+	// Jitter is the fraction, between 0 and 1, of the backoff
+	// that is randomized to spread retries of concurrent callers.
+	Jitter float64
+	// RetryableStatuses are the http status codes to retry.
+	// Errors with a documented TMDb status code that is not
+	// retryable, like an invalid id, are never retried.
+	RetryableStatuses []int
+	// RetryNetworkErrors retries transient network errors
+	// like timeouts and connection resets.
+	RetryNetworkErrors bool
+	// RetryNonIdempotent allows replaying non idempotent
+	// requests, like POST, after a 5xx or a network error,
+	// when the server may have already processed them.
+	RetryNonIdempotent bool
+}
+
+// DefaultRetryPolicy is a retry policy with exponential backoff
+// and jitter that retries rate limited requests, server errors
+// and transient network errors.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts:    5,
+	MaxElapsedTime: time.Second * 30,
+	InitialBackoff: time.Millisecond * 500,
+	MaxBackoff:     time.Second * 10,
+	Multiplier:     2,
+	Jitter:         0.5,
+	RetryableStatuses: []int{
+		http.StatusTooManyRequests,
+		http.StatusInternalServerError,
+		http.StatusBadGateway,
+		http.StatusServiceUnavailable,
+		http.StatusGatewayTimeout,
+	},
+	RetryNetworkErrors: true,
+}
+
+// autoRetryPolicy is the policy enabled by SetClientAutoRetry.
+// It retries rate limited requests for up to 10 seconds.
+var autoRetryPolicy = RetryPolicy{
+	MaxElapsedTime:    time.Second * 10,
+	InitialBackoff:    defaultRetryDuration,
+	RetryableStatuses: []int{http.StatusTooManyRequests},
+}
+
+// autoRetryWritePolicy is the policy enabled by SetClientAutoRetry
+// for the write requests, also retried while TMDb answers that
+// they were accepted but not processed yet.
+var autoRetryWritePolicy = RetryPolicy{
+	MaxElapsedTime: time.Second * 10,
+	InitialBackoff: defaultRetryDuration,
+	RetryableStatuses: []int{
+		http.StatusAccepted,
+		http.StatusTooManyRequests,
+	},
+}
+
+// SetRetryPolicy sets the retry policy used by the client.
+func (c *Client) SetRetryPolicy(policy RetryPolicy) {
+	c.retryPolicy = &policy
+}
+
+type retryPolicyKey struct{}
+
+// WithRetryPolicy returns a copy of ctx that overrides the client
+// retry policy for the calls made with it. Use the WithContext
+// variants of the client methods to pass it.
+func WithRetryPolicy(ctx context.Context, policy RetryPolicy) context.Context {
+	return context.WithValue(ctx, retryPolicyKey{}, policy)
+}
+
+// policyFor returns the retry policy for a call with the given
+// method, nil when the call must not be retried.
+func (c *Client) policyFor(ctx context.Context, method string) *RetryPolicy {
+	if policy, ok := ctx.Value(retryPolicyKey{}).(RetryPolicy); ok {
+		return &policy
+	}
+	if c.retryPolicy != nil {
+		return c.retryPolicy
+	}
+	if c.autoRetry && method == http.MethodGet {
+		return &autoRetryPolicy
+	}
+	if c.autoRetry {
+		return &autoRetryWritePolicy
+	}
+	return nil
+}
+
+// idempotent reports whether the method can be safely replayed.
+func idempotent(method string) bool {
+	switch method {
+	case http.MethodGet,
+		http.MethodHead,
+		http.MethodOptions,
+		http.MethodPut,
+		http.MethodDelete:
+		return true
+	}
+	return false
+}
+
+// retryable reports whether an attempt that ended
+// with res and err should be retried.
+func (p *RetryPolicy) retryable(method string, res *http.Response, err error) bool {
+	if res == nil {
+		return p.RetryNetworkErrors &&
+			(idempotent(method) || p.RetryNonIdempotent) &&
+			transientError(err)
+	}
+	if !slices.Contains(p.RetryableStatuses, res.StatusCode) {
+		return false
+	}
+	var apiErr *APIError
+	if errors.As(err, &apiErr) &&
+		apiErr.Code().Known() &&
+		!apiErr.Code().Retryable() {
+		return false
+	}
+	// A rate limited or accepted request was not processed
+	// yet, so it is safe to replay whatever the method is.
+	if res.StatusCode == http.StatusTooManyRequests ||
+		res.StatusCode == http.StatusAccepted {
+		return true
+	}
+	return idempotent(method) || p.RetryNonIdempotent
+}
+
+// backoff calculates the wait before the next attempt. The
+// Retry-After header, when present, takes precedence.
+func (p *RetryPolicy) backoff(attempt int, res *http.Response) time.Duration {
+	if res != nil {
+		if wait, ok := retryAfter(res); ok {
+			return wait
+		}
+	}
+	multiplier := max(p.Multiplier, 1)
+	wait := float64(p.InitialBackoff) * math.Pow(multiplier, float64(attempt-1))
+	if p.MaxBackoff > 0 {
+		wait = min(wait, float64(p.MaxBackoff))
+	}
+	if jitter := min(max(p.Jitter, 0), 1); jitter > 0 {
+		wait -= wait * jitter * rand.Float64()
+	}
+	return time.Duration(wait)
+}
+
+// transientError reports whether a network error is
+// likely to go away if the request is retried.
+func transientError(err error) bool {
+	if errors.Is(err, context.Canceled) ||
+		errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	var dnsErr *net.DNSError
+	if errors.As(err, &dnsErr) {
+		return dnsErr.IsTimeout || dnsErr.IsTemporary
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return true
+	}
+	return errors.Is(err, syscall.ECONNRESET) ||
+		errors.Is(err, syscall.ECONNREFUSED) ||
+		errors.Is(err, syscall.EPIPE) ||
+		errors.Is(err, io.ErrUnexpectedEOF) ||
+		errors.Is(err, io.EOF)
+}
+
+// roundTrip sends the request through the rate limiter and the
+// middleware chain, retrying it according to the retry policy.
+//
+// The response of the last attempt is returned along with its
+// error, the body of the discarded attempts is closed. When ctx
+// ends while waiting for a retry, the error of the last attempt
+// is returned joined with the context error.
+func (c *Client) roundTrip(
+	ctx context.Context,
+	endpoint string,
+	req *http.Request,
+) (*http.Response, error) {
+	policy := c.policyFor(ctx, req.Method)
+	start := time.Now()
+	var lastErr error
+	for attempt := 1; ; attempt++ {
+		if attempt > 1 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req.Body = body
+		}
+		if err := c.rateLimiter.Wait(ctx); err != nil {
+			return nil, errors.Join(lastErr, err)
+		}
+		res, err := c.send(endpoint, req)
+		if policy == nil ||
+			!policy.retryable(req.Method, res, err) ||
+			(policy.MaxAttempts > 0 && attempt >= policy.MaxAttempts) {
+			return res, err
+		}
+		if res == nil && ctx.Err() != nil {
+			return res, err
+		}
+		wait := policy.backoff(attempt, res)
+		if policy.MaxElapsedTime > 0 &&
+			time.Since(start)+wait > policy.MaxElapsedTime {
+			return res, err
+		}
+		if res != nil {
+			if res.StatusCode == http.StatusTooManyRequests {
+				c.rateLimiter.Pause(wait)
+			}
+			res.Body.Close()
+		}
+		lastErr = err
+		if err := sleepContext(ctx, wait); err != nil {
+			return nil, errors.Join(lastErr, err)
+		}
+	}
+}
@@ -0,0 +1,203 @@
+package tmdb
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"syscall"
+	"time"
+)
+
+var fastRetryPolicy = RetryPolicy{
+	MaxAttempts:       3,
+	InitialBackoff:    time.Millisecond,
+	RetryableStatuses: []int{http.StatusTooManyRequests, http.StatusInternalServerError},
+}
+
+func newFlakyServer(failures int32, status int, hits *int32, bodies *[]string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if bodies != nil {
+			b, _ := io.ReadAll(r.Body)
+			*bodies = append(*bodies, string(b))
+		}
+		if atomic.AddInt32(hits, 1) <= failures {
+			w.WriteHeader(status)
+			w.Write([]byte(`{"status_code":11,"status_message":"Internal error: Something went wrong, contact TMDb.","success":false}`))
+			return
+		}
+		w.Write([]byte(`{"status_code":1,"status_message":"Success."}`))
+	}))
+}
+
+func (suite *TMBDTestSuite) TestRetryPolicyServerError() {
+	var hits int32
+	ts := newFlakyServer(2, http.StatusInternalServerError, &hits, nil)
+	defer ts.Close()
+	c, _ := Init(apiKey)
+	c.SetCustomBaseURL(ts.URL)
+	c.SetRetryPolicy(fastRetryPolicy)
+	_, err := c.GetMovieDetails(bumblebeeID, nil)
+	suite.Nil(err)
+	suite.Equal(int32(3), hits)
+}
+
+func (suite *TMBDTestSuite) TestRetryPolicyMaxAttempts() {
+	var hits int32
+	ts := newFlakyServer(5, http.StatusInternalServerError, &hits, nil)
+	defer ts.Close()
+	c, _ := Init(apiKey)
+	c.SetCustomBaseURL(ts.URL)
+	c.SetRetryPolicy(fastRetryPolicy)
+	_, err := c.GetMovieDetails(bumblebeeID, nil)
+	var tmdbErr Error
+	suite.True(errors.As(err, &tmdbErr))
+	suite.Equal(11, tmdbErr.StatusCode)
+	suite.Equal(int32(3), hits)
+}
+
+func (suite *TMBDTestSuite) TestRetryPolicyMaxElapsedTime() {
+	var hits int32
+	ts := newFlakyServer(5, http.StatusInternalServerError, &hits, nil)
+	defer ts.Close()
+	c, _ := Init(apiKey)
+	c.SetCustomBaseURL(ts.URL)
+	policy := fastRetryPolicy
+	policy.MaxAttempts = 0
+	policy.InitialBackoff = time.Second
+	policy.MaxElapsedTime = time.Millisecond * 100
+	c.SetRetryPolicy(policy)
+	_, err := c.GetMovieDetails(bumblebeeID, nil)
+	suite.Error(err)
+	suite.Equal(int32(1), hits)
+}
+
+func (suite *TMBDTestSuite) TestRetryPolicyNonIdempotent() {
+	var hits int32
+	var bodies []string
+	ts := newFlakyServer(2, http.StatusInternalServerError, &hits, &bodies)
+	defer ts.Close()
+	c, _ := Init(apiKey)
+	c.SetCustomBaseURL(ts.URL)
+	c.SetRetryPolicy(fastRetryPolicy)
+	_, err := c.PostMovieRating(bumblebeeID, 7.5, nil)
+	suite.Error(err)
+	suite.Equal(int32(1), hits)
+
+	policy := fastRetryPolicy
+	policy.RetryNonIdempotent = true
+	ctx := WithRetryPolicy(context.Background(), policy)
+	_, err = c.PostMovieRatingWithContext(ctx, bumblebeeID, 7.5, nil)
+	suite.Nil(err)
+	suite.Equal(int32(3), hits)
+	suite.Equal(bodies[1], bodies[2])
+	suite.Contains(bodies[2], "7.5")
+}
+
+func (suite *TMBDTestSuite) TestRetryPolicyRateLimitedPost() {
+	var hits int32
+	var bodies []string
+	ts := newFlakyServer(1, http.StatusTooManyRequests, &hits, &bodies)
+	defer ts.Close()
+	c, _ := Init(apiKey)
+	c.SetCustomBaseURL(ts.URL)
+	c.SetRetryPolicy(fastRetryPolicy)
+	_, err := c.PostMovieRating(bumblebeeID, 7.5, nil)
+	suite.Nil(err)
+	suite.Equal(int32(2), hits)
+	suite.Equal(bodies[0], bodies[1])
+}
+
+func (suite *TMBDTestSuite) TestRetryPolicyPerCallOverride() {
+	var hits int32
+	ts := newFlakyServer(1, http.StatusInternalServerError, &hits, nil)
+	defer ts.Close()
+	c, _ := Init(apiKey)
+	c.SetCustomBaseURL(ts.URL)
+	c.SetRetryPolicy(fastRetryPolicy)
+	ctx := WithRetryPolicy(context.Background(), RetryPolicy{})
+	_, err := c.GetMovieDetailsWithContext(ctx, bumblebeeID, nil)
+	suite.Error(err)
+	suite.Equal(int32(1), hits)
+}
+
+func (suite *TMBDTestSuite) TestRetryPolicyContextDoneWhileWaiting() {
+	var hits int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		w.Header().Set("Retry-After", "4")
+		w.WriteHeader(http.StatusTooManyRequests)
+		w.Write([]byte(`{"status_code":25,"status_message":"Your request count (#) is over the allowed limit of (40).","success":false}`))
+	}))
+	defer ts.Close()
+	c, _ := Init(apiKey)
+	c.SetCustomBaseURL(ts.URL)
+	c.SetRetryPolicy(DefaultRetryPolicy)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*100)
+	defer cancel()
+	_, err := c.GetMovieDetailsWithContext(ctx, bumblebeeID, nil)
+	suite.ErrorIs(err, ErrRateLimited)
+	suite.ErrorIs(err, context.DeadlineExceeded)
+	var apiErr *APIError
+	suite.True(errors.As(err, &apiErr))
+	suite.Equal(time.Second*4, apiErr.RetryAfter)
+	suite.Equal(int32(1), hits)
+}
+
+func (suite *TMBDTestSuite) TestRetryPolicyTimeoutPerAttempt() {
+	var hits int32
+	ts := newFlakyServer(3, http.StatusInternalServerError, &hits, nil)
+	defer ts.Close()
+	c, _ := Init(apiKey)
+	c.SetCustomBaseURL(ts.URL)
+	c.SetClientConfig(http.Client{Timeout: time.Millisecond * 200})
+	policy := fastRetryPolicy
+	policy.MaxAttempts = 4
+	policy.InitialBackoff = time.Millisecond * 100
+	c.SetRetryPolicy(policy)
+	_, err := c.GetMovieDetails(bumblebeeID, nil)
+	suite.Nil(err)
+	suite.Equal(int32(4), hits)
+}
+
+func (suite *TMBDTestSuite) TestAutoRetryAccepted() {
+	var hits int32
+	ts := newFlakyServer(1, http.StatusAccepted, &hits, nil)
+	defer ts.Close()
+	c, _ := Init(apiKey)
+	c.SetCustomBaseURL(ts.URL)
+	c.SetClientAutoRetry()
+	_, err := c.GetMovieDetails(bumblebeeID, nil)
+	suite.Error(err)
+	suite.Equal(int32(1), hits)
+	suite.Same(&autoRetryPolicy, c.policyFor(context.Background(), http.MethodGet))
+	suite.Same(&autoRetryWritePolicy, c.policyFor(context.Background(), http.MethodPost))
+}
+
+func (suite *TMBDTestSuite) TestRetryPolicyBackoff() {
+	policy := RetryPolicy{
+		InitialBackoff: time.Second,
+		MaxBackoff:     time.Second * 5,
+		Multiplier:     2,
+	}
+	suite.Equal(time.Second, policy.backoff(1, nil))
+	suite.Equal(time.Second*4, policy.backoff(3, nil))
+	suite.Equal(time.Second*5, policy.backoff(10, nil))
+	res := &http.Response{Header: http.Header{"Retry-After": []string{"2"}}}
+	suite.Equal(time.Second*2, policy.backoff(10, res))
+	policy.Jitter = 0.5
+	for range 100 {
+		wait := policy.backoff(1, nil)
+		suite.GreaterOrEqual(wait, time.Millisecond*500)
+		suite.LessOrEqual(wait, time.Second)
+	}
+}
+
+func (suite *TMBDTestSuite) TestTransientError() {
+	suite.True(transientError(syscall.ECONNRESET))
+	suite.True(transientError(io.ErrUnexpectedEOF))
+	suite.False(transientError(context.Canceled))
+	suite.False(transientError(errors.New("no such host")))
+}
@@ -1,6 +1,9 @@
 package tmdb
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 // ReviewDetails type is a struct for details JSON response.
 type ReviewDetails struct {
@@ -27,15 +30,24 @@ type ReviewDetails struct {
 // https://developers.themoviedb.org/3/reviews/get-review-details
 func (c *Client) GetReviewDetails(
 	id string,
+) (*ReviewDetails, error) {
+	return c.GetReviewDetailsWithContext(context.Background(), id)
+}
+
+// GetReviewDetailsWithContext is like GetReviewDetails but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetReviewDetailsWithContext(
+	ctx context.Context,
+	id string,
 ) (*ReviewDetails, error) {
 	tmdbURL := fmt.Sprintf(
 		"%s/review/%s?api_key=%s",
-		baseURL,
+		c.GetBaseURL(),
 		id,
 		c.apiKey,
 	)
 	reviewDetails := ReviewDetails{}
-	if err := c.get(tmdbURL, &reviewDetails); err != nil {
+	if err := c.get(ctx, "GetReviewDetails", tmdbURL, &reviewDetails); err != nil {
 		return nil, err
 	}
 	return &reviewDetails, nil
@@ -1,6 +1,7 @@
 package tmdb
 
 import (
+	"context"
 	"fmt"
 	"net/url"
 )
@@ -17,18 +18,28 @@ type SearchCompanies struct {
 func (c *Client) GetSearchCompanies(
 	query string,
 	urlOptions map[string]string,
+) (*SearchCompanies, error) {
+	return c.GetSearchCompaniesWithContext(context.Background(), query, urlOptions)
+}
+
+// GetSearchCompaniesWithContext is like GetSearchCompanies but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetSearchCompaniesWithContext(
+	ctx context.Context,
+	query string,
+	urlOptions map[string]string,
 ) (*SearchCompanies, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%scompany?api_key=%s&query=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		searchURL,
 		c.apiKey,
 		url.QueryEscape(query),
 		options,
 	)
 	SearchCompanies := SearchCompanies{}
-	if err := c.get(tmdbURL, &SearchCompanies); err != nil {
+	if err := c.get(ctx, "GetSearchCompanies", tmdbURL, &SearchCompanies); err != nil {
 		return nil, err
 	}
 	return &SearchCompanies, nil
@@ -46,18 +57,32 @@ type SearchCollections struct {
 func (c *Client) GetSearchCollections(
 	query string,
 	urlOptions map[string]string,
+) (*SearchCollections, error) {
+	return c.GetSearchCollectionsWithContext(
+		context.Background(),
+		query,
+		urlOptions,
+	)
+}
+
+// GetSearchCollectionsWithContext is like GetSearchCollections but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetSearchCollectionsWithContext(
+	ctx context.Context,
+	query string,
+	urlOptions map[string]string,
 ) (*SearchCollections, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%scollection?api_key=%s&query=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		searchURL,
 		c.apiKey,
 		url.QueryEscape(query),
 		options,
 	)
 	searchCollections := SearchCollections{}
-	if err := c.get(tmdbURL, &searchCollections); err != nil {
+	if err := c.get(ctx, "GetSearchCollections", tmdbURL, &searchCollections); err != nil {
 		return nil, err
 	}
 	return &searchCollections, nil
@@ -75,18 +100,28 @@ type SearchKeywords struct {
 func (c *Client) GetSearchKeywords(
 	query string,
 	urlOptions map[string]string,
+) (*SearchKeywords, error) {
+	return c.GetSearchKeywordsWithContext(context.Background(), query, urlOptions)
+}
+
+// GetSearchKeywordsWithContext is like GetSearchKeywords but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetSearchKeywordsWithContext(
+	ctx context.Context,
+	query string,
+	urlOptions map[string]string,
 ) (*SearchKeywords, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%skeyword?api_key=%s&query=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		searchURL,
 		c.apiKey,
 		url.QueryEscape(query),
 		options,
 	)
 	searchKeywords := SearchKeywords{}
-	if err := c.get(tmdbURL, &searchKeywords); err != nil {
+	if err := c.get(ctx, "GetSearchKeywords", tmdbURL, &searchKeywords); err != nil {
 		return nil, err
 	}
 	return &searchKeywords, nil
@@ -104,18 +139,28 @@ type SearchMovies struct {
 func (c *Client) GetSearchMovies(
 	query string,
 	urlOptions map[string]string,
+) (*SearchMovies, error) {
+	return c.GetSearchMoviesWithContext(context.Background(), query, urlOptions)
+}
+
+// GetSearchMoviesWithContext is like GetSearchMovies but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetSearchMoviesWithContext(
+	ctx context.Context,
+	query string,
+	urlOptions map[string]string,
 ) (*SearchMovies, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%smovie?api_key=%s&query=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		searchURL,
 		c.apiKey,
 		url.QueryEscape(query),
 		options,
 	)
 	searchMovies := SearchMovies{}
-	if err := c.get(tmdbURL, &searchMovies); err != nil {
+	if err := c.get(ctx, "GetSearchMovies", tmdbURL, &searchMovies); err != nil {
 		return nil, err
 	}
 	return &searchMovies, nil
@@ -135,18 +180,28 @@ type SearchMulti struct {
 func (c *Client) GetSearchMulti(
 	query string,
 	urlOptions map[string]string,
+) (*SearchMulti, error) {
+	return c.GetSearchMultiWithContext(context.Background(), query, urlOptions)
+}
+
+// GetSearchMultiWithContext is like GetSearchMulti but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetSearchMultiWithContext(
+	ctx context.Context,
+	query string,
+	urlOptions map[string]string,
 ) (*SearchMulti, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%smulti?api_key=%s&query=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		searchURL,
 		c.apiKey,
 		url.QueryEscape(query),
 		options,
 	)
 	searchMulti := SearchMulti{}
-	if err := c.get(tmdbURL, &searchMulti); err != nil {
+	if err := c.get(ctx, "GetSearchMulti", tmdbURL, &searchMulti); err != nil {
 		return nil, err
 	}
 	return &searchMulti, nil
@@ -164,18 +219,28 @@ type SearchPeople struct {
 func (c *Client) GetSearchPeople(
 	query string,
 	urlOptions map[string]string,
+) (*SearchPeople, error) {
+	return c.GetSearchPeopleWithContext(context.Background(), query, urlOptions)
+}
+
+// GetSearchPeopleWithContext is like GetSearchPeople but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetSearchPeopleWithContext(
+	ctx context.Context,
+	query string,
+	urlOptions map[string]string,
 ) (*SearchPeople, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%sperson?api_key=%s&query=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		searchURL,
 		c.apiKey,
 		url.QueryEscape(query),
 		options,
 	)
 	searchPeople := SearchPeople{}
-	if err := c.get(tmdbURL, &searchPeople); err != nil {
+	if err := c.get(ctx, "GetSearchPeople", tmdbURL, &searchPeople); err != nil {
 		return nil, err
 	}
 	return &searchPeople, nil
@@ -193,18 +258,28 @@ type SearchTVShows struct {
 func (c *Client) GetSearchTVShow(
 	query string,
 	urlOptions map[string]string,
+) (*SearchTVShows, error) {
+	return c.GetSearchTVShowWithContext(context.Background(), query, urlOptions)
+}
+
+// GetSearchTVShowWithContext is like GetSearchTVShow but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetSearchTVShowWithContext(
+	ctx context.Context,
+	query string,
+	urlOptions map[string]string,
 ) (*SearchTVShows, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%stv?api_key=%s&query=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		searchURL,
 		c.apiKey,
 		url.QueryEscape(query),
 		options,
 	)
 	searchTVShows := SearchTVShows{}
-	if err := c.get(tmdbURL, &searchTVShows); err != nil {
+	if err := c.get(ctx, "GetSearchTVShow", tmdbURL, &searchTVShows); err != nil {
 		return nil, err
 	}
 	return &searchTVShows, nil
@@ -0,0 +1,63 @@
+package tmdb
+
+import (
+	"errors"
+	"slices"
+	"sync"
+)
+
+// The service interfaces group the Client methods by API section,
+// so consumers can depend on the slices they use and substitute
+// them in tests. They and their mocks are generated from the
+// Client methods of each file.
+//
+//go:generate go run ./internal/servicegen
+
+// ErrNotStubbed is returned by the mock methods without a stub.
+var ErrNotStubbed = errors.New("tmdb: mock method not stubbed")
+
+// MockCall type is a call received by a mock.
+type MockCall struct {
+	Method string
+	Args   []any
+}
+
+// MockRecorder type records the calls of a mock. It is
+// embedded in the generated mocks and safe for concurrent use.
+type MockRecorder struct {
+	mu    sync.Mutex
+	calls []MockCall
+}
+
+func (r *MockRecorder) record(method string, args ...any) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = append(r.calls, MockCall{Method: method, Args: args})
+}
+
+// Calls returns the calls received so far.
+func (r *MockRecorder) Calls() []MockCall {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return slices.Clone(r.calls)
+}
+
+// CallsTo returns the calls received so far by method.
+func (r *MockRecorder) CallsTo(method string) []MockCall {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var calls []MockCall
+	for _, call := range r.calls {
+		if call.Method == method {
+			calls = append(calls, call)
+		}
+	}
+	return calls
+}
+
+// ResetCalls forgets the calls received so far.
+func (r *MockRecorder) ResetCalls() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.calls = nil
+}
@@ -0,0 +1,1436 @@
+// Code generated by servicegen. DO NOT EDIT.
+
+package tmdb
+
+import (
+	"context"
+	"iter"
+)
+
+// API is the interface of all the services, satisfied by *Client.
+type API interface {
+	AccountService
+	AccountV4Service
+	AuthenticationService
+	CertificationsService
+	ChangesService
+	CollectionsService
+	CompaniesService
+	ConfigurationService
+	CreditsService
+	DiscoverService
+	FindService
+	GenresService
+	GuestSessionsService
+	KeywordsService
+	ListsService
+	ListsV4Service
+	MoviesService
+	NetworksService
+	PeopleService
+	ReviewsService
+	SearchService
+	TrendingService
+	TVService
+	TVEpisodesService
+	TVSeasonsService
+	WatchProvidersService
+}
+
+var _ API = (*Client)(nil)
+
+// AccountService is the interface of the v3 account endpoints.
+type AccountService interface {
+	// GetAccountDetails get your account details.
+	GetAccountDetails() (*AccountDetails, error)
+
+	// GetAccountDetailsWithContext is like GetAccountDetails but uses the
+	// given context for cancellation and deadlines.
+	GetAccountDetailsWithContext(ctx context.Context) (*AccountDetails, error)
+
+	// GetCreatedLists get all of the lists created by an account.
+	// Will invlude private lists if you are the owner.
+	GetCreatedLists(id int, urlOptions map[string]string) (*AccountCreatedLists, error)
+
+	// GetCreatedListsWithContext is like GetCreatedLists but uses the
+	// given context for cancellation and deadlines.
+	GetCreatedListsWithContext(ctx context.Context, id int, urlOptions map[string]string) (*AccountCreatedLists, error)
+
+	// GetFavoriteMovies get the list of your favorite movies.
+	GetFavoriteMovies(id int, urlOptions map[string]string) (*AccountFavoriteMovies, error)
+
+	// GetFavoriteMoviesWithContext is like GetFavoriteMovies but uses the
+	// given context for cancellation and deadlines.
+	GetFavoriteMoviesWithContext(ctx context.Context, id int, urlOptions map[string]string) (*AccountFavoriteMovies, error)
+
+	// GetFavoriteTVShows get the list of your favorite TV shows.
+	GetFavoriteTVShows(id int, urlOptions map[string]string) (*AccountFavoriteTVShows, error)
+
+	// GetFavoriteTVShowsWithContext is like GetFavoriteTVShows but uses the
+	// given context for cancellation and deadlines.
+	GetFavoriteTVShowsWithContext(ctx context.Context, id int, urlOptions map[string]string) (*AccountFavoriteTVShows, error)
+
+	// MarkAsFavorite this method allows you to mark a movie
+	// or TV show as a favorite item.
+	MarkAsFavorite(id int, title *AccountFavorite) (*Response, error)
+
+	// MarkAsFavoriteWithContext is like MarkAsFavorite but uses the
+	// given context for cancellation and deadlines.
+	MarkAsFavoriteWithContext(ctx context.Context, id int, title *AccountFavorite) (*Response, error)
+
+	// GetRatedMovies get a list of all the movies you have rated.
+	GetRatedMovies(id int, urlOptions map[string]string) (*AccountRatedMovies, error)
+
+	// GetRatedMoviesWithContext is like GetRatedMovies but uses the
+	// given context for cancellation and deadlines.
+	GetRatedMoviesWithContext(ctx context.Context, id int, urlOptions map[string]string) (*AccountRatedMovies, error)
+
+	// GetRatedTVShows get a list of all the TV shows you have rated.
+	GetRatedTVShows(id int, urlOptions map[string]string) (*AccountRatedTVShows, error)
+
+	// GetRatedTVShowsWithContext is like GetRatedTVShows but uses the
+	// given context for cancellation and deadlines.
+	GetRatedTVShowsWithContext(ctx context.Context, id int, urlOptions map[string]string) (*AccountRatedTVShows, error)
+
+	// GetRatedTVEpisodes get a list of all the TV episodes you have rated.
+	GetRatedTVEpisodes(id int, urlOptions map[string]string) (*AccountRatedTVEpisodes, error)
+
+	// GetRatedTVEpisodesWithContext is like GetRatedTVEpisodes but uses the
+	// given context for cancellation and deadlines.
+	GetRatedTVEpisodesWithContext(ctx context.Context, id int, urlOptions map[string]string) (*AccountRatedTVEpisodes, error)
+
+	// GetMovieWatchlist get a list of all the movies you have added to your watchlist.
+	GetMovieWatchlist(id int, urlOptions map[string]string) (*AccountMovieWatchlist, error)
+
+	// GetMovieWatchlistWithContext is like GetMovieWatchlist but uses the
+	// given context for cancellation and deadlines.
+	GetMovieWatchlistWithContext(ctx context.Context, id int, urlOptions map[string]string) (*AccountMovieWatchlist, error)
+
+	// GetTVShowsWatchlist get a list of all the TV shows you have added to your watchlist.
+	GetTVShowsWatchlist(id int, urlOptions map[string]string) (*AccountTVShowsWatchlist, error)
+
+	// GetTVShowsWatchlistWithContext is like GetTVShowsWatchlist but uses the
+	// given context for cancellation and deadlines.
+	GetTVShowsWatchlistWithContext(ctx context.Context, id int, urlOptions map[string]string) (*AccountTVShowsWatchlist, error)
+
+	// AddToWatchlist add a movie or TV show to your watchlist.
+	AddToWatchlist(id int, title *AccountWatchlist) (*Response, error)
+
+	// AddToWatchlistWithContext is like AddToWatchlist but uses the
+	// given context for cancellation and deadlines.
+	AddToWatchlistWithContext(ctx context.Context, id int, title *AccountWatchlist) (*Response, error)
+}
+
+var _ AccountService = (*Client)(nil)
+
+// AccountV4Service is the interface of the v4 account endpoints.
+type AccountV4Service interface {
+	// GetAccountV4Lists get all of the lists you've created.
+	GetAccountV4Lists(accountObjectID string, urlOptions map[string]string) (*AccountV4Lists, error)
+
+	// GetAccountV4ListsWithContext is like GetAccountV4Lists but uses the
+	// given context for cancellation and deadlines.
+	GetAccountV4ListsWithContext(ctx context.Context, accountObjectID string, urlOptions map[string]string) (*AccountV4Lists, error)
+
+	// AllAccountV4Lists returns an iterator over the
+	// lists you've created across all of the pages.
+	AllAccountV4Lists(ctx context.Context, accountObjectID string, urlOptions map[string]string, opts PaginateOptions) iter.Seq2[AccountV4List, error]
+
+	// GetAccountV4FavoriteMovies get the list of movies you
+	// have marked as a favorite.
+	GetAccountV4FavoriteMovies(accountObjectID string, urlOptions map[string]string) (*AccountV4Movies, error)
+
+	// GetAccountV4FavoriteMoviesWithContext is like GetAccountV4FavoriteMovies
+	// but uses the given context for cancellation and deadlines.
+	GetAccountV4FavoriteMoviesWithContext(ctx context.Context, accountObjectID string, urlOptions map[string]string) (*AccountV4Movies, error)
+
+	// AllAccountV4FavoriteMovies returns an iterator over your
+	// favorite movies across all of the pages.
+	AllAccountV4FavoriteMovies(ctx context.Context, accountObjectID string, urlOptions map[string]string, opts PaginateOptions) iter.Seq2[AccountV4Movie, error]
+
+	// GetAccountV4FavoriteTVShows get the list of TV shows you
+	// have marked as a favorite.
+	GetAccountV4FavoriteTVShows(accountObjectID string, urlOptions map[string]string) (*AccountV4TVShows, error)
+
+	// GetAccountV4FavoriteTVShowsWithContext is like GetAccountV4FavoriteTVShows
+	// but uses the given context for cancellation and deadlines.
+	GetAccountV4FavoriteTVShowsWithContext(ctx context.Context, accountObjectID string, urlOptions map[string]string) (*AccountV4TVShows, error)
+
+	// AllAccountV4FavoriteTVShows returns an iterator over your
+	// favorite TV shows across all of the pages.
+	AllAccountV4FavoriteTVShows(ctx context.Context, accountObjectID string, urlOptions map[string]string, opts PaginateOptions) iter.Seq2[AccountV4TVShow, error]
+
+	// GetAccountV4MovieWatchlist get the list of movies you
+	// have added to your watchlist.
+	GetAccountV4MovieWatchlist(accountObjectID string, urlOptions map[string]string) (*AccountV4Movies, error)
+
+	// GetAccountV4MovieWatchlistWithContext is like GetAccountV4MovieWatchlist
+	// but uses the given context for cancellation and deadlines.
+	GetAccountV4MovieWatchlistWithContext(ctx context.Context, accountObjectID string, urlOptions map[string]string) (*AccountV4Movies, error)
+
+	// AllAccountV4MovieWatchlist returns an iterator over the
+	// movies of your watchlist across all of the pages.
+	AllAccountV4MovieWatchlist(ctx context.Context, accountObjectID string, urlOptions map[string]string, opts PaginateOptions) iter.Seq2[AccountV4Movie, error]
+
+	// GetAccountV4TVShowWatchlist get the list of TV shows you
+	// have added to your watchlist.
+	GetAccountV4TVShowWatchlist(accountObjectID string, urlOptions map[string]string) (*AccountV4TVShows, error)
+
+	// GetAccountV4TVShowWatchlistWithContext is like GetAccountV4TVShowWatchlist
+	// but uses the given context for cancellation and deadlines.
+	GetAccountV4TVShowWatchlistWithContext(ctx context.Context, accountObjectID string, urlOptions map[string]string) (*AccountV4TVShows, error)
+
+	// AllAccountV4TVShowWatchlist returns an iterator over the
+	// TV shows of your watchlist across all of the pages.
+	AllAccountV4TVShowWatchlist(ctx context.Context, accountObjectID string, urlOptions map[string]string, opts PaginateOptions) iter.Seq2[AccountV4TVShow, error]
+
+	// GetAccountV4RatedMovies get the list of movies you have rated.
+	GetAccountV4RatedMovies(accountObjectID string, urlOptions map[string]string) (*AccountV4Movies, error)
+
+	// GetAccountV4RatedMoviesWithContext is like GetAccountV4RatedMovies
+	// but uses the given context for cancellation and deadlines.
+	GetAccountV4RatedMoviesWithContext(ctx context.Context, accountObjectID string, urlOptions map[string]string) (*AccountV4Movies, error)
+
+	// AllAccountV4RatedMovies returns an iterator over the
+	// movies you have rated across all of the pages.
+	AllAccountV4RatedMovies(ctx context.Context, accountObjectID string, urlOptions map[string]string, opts PaginateOptions) iter.Seq2[AccountV4Movie, error]
+
+	// GetAccountV4RatedTVShows get the list of TV shows you have rated.
+	GetAccountV4RatedTVShows(accountObjectID string, urlOptions map[string]string) (*AccountV4TVShows, error)
+
+	// GetAccountV4RatedTVShowsWithContext is like GetAccountV4RatedTVShows
+	// but uses the given context for cancellation and deadlines.
+	GetAccountV4RatedTVShowsWithContext(ctx context.Context, accountObjectID string, urlOptions map[string]string) (*AccountV4TVShows, error)
+
+	// AllAccountV4RatedTVShows returns an iterator over the
+	// TV shows you have rated across all of the pages.
+	AllAccountV4RatedTVShows(ctx context.Context, accountObjectID string, urlOptions map[string]string, opts PaginateOptions) iter.Seq2[AccountV4TVShow, error]
+
+	// GetAccountV4MovieRecommendations get a list of your
+	// personalized movie recommendations.
+	GetAccountV4MovieRecommendations(accountObjectID string, urlOptions map[string]string) (*AccountV4Movies, error)
+
+	// GetAccountV4MovieRecommendationsWithContext is like
+	// GetAccountV4MovieRecommendations but uses the given
+	// context for cancellation and deadlines.
+	GetAccountV4MovieRecommendationsWithContext(ctx context.Context, accountObjectID string, urlOptions map[string]string) (*AccountV4Movies, error)
+
+	// AllAccountV4MovieRecommendations returns an iterator over your
+	// movie recommendations across all of the pages.
+	AllAccountV4MovieRecommendations(ctx context.Context, accountObjectID string, urlOptions map[string]string, opts PaginateOptions) iter.Seq2[AccountV4Movie, error]
+
+	// GetAccountV4TVShowRecommendations get a list of your
+	// personalized TV show recommendations.
+	GetAccountV4TVShowRecommendations(accountObjectID string, urlOptions map[string]string) (*AccountV4TVShows, error)
+
+	// GetAccountV4TVShowRecommendationsWithContext is like
+	// GetAccountV4TVShowRecommendations but uses the given
+	// context for cancellation and deadlines.
+	GetAccountV4TVShowRecommendationsWithContext(ctx context.Context, accountObjectID string, urlOptions map[string]string) (*AccountV4TVShows, error)
+
+	// AllAccountV4TVShowRecommendations returns an iterator over your
+	// TV show recommendations across all of the pages.
+	AllAccountV4TVShowRecommendations(ctx context.Context, accountObjectID string, urlOptions map[string]string, opts PaginateOptions) iter.Seq2[AccountV4TVShow, error]
+}
+
+var _ AccountV4Service = (*Client)(nil)
+
+// AuthenticationService is the interface of the v3 and v4 authentication endpoints.
+type AuthenticationService interface {
+	// CreateGuestSession creates a temporary request token
+	// that can be used to validate a TMDb user login.
+	CreateGuestSession() (*RequestToken, error)
+
+	// CreateGuestSessionWithContext is like CreateGuestSession but uses the
+	// given context for cancellation and deadlines.
+	CreateGuestSessionWithContext(ctx context.Context) (*RequestToken, error)
+
+	// CreateRequestToken creates a temporary request token
+	// that can be used to validate a TMDb user login.
+	CreateRequestToken() (*RequestToken, error)
+
+	// CreateRequestTokenWithContext is like CreateRequestToken but uses the
+	// given context for cancellation and deadlines.
+	CreateRequestTokenWithContext(ctx context.Context) (*RequestToken, error)
+
+	// CreateSession creates a new session id.
+	CreateSession(rt string) (*Session, error)
+
+	// CreateSessionWithContext is like CreateSession but uses the
+	// given context for cancellation and deadlines.
+	CreateSessionWithContext(ctx context.Context, rt string) (*Session, error)
+
+	// CreateSessionWithLogin creates a new session id using login.
+	CreateSessionWithLogin(u string, p string, rt string) (*RequestToken, error)
+
+	// CreateSessionWithLoginWithContext is like CreateSessionWithLogin
+	// but uses the given context for cancellation and deadlines.
+	CreateSessionWithLoginWithContext(ctx context.Context, u string, p string, rt string) (*RequestToken, error)
+
+	// CreateSessionFromV4 creates a new session id.
+	CreateSessionFromV4(at string) (*Session, error)
+
+	// CreateSessionFromV4WithContext is like CreateSessionFromV4 but
+	// uses the given context for cancellation and deadlines.
+	CreateSessionFromV4WithContext(ctx context.Context, at string) (*Session, error)
+
+	// DeleteSession deletes a session id.
+	DeleteSession(sid string) (*Session, error)
+
+	// DeleteSessionWithContext is like DeleteSession but uses the
+	// given context for cancellation and deadlines.
+	DeleteSessionWithContext(ctx context.Context, sid string) (*Session, error)
+
+	// GetApprovalURL returns the url where the user approves a
+	// request token. If redirectTo is not empty, the user is sent
+	// back to it after the approval.
+	GetApprovalURL(requestToken string, redirectTo string) string
+
+	// CreateRequestTokenV4 creates a temporary request token
+	// that can be approved by a user on the TMDb website.
+	CreateRequestTokenV4(redirectTo string) (*RequestTokenV4, error)
+
+	// CreateRequestTokenV4WithContext is like CreateRequestTokenV4 but
+	// uses the given context for cancellation and deadlines.
+	CreateRequestTokenV4WithContext(ctx context.Context, redirectTo string) (*RequestTokenV4, error)
+
+	// CreateAccessTokenV4 exchanges a request token approved by
+	// the user for a user access token and account_object_id.
+	CreateAccessTokenV4(requestToken string) (*AccessTokenV4, error)
+
+	// CreateAccessTokenV4WithContext is like CreateAccessTokenV4 but
+	// uses the given context for cancellation and deadlines.
+	CreateAccessTokenV4WithContext(ctx context.Context, requestToken string) (*AccessTokenV4, error)
+
+	// DeleteAccessTokenV4 revokes a user access token ("logout").
+	DeleteAccessTokenV4(accessToken string) (*Response, error)
+
+	// DeleteAccessTokenV4WithContext is like DeleteAccessTokenV4 but
+	// uses the given context for cancellation and deadlines.
+	DeleteAccessTokenV4WithContext(ctx context.Context, accessToken string) (*Response, error)
+
+	// GetApprovalURLV4 returns the url where the user
+	// approves a v4 request token.
+	GetApprovalURLV4(requestToken string) string
+}
+
+var _ AuthenticationService = (*Client)(nil)
+
+// CertificationsService is the interface of the certifications endpoints.
+type CertificationsService interface {
+	// GetCertificationMovie get an up to date list of the
+	// officially supported movie certifications on TMDb.
+	GetCertificationMovie() (*Certifications, error)
+
+	// GetCertificationMovieWithContext is like GetCertificationMovie but uses the
+	// given context for cancellation and deadlines.
+	GetCertificationMovieWithContext(ctx context.Context) (*Certifications, error)
+
+	// GetCertificationTV get an up to date list of the
+	// officially supported TV show certifications on TMDb.
+	GetCertificationTV() (*Certifications, error)
+
+	// GetCertificationTVWithContext is like GetCertificationTV but uses the
+	// given context for cancellation and deadlines.
+	GetCertificationTVWithContext(ctx context.Context) (*Certifications, error)
+}
+
+var _ CertificationsService = (*Client)(nil)
+
+// ChangesService is the interface of the changes endpoints.
+type ChangesService interface {
+	// GetChangesMovie get a list of all of the movie ids
+	// that have been changed in the past 24 hours.
+	GetChangesMovie(urlOptions map[string]string) (*ChangesMovie, error)
+
+	// GetChangesMovieWithContext is like GetChangesMovie but uses the
+	// given context for cancellation and deadlines.
+	GetChangesMovieWithContext(ctx context.Context, urlOptions map[string]string) (*ChangesMovie, error)
+
+	// GetChangesTV get a list of all of the TV show ids
+	// that have been changed in the past 24 hours.
+	GetChangesTV(urlOptions map[string]string) (*ChangesTV, error)
+
+	// GetChangesTVWithContext is like GetChangesTV but uses the
+	// given context for cancellation and deadlines.
+	GetChangesTVWithContext(ctx context.Context, urlOptions map[string]string) (*ChangesTV, error)
+
+	// GetChangesPerson get a list of all of the person ids
+	// that have been changed in the past 24 hours.
+	GetChangesPerson(urlOptions map[string]string) (*ChangesPerson, error)
+
+	// GetChangesPersonWithContext is like GetChangesPerson but uses the
+	// given context for cancellation and deadlines.
+	GetChangesPersonWithContext(ctx context.Context, urlOptions map[string]string) (*ChangesPerson, error)
+}
+
+var _ ChangesService = (*Client)(nil)
+
+// CollectionsService is the interface of the collections endpoints.
+type CollectionsService interface {
+	// GetCollectionDetails get collection details by id.
+	GetCollectionDetails(id int, urlOptions map[string]string) (*CollectionDetails, error)
+
+	// GetCollectionDetailsWithContext is like GetCollectionDetails but uses the
+	// given context for cancellation and deadlines.
+	GetCollectionDetailsWithContext(ctx context.Context, id int, urlOptions map[string]string) (*CollectionDetails, error)
+
+	// GetCollectionImages get the images for a collection by id.
+	GetCollectionImages(id int, urlOptions map[string]string) (*CollectionImages, error)
+
+	// GetCollectionImagesWithContext is like GetCollectionImages but uses the
+	// given context for cancellation and deadlines.
+	GetCollectionImagesWithContext(ctx context.Context, id int, urlOptions map[string]string) (*CollectionImages, error)
+
+	// GetCollectionTranslations get the list translations for a collection by id.
+	GetCollectionTranslations(id int, urlOptions map[string]string) (*CollectionTranslations, error)
+
+	// GetCollectionTranslationsWithContext is like GetCollectionTranslations but uses the
+	// given context for cancellation and deadlines.
+	GetCollectionTranslationsWithContext(ctx context.Context, id int, urlOptions map[string]string) (*CollectionTranslations, error)
+}
+
+var _ CollectionsService = (*Client)(nil)
+
+// CompaniesService is the interface of the companies endpoints.
+type CompaniesService interface {
+	// GetCompanyDetails get a companies details by id.
+	GetCompanyDetails(id int) (*CompanyDetails, error)
+
+	// GetCompanyDetailsWithContext is like GetCompanyDetails but uses the
+	// given context for cancellation and deadlines.
+	GetCompanyDetailsWithContext(ctx context.Context, id int) (*CompanyDetails, error)
+
+	// GetCompanyAlternativeNames get the alternative names of a company.
+	GetCompanyAlternativeNames(id int) (*CompanyAlternativeNames, error)
+
+	// GetCompanyAlternativeNamesWithContext is like GetCompanyAlternativeNames but uses the
+	// given context for cancellation and deadlines.
+	GetCompanyAlternativeNamesWithContext(ctx context.Context, id int) (*CompanyAlternativeNames, error)
+
+	// GetCompanyImages get a companies logos by id.
+	GetCompanyImages(id int) (*CompanyImages, error)
+
+	// GetCompanyImagesWithContext is like GetCompanyImages but uses the
+	// given context for cancellation and deadlines.
+	GetCompanyImagesWithContext(ctx context.Context, id int) (*CompanyImages, error)
+}
+
+var _ CompaniesService = (*Client)(nil)
+
+// ConfigurationService is the interface of the configuration endpoints.
+type ConfigurationService interface {
+	// GetConfigurationAPI get the system wide configuration information.
+	GetConfigurationAPI() (*ConfigurationAPI, error)
+
+	// GetConfigurationAPIWithContext is like GetConfigurationAPI but uses the
+	// given context for cancellation and deadlines.
+	GetConfigurationAPIWithContext(ctx context.Context) (*ConfigurationAPI, error)
+
+	// GetConfigurationCountries get the list of countries
+	// (ISO 3166-1 tags) used throughout TMDb.
+	GetConfigurationCountries() (*ConfigurationCountries, error)
+
+	// GetConfigurationCountriesWithContext is like GetConfigurationCountries but uses the
+	// given context for cancellation and deadlines.
+	GetConfigurationCountriesWithContext(ctx context.Context) (*ConfigurationCountries, error)
+
+	// GetConfigurationJobs get a list of the jobs and departments we use on TMDb.
+	GetConfigurationJobs() (*ConfigurationJobs, error)
+
+	// GetConfigurationJobsWithContext is like GetConfigurationJobs but uses the
+	// given context for cancellation and deadlines.
+	GetConfigurationJobsWithContext(ctx context.Context) (*ConfigurationJobs, error)
+
+	// GetConfigurationLanguages get the list of languages
+	// (ISO 639-1 tags) used throughout TMDb.
+	GetConfigurationLanguages() (*ConfigurationLanguages, error)
+
+	// GetConfigurationLanguagesWithContext is like GetConfigurationLanguages but uses the
+	// given context for cancellation and deadlines.
+	GetConfigurationLanguagesWithContext(ctx context.Context) (*ConfigurationLanguages, error)
+
+	// GetConfigurationPrimaryTranslations get a list of the officially
+	// supported translations on TMDb.
+	GetConfigurationPrimaryTranslations() (*ConfigurationPrimaryTranslations, error)
+
+	// GetConfigurationPrimaryTranslationsWithContext is like GetConfigurationPrimaryTranslations but uses the
+	// given context for cancellation and deadlines.
+	GetConfigurationPrimaryTranslationsWithContext(ctx context.Context) (*ConfigurationPrimaryTranslations, error)
+
+	// GetConfigurationTimezones get the list of timezones
+	// used throughout TMDb.
+	GetConfigurationTimezones() (*ConfigurationTimezones, error)
+
+	// GetConfigurationTimezonesWithContext is like GetConfigurationTimezones but uses the
+	// given context for cancellation and deadlines.
+	GetConfigurationTimezonesWithContext(ctx context.Context) (*ConfigurationTimezones, error)
+}
+
+var _ ConfigurationService = (*Client)(nil)
+
+// CreditsService is the interface of the credits endpoints.
+type CreditsService interface {
+	// GetCreditDetails get a movie or TV credit details by id.
+	GetCreditDetails(id string) (*CreditsDetails, error)
+
+	// GetCreditDetailsWithContext is like GetCreditDetails but uses the
+	// given context for cancellation and deadlines.
+	GetCreditDetailsWithContext(ctx context.Context, id string) (*CreditsDetails, error)
+}
+
+var _ CreditsService = (*Client)(nil)
+
+// DiscoverService is the interface of the discover endpoints.
+type DiscoverService interface {
+	// GetDiscoverMovie discover movies by different types of data like
+	// average rating, number of votes, genres and certifications. You can
+	// get a valid list of certifications from the  method.
+	GetDiscoverMovie(urlOptions map[string]string) (*DiscoverMovie, error)
+
+	// GetDiscoverMovieWithContext is like GetDiscoverMovie but uses the
+	// given context for cancellation and deadlines.
+	GetDiscoverMovieWithContext(ctx context.Context, urlOptions map[string]string) (*DiscoverMovie, error)
+
+	// GetDiscoverTV Discover TV shows by different types of data like average
+	// rating, number of votes, genres, the network they aired on and air dates.
+	GetDiscoverTV(urlOptions map[string]string) (*DiscoverTV, error)
+
+	// GetDiscoverTVWithContext is like GetDiscoverTV but uses the
+	// given context for cancellation and deadlines.
+	GetDiscoverTVWithContext(ctx context.Context, urlOptions map[string]string) (*DiscoverTV, error)
+
+	// GetDiscoverMovieQuery validates the query and discovers
+	// movies with it. See GetDiscoverMovie.
+	GetDiscoverMovieQuery(query *DiscoverMovieQuery) (*DiscoverMovie, error)
+
+	// GetDiscoverMovieQueryWithContext is like GetDiscoverMovieQuery
+	// but uses the given context for cancellation and deadlines.
+	GetDiscoverMovieQueryWithContext(ctx context.Context, query *DiscoverMovieQuery) (*DiscoverMovie, error)
+
+	// GetDiscoverTVQuery validates the query and discovers
+	// TV shows with it. See GetDiscoverTV.
+	GetDiscoverTVQuery(query *DiscoverTVQuery) (*DiscoverTV, error)
+
+	// GetDiscoverTVQueryWithContext is like GetDiscoverTVQuery
+	// but uses the given context for cancellation and deadlines.
+	GetDiscoverTVQueryWithContext(ctx context.Context, query *DiscoverTVQuery) (*DiscoverTV, error)
+}
+
+var _ DiscoverService = (*Client)(nil)
+
+// FindService is the interface of the find endpoints.
+type FindService interface {
+	// GetFindByID the find method makes it easy to search for objects in our
+	// database by an external id. For example, an IMDB ID.
+	GetFindByID(id string, urlOptions map[string]string) (*FindByID, error)
+
+	// GetFindByIDWithContext is like GetFindByID but uses the
+	// given context for cancellation and deadlines.
+	GetFindByIDWithContext(ctx context.Context, id string, urlOptions map[string]string) (*FindByID, error)
+}
+
+var _ FindService = (*Client)(nil)
+
+// GenresService is the interface of the genres endpoints.
+type GenresService interface {
+	// GetGenreMovieList get the list of official genres for movies.
+	GetGenreMovieList(urlOptions map[string]string) (*GenreMovieList, error)
+
+	// GetGenreMovieListWithContext is like GetGenreMovieList but uses the
+	// given context for cancellation and deadlines.
+	GetGenreMovieListWithContext(ctx context.Context, urlOptions map[string]string) (*GenreMovieList, error)
+
+	// GetGenreTVList get the list of official genres for TV shows.
+	GetGenreTVList(urlOptions map[string]string) (*GenreMovieList, error)
+
+	// GetGenreTVListWithContext is like GetGenreTVList but uses the
+	// given context for cancellation and deadlines.
+	GetGenreTVListWithContext(ctx context.Context, urlOptions map[string]string) (*GenreMovieList, error)
+}
+
+var _ GenresService = (*Client)(nil)
+
+// GuestSessionsService is the interface of the guest sessions endpoints.
+type GuestSessionsService interface {
+	// GetGuestSessionRatedMovies get the rated movies for a guest session.
+	GetGuestSessionRatedMovies(id string, urlOptions map[string]string) (*GuestSessionRatedMovies, error)
+
+	// GetGuestSessionRatedMoviesWithContext is like GetGuestSessionRatedMovies but uses the
+	// given context for cancellation and deadlines.
+	GetGuestSessionRatedMoviesWithContext(ctx context.Context, id string, urlOptions map[string]string) (*GuestSessionRatedMovies, error)
+
+	// GetGuestSessionRatedTVShows get the rated TV shows for a guest session.
+	GetGuestSessionRatedTVShows(id string, urlOptions map[string]string) (*GuestSessionRatedTVShows, error)
+
+	// GetGuestSessionRatedTVShowsWithContext is like GetGuestSessionRatedTVShows but uses the
+	// given context for cancellation and deadlines.
+	GetGuestSessionRatedTVShowsWithContext(ctx context.Context, id string, urlOptions map[string]string) (*GuestSessionRatedTVShows, error)
+
+	// GetGuestSessionRatedTVEpisodes get the rated TV episodes for a guest session.
+	GetGuestSessionRatedTVEpisodes(id string, urlOptions map[string]string) (*GuestSessionRatedTVEpisodes, error)
+
+	// GetGuestSessionRatedTVEpisodesWithContext is like GetGuestSessionRatedTVEpisodes but uses the
+	// given context for cancellation and deadlines.
+	GetGuestSessionRatedTVEpisodesWithContext(ctx context.Context, id string, urlOptions map[string]string) (*GuestSessionRatedTVEpisodes, error)
+}
+
+var _ GuestSessionsService = (*Client)(nil)
+
+// KeywordsService is the interface of the keywords endpoints.
+type KeywordsService interface {
+	// GetKeywordDetails get keyword details by id.
+	GetKeywordDetails(id int) (*KeywordDetails, error)
+
+	// GetKeywordDetailsWithContext is like GetKeywordDetails but uses the
+	// given context for cancellation and deadlines.
+	GetKeywordDetailsWithContext(ctx context.Context, id int) (*KeywordDetails, error)
+
+	// GetKeywordMovies get the movies that belong to a keyword.
+	GetKeywordMovies(id int, urlOptions map[string]string) (*KeywordMovies, error)
+
+	// GetKeywordMoviesWithContext is like GetKeywordMovies but uses the
+	// given context for cancellation and deadlines.
+	GetKeywordMoviesWithContext(ctx context.Context, id int, urlOptions map[string]string) (*KeywordMovies, error)
+}
+
+var _ KeywordsService = (*Client)(nil)
+
+// ListsService is the interface of the v3 lists endpoints.
+type ListsService interface {
+	// GetListDetails get the details of a list.
+	GetListDetails(id int64, urlOptions map[string]string) (*ListDetails, error)
+
+	// GetListDetailsWithContext is like GetListDetails but uses the
+	// given context for cancellation and deadlines.
+	GetListDetailsWithContext(ctx context.Context, id int64, urlOptions map[string]string) (*ListDetails, error)
+
+	// GetListItemStatus check if a movie has already been added to the list.
+	GetListItemStatus(id int64, urlOptions map[string]string) (*ListItemStatus, error)
+
+	// GetListItemStatusWithContext is like GetListItemStatus but uses the
+	// given context for cancellation and deadlines.
+	GetListItemStatusWithContext(ctx context.Context, id int64, urlOptions map[string]string) (*ListItemStatus, error)
+
+	// CreateList creates a list.
+	CreateList(list *ListCreate) (*ListResponse, error)
+
+	// CreateListWithContext is like CreateList but uses the
+	// given context for cancellation and deadlines.
+	CreateListWithContext(ctx context.Context, list *ListCreate) (*ListResponse, error)
+
+	// AddMovie add a movie to a list.
+	AddMovie(listID int, mediaID *ListMedia) (*Response, error)
+
+	// AddMovieWithContext is like AddMovie but uses the
+	// given context for cancellation and deadlines.
+	AddMovieWithContext(ctx context.Context, listID int, mediaID *ListMedia) (*Response, error)
+
+	// RemoveMovie remove a movie from a list.
+	RemoveMovie(listID int, mediaID *ListMedia) (*Response, error)
+
+	// RemoveMovieWithContext is like RemoveMovie but uses the
+	// given context for cancellation and deadlines.
+	RemoveMovieWithContext(ctx context.Context, listID int, mediaID *ListMedia) (*Response, error)
+
+	// ClearList clear all of the items from a list.
+	ClearList(listID int, confirm bool) (*Response, error)
+
+	// ClearListWithContext is like ClearList but uses the
+	// given context for cancellation and deadlines.
+	ClearListWithContext(ctx context.Context, listID int, confirm bool) (*Response, error)
+
+	// DeleteList deletes a list.
+	DeleteList(listID int) (*Response, error)
+
+	// DeleteListWithContext is like DeleteList but uses the
+	// given context for cancellation and deadlines.
+	DeleteListWithContext(ctx context.Context, listID int) (*Response, error)
+}
+
+var _ ListsService = (*Client)(nil)
+
+// ListsV4Service is the interface of the v4 lists endpoints.
+type ListsV4Service interface {
+	// GetListV4Details get the details of a v4 list.
+	GetListV4Details(listID int64, urlOptions map[string]string) (*ListV4Details, error)
+
+	// GetListV4DetailsWithContext is like GetListV4Details but uses the
+	// given context for cancellation and deadlines.
+	GetListV4DetailsWithContext(ctx context.Context, listID int64, urlOptions map[string]string) (*ListV4Details, error)
+
+	// AllListV4Items returns an iterator over the items of a
+	// v4 list across all of its pages.
+	AllListV4Items(ctx context.Context, listID int64, urlOptions map[string]string, opts PaginateOptions) iter.Seq2[ListV4Result, error]
+
+	// GetListV4ItemStatus check if an item is on a v4 list.
+	// The mediaType is either "movie" or "tv".
+	GetListV4ItemStatus(listID int64, mediaID int64, mediaType string) (*ListV4ItemStatus, error)
+
+	// GetListV4ItemStatusWithContext is like GetListV4ItemStatus but
+	// uses the given context for cancellation and deadlines.
+	GetListV4ItemStatusWithContext(ctx context.Context, listID int64, mediaID int64, mediaType string) (*ListV4ItemStatus, error)
+
+	// CreateListV4 creates a v4 list.
+	CreateListV4(list *ListV4Create) (*ListV4Response, error)
+
+	// CreateListV4WithContext is like CreateListV4 but uses the
+	// given context for cancellation and deadlines.
+	CreateListV4WithContext(ctx context.Context, list *ListV4Create) (*ListV4Response, error)
+
+	// UpdateListV4 updates the details of a v4 list.
+	UpdateListV4(listID int64, list *ListV4Update) (*ListV4Response, error)
+
+	// UpdateListV4WithContext is like UpdateListV4 but uses the
+	// given context for cancellation and deadlines.
+	UpdateListV4WithContext(ctx context.Context, listID int64, list *ListV4Update) (*ListV4Response, error)
+
+	// ClearListV4 clear all of the items from a v4 list.
+	ClearListV4(listID int64) (*ListV4ClearResponse, error)
+
+	// ClearListV4WithContext is like ClearListV4 but uses the
+	// given context for cancellation and deadlines.
+	ClearListV4WithContext(ctx context.Context, listID int64) (*ListV4ClearResponse, error)
+
+	// DeleteListV4 deletes a v4 list.
+	DeleteListV4(listID int64) (*ListV4Response, error)
+
+	// DeleteListV4WithContext is like DeleteListV4 but uses the
+	// given context for cancellation and deadlines.
+	DeleteListV4WithContext(ctx context.Context, listID int64) (*ListV4Response, error)
+
+	// AddListV4Items add movies and TV shows to a v4 list.
+	AddListV4Items(listID int64, items []ListV4Item) (*ListV4ItemsResponse, error)
+
+	// AddListV4ItemsWithContext is like AddListV4Items but uses the
+	// given context for cancellation and deadlines.
+	AddListV4ItemsWithContext(ctx context.Context, listID int64, items []ListV4Item) (*ListV4ItemsResponse, error)
+
+	// UpdateListV4Items update the comments of items on a v4 list.
+	UpdateListV4Items(listID int64, items []ListV4Item) (*ListV4ItemsResponse, error)
+
+	// UpdateListV4ItemsWithContext is like UpdateListV4Items but uses the
+	// given context for cancellation and deadlines.
+	UpdateListV4ItemsWithContext(ctx context.Context, listID int64, items []ListV4Item) (*ListV4ItemsResponse, error)
+
+	// RemoveListV4Items remove movies and TV shows from a v4 list.
+	RemoveListV4Items(listID int64, items []ListV4Item) (*ListV4ItemsResponse, error)
+
+	// RemoveListV4ItemsWithContext is like RemoveListV4Items but uses the
+	// given context for cancellation and deadlines.
+	RemoveListV4ItemsWithContext(ctx context.Context, listID int64, items []ListV4Item) (*ListV4ItemsResponse, error)
+}
+
+var _ ListsV4Service = (*Client)(nil)
+
+// MoviesService is the interface of the movies endpoints.
+type MoviesService interface {
+	// GetMovieDetails get the primary information about a movie.
+	GetMovieDetails(id int, urlOptions map[string]string) (*MovieDetails, error)
+
+	// GetMovieDetailsWithContext is like GetMovieDetails but uses the
+	// given context for cancellation and deadlines.
+	GetMovieDetailsWithContext(ctx context.Context, id int, urlOptions map[string]string) (*MovieDetails, error)
+
+	// GetMovieAccountStates grab the following account states for a session:
+	GetMovieAccountStates(id int, urlOptions map[string]string) (*MovieAccountStates, error)
+
+	// GetMovieAccountStatesWithContext is like GetMovieAccountStates but uses the
+	// given context for cancellation and deadlines.
+	GetMovieAccountStatesWithContext(ctx context.Context, id int, urlOptions map[string]string) (*MovieAccountStates, error)
+
+	// GetMovieAlternativeTitles get all of the alternative titles for a movie.
+	GetMovieAlternativeTitles(id int, urlOptions map[string]string) (*MovieAlternativeTitles, error)
+
+	// GetMovieAlternativeTitlesWithContext is like GetMovieAlternativeTitles but uses the
+	// given context for cancellation and deadlines.
+	GetMovieAlternativeTitlesWithContext(ctx context.Context, id int, urlOptions map[string]string) (*MovieAlternativeTitles, error)
+
+	// GetMovieChanges get the changes for a movie.
+	GetMovieChanges(id int, urlOptions map[string]string) (*MovieChanges, error)
+
+	// GetMovieChangesWithContext is like GetMovieChanges but uses the
+	// given context for cancellation and deadlines.
+	GetMovieChangesWithContext(ctx context.Context, id int, urlOptions map[string]string) (*MovieChanges, error)
+
+	// GetMovieCredits get the cast and crew for a movie.
+	GetMovieCredits(id int, urlOptions map[string]string) (*MovieCredits, error)
+
+	// GetMovieCreditsWithContext is like GetMovieCredits but uses the
+	// given context for cancellation and deadlines.
+	GetMovieCreditsWithContext(ctx context.Context, id int, urlOptions map[string]string) (*MovieCredits, error)
+
+	// GetMovieExternalIDs get the external ids for a movie.
+	GetMovieExternalIDs(id int, urlOptions map[string]string) (*MovieExternalIDs, error)
+
+	// GetMovieExternalIDsWithContext is like GetMovieExternalIDs but uses the
+	// given context for cancellation and deadlines.
+	GetMovieExternalIDsWithContext(ctx context.Context, id int, urlOptions map[string]string) (*MovieExternalIDs, error)
+
+	// GetMovieImages get the images that belong to a movie.
+	GetMovieImages(id int, urlOptions map[string]string) (*MovieImages, error)
+
+	// GetMovieImagesWithContext is like GetMovieImages but uses the
+	// given context for cancellation and deadlines.
+	GetMovieImagesWithContext(ctx context.Context, id int, urlOptions map[string]string) (*MovieImages, error)
+
+	// GetMovieKeywords get the keywords that have been added to a movie.
+	GetMovieKeywords(id int) (*MovieKeywords, error)
+
+	// GetMovieKeywordsWithContext is like GetMovieKeywords but uses the
+	// given context for cancellation and deadlines.
+	GetMovieKeywordsWithContext(ctx context.Context, id int) (*MovieKeywords, error)
+
+	// GetMovieReleaseDates get the release date along with the certification for a movie.
+	GetMovieReleaseDates(id int) (*MovieReleaseDates, error)
+
+	// GetMovieReleaseDatesWithContext is like GetMovieReleaseDates but uses the
+	// given context for cancellation and deadlines.
+	GetMovieReleaseDatesWithContext(ctx context.Context, id int) (*MovieReleaseDates, error)
+
+	// GetMovieVideos get the videos that have been added to a movie.
+	GetMovieVideos(id int, urlOptions map[string]string) (*VideoResults, error)
+
+	// GetMovieVideosWithContext is like GetMovieVideos but uses the
+	// given context for cancellation and deadlines.
+	GetMovieVideosWithContext(ctx context.Context, id int, urlOptions map[string]string) (*VideoResults, error)
+
+	// GetMovieWatchProviders get a list of the availabilities per country by provider for a movie.
+	GetMovieWatchProviders(id int, urlOptions map[string]string) (*WatchProviderResults, error)
+
+	// GetMovieWatchProvidersWithContext is like GetMovieWatchProviders but uses the
+	// given context for cancellation and deadlines.
+	GetMovieWatchProvidersWithContext(ctx context.Context, id int, urlOptions map[string]string) (*WatchProviderResults, error)
+
+	// GetMovieTranslations get a list of translations that have been created for a movie.
+	GetMovieTranslations(id int, urlOptions map[string]string) (*MovieTranslations, error)
+
+	// GetMovieTranslationsWithContext is like GetMovieTranslations but uses the
+	// given context for cancellation and deadlines.
+	GetMovieTranslationsWithContext(ctx context.Context, id int, urlOptions map[string]string) (*MovieTranslations, error)
+
+	// GetMovieRecommendations get a list of recommended movies for a movie.
+	GetMovieRecommendations(id int, urlOptions map[string]string) (*MovieRecommendations, error)
+
+	// GetMovieRecommendationsWithContext is like GetMovieRecommendations but uses the
+	// given context for cancellation and deadlines.
+	GetMovieRecommendationsWithContext(ctx context.Context, id int, urlOptions map[string]string) (*MovieRecommendations, error)
+
+	// GetMovieSimilar get a list of similar movies.
+	GetMovieSimilar(id int, urlOptions map[string]string) (*MovieSimilar, error)
+
+	// GetMovieSimilarWithContext is like GetMovieSimilar but uses the
+	// given context for cancellation and deadlines.
+	GetMovieSimilarWithContext(ctx context.Context, id int, urlOptions map[string]string) (*MovieSimilar, error)
+
+	// GetMovieReviews get the user reviews for a movie.
+	GetMovieReviews(id int, urlOptions map[string]string) (*MovieReviews, error)
+
+	// GetMovieReviewsWithContext is like GetMovieReviews but uses the
+	// given context for cancellation and deadlines.
+	GetMovieReviewsWithContext(ctx context.Context, id int, urlOptions map[string]string) (*MovieReviews, error)
+
+	// GetMovieLists get a list of lists that this movie belongs to.
+	GetMovieLists(id int, urlOptions map[string]string) (*MovieLists, error)
+
+	// GetMovieListsWithContext is like GetMovieLists but uses the
+	// given context for cancellation and deadlines.
+	GetMovieListsWithContext(ctx context.Context, id int, urlOptions map[string]string) (*MovieLists, error)
+
+	// GetMovieLatest get the most newly created movie.
+	GetMovieLatest(urlOptions map[string]string) (*MovieLatest, error)
+
+	// GetMovieLatestWithContext is like GetMovieLatest but uses the
+	// given context for cancellation and deadlines.
+	GetMovieLatestWithContext(ctx context.Context, urlOptions map[string]string) (*MovieLatest, error)
+
+	// GetMovieNowPlaying get a list of movies in theatres.
+	GetMovieNowPlaying(urlOptions map[string]string) (*MovieNowPlaying, error)
+
+	// GetMovieNowPlayingWithContext is like GetMovieNowPlaying but uses the
+	// given context for cancellation and deadlines.
+	GetMovieNowPlayingWithContext(ctx context.Context, urlOptions map[string]string) (*MovieNowPlaying, error)
+
+	// GetMoviePopular get a list of the current popular movies on TMDb.
+	GetMoviePopular(urlOptions map[string]string) (*MoviePopular, error)
+
+	// GetMoviePopularWithContext is like GetMoviePopular but uses the
+	// given context for cancellation and deadlines.
+	GetMoviePopularWithContext(ctx context.Context, urlOptions map[string]string) (*MoviePopular, error)
+
+	// GetMovieTopRated get the top rated movies on TMDb.
+	GetMovieTopRated(urlOptions map[string]string) (*MovieTopRated, error)
+
+	// GetMovieTopRatedWithContext is like GetMovieTopRated but uses the
+	// given context for cancellation and deadlines.
+	GetMovieTopRatedWithContext(ctx context.Context, urlOptions map[string]string) (*MovieTopRated, error)
+
+	// GetMovieUpcoming get a list of upcoming movies in theatres.
+	GetMovieUpcoming(urlOptions map[string]string) (*MovieUpcoming, error)
+
+	// GetMovieUpcomingWithContext is like GetMovieUpcoming but uses the
+	// given context for cancellation and deadlines.
+	GetMovieUpcomingWithContext(ctx context.Context, urlOptions map[string]string) (*MovieUpcoming, error)
+
+	// PostMovieRating rate a movie.
+	PostMovieRating(id int, rating float32, urlOptions map[string]string) (*Response, error)
+
+	// PostMovieRatingWithContext is like PostMovieRating but uses the
+	// given context for cancellation and deadlines.
+	PostMovieRatingWithContext(ctx context.Context, id int, rating float32, urlOptions map[string]string) (*Response, error)
+
+	// DeleteMovieRating remove your rating for a movie.
+	DeleteMovieRating(id int, urlOptions map[string]string) (*Response, error)
+
+	// DeleteMovieRatingWithContext is like DeleteMovieRating but uses the
+	// given context for cancellation and deadlines.
+	DeleteMovieRatingWithContext(ctx context.Context, id int, urlOptions map[string]string) (*Response, error)
+}
+
+var _ MoviesService = (*Client)(nil)
+
+// NetworksService is the interface of the networks endpoints.
+type NetworksService interface {
+	// GetNetworkDetails get the details of a network.
+	GetNetworkDetails(id int) (*NetworkDetails, error)
+
+	// GetNetworkDetailsWithContext is like GetNetworkDetails but uses the
+	// given context for cancellation and deadlines.
+	GetNetworkDetailsWithContext(ctx context.Context, id int) (*NetworkDetails, error)
+
+	// GetNetworkAlternativeNames get the alternative names of a network.
+	GetNetworkAlternativeNames(id int) (*NetworkAlternativeNames, error)
+
+	// GetNetworkAlternativeNamesWithContext is like GetNetworkAlternativeNames but uses the
+	// given context for cancellation and deadlines.
+	GetNetworkAlternativeNamesWithContext(ctx context.Context, id int) (*NetworkAlternativeNames, error)
+
+	// GetNetworkImages get the TV network logos by id.
+	GetNetworkImages(id int) (*NetworkImages, error)
+
+	// GetNetworkImagesWithContext is like GetNetworkImages but uses the
+	// given context for cancellation and deadlines.
+	GetNetworkImagesWithContext(ctx context.Context, id int) (*NetworkImages, error)
+}
+
+var _ NetworksService = (*Client)(nil)
+
+// PeopleService is the interface of the people endpoints.
+type PeopleService interface {
+	// GetPersonDetails get the primary person details by id.
+	GetPersonDetails(id int, urlOptions map[string]string) (*PersonDetails, error)
+
+	// GetPersonDetailsWithContext is like GetPersonDetails but uses the
+	// given context for cancellation and deadlines.
+	GetPersonDetailsWithContext(ctx context.Context, id int, urlOptions map[string]string) (*PersonDetails, error)
+
+	// GetPersonChanges get the changes for a person.
+	// By default only the last 24 hours are returned.
+	GetPersonChanges(id int, urlOptions map[string]string) (*PersonChanges, error)
+
+	// GetPersonChangesWithContext is like GetPersonChanges but uses the
+	// given context for cancellation and deadlines.
+	GetPersonChangesWithContext(ctx context.Context, id int, urlOptions map[string]string) (*PersonChanges, error)
+
+	// GetPersonMovieCredits get the movie credits for a person.
+	GetPersonMovieCredits(id int, urlOptions map[string]string) (*PersonMovieCredits, error)
+
+	// GetPersonMovieCreditsWithContext is like GetPersonMovieCredits but uses the
+	// given context for cancellation and deadlines.
+	GetPersonMovieCreditsWithContext(ctx context.Context, id int, urlOptions map[string]string) (*PersonMovieCredits, error)
+
+	// GetPersonTVCredits get the TV show credits for a person.
+	GetPersonTVCredits(id int, urlOptions map[string]string) (*PersonTVCredits, error)
+
+	// GetPersonTVCreditsWithContext is like GetPersonTVCredits but uses the
+	// given context for cancellation and deadlines.
+	GetPersonTVCreditsWithContext(ctx context.Context, id int, urlOptions map[string]string) (*PersonTVCredits, error)
+
+	// GetPersonCombinedCredits get the movie and TV credits together in a single response.
+	GetPersonCombinedCredits(id int, urlOptions map[string]string) (*PersonCombinedCredits, error)
+
+	// GetPersonCombinedCreditsWithContext is like GetPersonCombinedCredits but uses the
+	// given context for cancellation and deadlines.
+	GetPersonCombinedCreditsWithContext(ctx context.Context, id int, urlOptions map[string]string) (*PersonCombinedCredits, error)
+
+	// GetPersonExternalIDs get the external ids for a person.
+	// We currently support the following external sources.
+	GetPersonExternalIDs(id int, urlOptions map[string]string) (*PersonExternalIDs, error)
+
+	// GetPersonExternalIDsWithContext is like GetPersonExternalIDs but uses the
+	// given context for cancellation and deadlines.
+	GetPersonExternalIDsWithContext(ctx context.Context, id int, urlOptions map[string]string) (*PersonExternalIDs, error)
+
+	// GetPersonImages get the images for a person.
+	GetPersonImages(id int) (*PersonImages, error)
+
+	// GetPersonImagesWithContext is like GetPersonImages but uses the
+	// given context for cancellation and deadlines.
+	GetPersonImagesWithContext(ctx context.Context, id int) (*PersonImages, error)
+
+	// GetPersonTranslations get a list of translations that have been created for a person.
+	GetPersonTranslations(id int, urlOptions map[string]string) (*PersonTranslations, error)
+
+	// GetPersonTranslationsWithContext is like GetPersonTranslations but uses the
+	// given context for cancellation and deadlines.
+	GetPersonTranslationsWithContext(ctx context.Context, id int, urlOptions map[string]string) (*PersonTranslations, error)
+
+	// GetPersonLatest get the most newly created person.
+	// This is a live response and will continuously change.
+	GetPersonLatest(urlOptions map[string]string) (*PersonLatest, error)
+
+	// GetPersonLatestWithContext is like GetPersonLatest but uses the
+	// given context for cancellation and deadlines.
+	GetPersonLatestWithContext(ctx context.Context, urlOptions map[string]string) (*PersonLatest, error)
+
+	// GetPersonPopular get the list of popular people on TMDb.
+	// This list updates daily.
+	GetPersonPopular(urlOptions map[string]string) (*PersonPopular, error)
+
+	// GetPersonPopularWithContext is like GetPersonPopular but uses the
+	// given context for cancellation and deadlines.
+	GetPersonPopularWithContext(ctx context.Context, urlOptions map[string]string) (*PersonPopular, error)
+}
+
+var _ PeopleService = (*Client)(nil)
+
+// ReviewsService is the interface of the reviews endpoints.
+type ReviewsService interface {
+	// GetReviewDetails get review details by id.
+	GetReviewDetails(id string) (*ReviewDetails, error)
+
+	// GetReviewDetailsWithContext is like GetReviewDetails but uses the
+	// given context for cancellation and deadlines.
+	GetReviewDetailsWithContext(ctx context.Context, id string) (*ReviewDetails, error)
+}
+
+var _ ReviewsService = (*Client)(nil)
+
+// SearchService is the interface of the search endpoints.
+type SearchService interface {
+	// GetSearchCompanies search for companies.
+	GetSearchCompanies(query string, urlOptions map[string]string) (*SearchCompanies, error)
+
+	// GetSearchCompaniesWithContext is like GetSearchCompanies but uses the
+	// given context for cancellation and deadlines.
+	GetSearchCompaniesWithContext(ctx context.Context, query string, urlOptions map[string]string) (*SearchCompanies, error)
+
+	// GetSearchCollections search for collections.
+	GetSearchCollections(query string, urlOptions map[string]string) (*SearchCollections, error)
+
+	// GetSearchCollectionsWithContext is like GetSearchCollections but uses the
+	// given context for cancellation and deadlines.
+	GetSearchCollectionsWithContext(ctx context.Context, query string, urlOptions map[string]string) (*SearchCollections, error)
+
+	// GetSearchKeywords search for keywords.
+	GetSearchKeywords(query string, urlOptions map[string]string) (*SearchKeywords, error)
+
+	// GetSearchKeywordsWithContext is like GetSearchKeywords but uses the
+	// given context for cancellation and deadlines.
+	GetSearchKeywordsWithContext(ctx context.Context, query string, urlOptions map[string]string) (*SearchKeywords, error)
+
+	// GetSearchMovies search for keywords.
+	GetSearchMovies(query string, urlOptions map[string]string) (*SearchMovies, error)
+
+	// GetSearchMoviesWithContext is like GetSearchMovies but uses the
+	// given context for cancellation and deadlines.
+	GetSearchMoviesWithContext(ctx context.Context, query string, urlOptions map[string]string) (*SearchMovies, error)
+
+	// GetSearchMulti search multiple models in a single request.
+	// Multi search currently supports searching for movies,
+	// tv shows and people in a single request.
+	GetSearchMulti(query string, urlOptions map[string]string) (*SearchMulti, error)
+
+	// GetSearchMultiWithContext is like GetSearchMulti but uses the
+	// given context for cancellation and deadlines.
+	GetSearchMultiWithContext(ctx context.Context, query string, urlOptions map[string]string) (*SearchMulti, error)
+
+	// GetSearchPeople search for people.
+	GetSearchPeople(query string, urlOptions map[string]string) (*SearchPeople, error)
+
+	// GetSearchPeopleWithContext is like GetSearchPeople but uses the
+	// given context for cancellation and deadlines.
+	GetSearchPeopleWithContext(ctx context.Context, query string, urlOptions map[string]string) (*SearchPeople, error)
+
+	// GetSearchTVShow search for a TV Show.
+	GetSearchTVShow(query string, urlOptions map[string]string) (*SearchTVShows, error)
+
+	// GetSearchTVShowWithContext is like GetSearchTVShow but uses the
+	// given context for cancellation and deadlines.
+	GetSearchTVShowWithContext(ctx context.Context, query string, urlOptions map[string]string) (*SearchTVShows, error)
+}
+
+var _ SearchService = (*Client)(nil)
+
+// TrendingService is the interface of the trending endpoints.
+type TrendingService interface {
+	// GetTrending get the daily or weekly trending items.
+	GetTrending(mediaType string, timeWindow string, urlOptions map[string]string) (*Trending, error)
+
+	// GetTrendingWithContext is like GetTrending but uses the
+	// given context for cancellation and deadlines.
+	GetTrendingWithContext(ctx context.Context, mediaType string, timeWindow string, urlOptions map[string]string) (*Trending, error)
+}
+
+var _ TrendingService = (*Client)(nil)
+
+// TVService is the interface of the TV series and episode groups endpoints.
+type TVService interface {
+	// GetTVDetails get the primary TV show details by id.
+	GetTVDetails(id int, urlOptions map[string]string) (*TVDetails, error)
+
+	// GetTVDetailsWithContext is like GetTVDetails but uses the
+	// given context for cancellation and deadlines.
+	GetTVDetailsWithContext(ctx context.Context, id int, urlOptions map[string]string) (*TVDetails, error)
+
+	// GetTVAccountStates grab the following account states for a session:
+	GetTVAccountStates(id int, urlOptions map[string]string) (*TVAccountStates, error)
+
+	// GetTVAccountStatesWithContext is like GetTVAccountStates but uses the
+	// given context for cancellation and deadlines.
+	GetTVAccountStatesWithContext(ctx context.Context, id int, urlOptions map[string]string) (*TVAccountStates, error)
+
+	// GetTVAggregateCredits get the aggregate credits (cast and crew) that have been added to a TV show.
+	GetTVAggregateCredits(id int, urlOptions map[string]string) (*TVAggregateCredits, error)
+
+	// GetTVAggregateCreditsWithContext is like GetTVAggregateCredits but uses the
+	// given context for cancellation and deadlines.
+	GetTVAggregateCreditsWithContext(ctx context.Context, id int, urlOptions map[string]string) (*TVAggregateCredits, error)
+
+	// GetTVAlternativeTitles get all of the alternative titles for a TV show.
+	GetTVAlternativeTitles(id int, urlOptions map[string]string) (*TVAlternativeTitles, error)
+
+	// GetTVAlternativeTitlesWithContext is like GetTVAlternativeTitles but uses the
+	// given context for cancellation and deadlines.
+	GetTVAlternativeTitlesWithContext(ctx context.Context, id int, urlOptions map[string]string) (*TVAlternativeTitles, error)
+
+	// GetTVChanges get the changes for a TV show.
+	GetTVChanges(id int, urlOptions map[string]string) (*TVChanges, error)
+
+	// GetTVChangesWithContext is like GetTVChanges but uses the
+	// given context for cancellation and deadlines.
+	GetTVChangesWithContext(ctx context.Context, id int, urlOptions map[string]string) (*TVChanges, error)
+
+	// GetTVContentRatings get the list of content ratings (certifications) that have been added to a TV show.
+	GetTVContentRatings(id int, urlOptions map[string]string) (*TVContentRatings, error)
+
+	// GetTVContentRatingsWithContext is like GetTVContentRatings but uses the
+	// given context for cancellation and deadlines.
+	GetTVContentRatingsWithContext(ctx context.Context, id int, urlOptions map[string]string) (*TVContentRatings, error)
+
+	// GetTVCredits get the credits (cast and crew) that have been added to a TV show.
+	GetTVCredits(id int, urlOptions map[string]string) (*TVCredits, error)
+
+	// GetTVCreditsWithContext is like GetTVCredits but uses the
+	// given context for cancellation and deadlines.
+	GetTVCreditsWithContext(ctx context.Context, id int, urlOptions map[string]string) (*TVCredits, error)
+
+	// GetTVEpisodeGroups get all of the episode groups that have been created for a TV show.
+	GetTVEpisodeGroups(id int, urlOptions map[string]string) (*TVEpisodeGroups, error)
+
+	// GetTVEpisodeGroupsWithContext is like GetTVEpisodeGroups but uses the
+	// given context for cancellation and deadlines.
+	GetTVEpisodeGroupsWithContext(ctx context.Context, id int, urlOptions map[string]string) (*TVEpisodeGroups, error)
+
+	// GetTVExternalIDs get the external ids for a TV show.
+	GetTVExternalIDs(id int, urlOptions map[string]string) (*TVExternalIDs, error)
+
+	// GetTVExternalIDsWithContext is like GetTVExternalIDs but uses the
+	// given context for cancellation and deadlines.
+	GetTVExternalIDsWithContext(ctx context.Context, id int, urlOptions map[string]string) (*TVExternalIDs, error)
+
+	// GetTVImages get the images that belong to a TV show.
+	GetTVImages(id int, urlOptions map[string]string) (*TVImages, error)
+
+	// GetTVImagesWithContext is like GetTVImages but uses the
+	// given context for cancellation and deadlines.
+	GetTVImagesWithContext(ctx context.Context, id int, urlOptions map[string]string) (*TVImages, error)
+
+	// GetTVKeywords get the keywords that have been added to a TV show.
+	GetTVKeywords(id int) (*TVKeywords, error)
+
+	// GetTVKeywordsWithContext is like GetTVKeywords but uses the
+	// given context for cancellation and deadlines.
+	GetTVKeywordsWithContext(ctx context.Context, id int) (*TVKeywords, error)
+
+	// GetTVRecommendations get the list of TV show recommendations for this item.
+	GetTVRecommendations(id int, urlOptions map[string]string) (*TVRecommendations, error)
+
+	// GetTVRecommendationsWithContext is like GetTVRecommendations but uses the
+	// given context for cancellation and deadlines.
+	GetTVRecommendationsWithContext(ctx context.Context, id int, urlOptions map[string]string) (*TVRecommendations, error)
+
+	// GetTVReviews get the reviews for a TV show.
+	GetTVReviews(id int, urlOptions map[string]string) (*TVReviews, error)
+
+	// GetTVReviewsWithContext is like GetTVReviews but uses the
+	// given context for cancellation and deadlines.
+	GetTVReviewsWithContext(ctx context.Context, id int, urlOptions map[string]string) (*TVReviews, error)
+
+	// GetTVScreenedTheatrically get a list of seasons or episodes that
+	// have been screened in a film festival or theatre.
+	GetTVScreenedTheatrically(id int) (*TVScreenedTheatrically, error)
+
+	// GetTVScreenedTheatricallyWithContext is like GetTVScreenedTheatrically but uses the
+	// given context for cancellation and deadlines.
+	GetTVScreenedTheatricallyWithContext(ctx context.Context, id int) (*TVScreenedTheatrically, error)
+
+	// GetTVSimilar a list of similar TV shows.
+	// These items are assembled by looking at keywords and genres.
+	GetTVSimilar(id int, urlOptions map[string]string) (*TVSimilar, error)
+
+	// GetTVSimilarWithContext is like GetTVSimilar but uses the
+	// given context for cancellation and deadlines.
+	GetTVSimilarWithContext(ctx context.Context, id int, urlOptions map[string]string) (*TVSimilar, error)
+
+	// GetTVWatchProviders get a list of the availabilities per country by provider for a TV show.
+	GetTVWatchProviders(id int, urlOptions map[string]string) (*WatchProviderResults, error)
+
+	// GetTVWatchProvidersWithContext is like GetTVWatchProviders but uses the
+	// given context for cancellation and deadlines.
+	GetTVWatchProvidersWithContext(ctx context.Context, id int, urlOptions map[string]string) (*WatchProviderResults, error)
+
+	// GetTVTranslations get a list fo translations that have been created for a TV Show.
+	GetTVTranslations(id int, urlOptions map[string]string) (*TVTranslations, error)
+
+	// GetTVTranslationsWithContext is like GetTVTranslations but uses the
+	// given context for cancellation and deadlines.
+	GetTVTranslationsWithContext(ctx context.Context, id int, urlOptions map[string]string) (*TVTranslations, error)
+
+	// GetTVVideos get the videos that have been added to a TV show.
+	GetTVVideos(id int, urlOptions map[string]string) (*VideoResults, error)
+
+	// GetTVVideosWithContext is like GetTVVideos but uses the
+	// given context for cancellation and deadlines.
+	GetTVVideosWithContext(ctx context.Context, id int, urlOptions map[string]string) (*VideoResults, error)
+
+	// GetTVLatest get the most newly created TV show.
+	GetTVLatest(urlOptions map[string]string) (*TVLatest, error)
+
+	// GetTVLatestWithContext is like GetTVLatest but uses the
+	// given context for cancellation and deadlines.
+	GetTVLatestWithContext(ctx context.Context, urlOptions map[string]string) (*TVLatest, error)
+
+	// GetTVAiringToday get a list of TV shows that are airing today.
+	// This query is purely day based as we do not currently support
+	// airing times.
+	GetTVAiringToday(urlOptions map[string]string) (*TVAiringToday, error)
+
+	// GetTVAiringTodayWithContext is like GetTVAiringToday but uses the
+	// given context for cancellation and deadlines.
+	GetTVAiringTodayWithContext(ctx context.Context, urlOptions map[string]string) (*TVAiringToday, error)
+
+	// GetTVOnTheAir get a list of shows that are currently on the air.
+	GetTVOnTheAir(urlOptions map[string]string) (*TVOnTheAir, error)
+
+	// GetTVOnTheAirWithContext is like GetTVOnTheAir but uses the
+	// given context for cancellation and deadlines.
+	GetTVOnTheAirWithContext(ctx context.Context, urlOptions map[string]string) (*TVOnTheAir, error)
+
+	// GetTVPopular get a list of the current popular TV shows on TMDb.
+	// This list updates daily.
+	GetTVPopular(urlOptions map[string]string) (*TVPopular, error)
+
+	// GetTVPopularWithContext is like GetTVPopular but uses the
+	// given context for cancellation and deadlines.
+	GetTVPopularWithContext(ctx context.Context, urlOptions map[string]string) (*TVPopular, error)
+
+	// GetTVTopRated get a list of the top rated TV shows on TMDb.
+	GetTVTopRated(urlOptions map[string]string) (*TVTopRated, error)
+
+	// GetTVTopRatedWithContext is like GetTVTopRated but uses the
+	// given context for cancellation and deadlines.
+	GetTVTopRatedWithContext(ctx context.Context, urlOptions map[string]string) (*TVTopRated, error)
+
+	// PostTVShowRating rate a TV show.
+	PostTVShowRating(id int, rating float32, urlOptions map[string]string) (*Response, error)
+
+	// PostTVShowRatingWithContext is like PostTVShowRating but uses the
+	// given context for cancellation and deadlines.
+	PostTVShowRatingWithContext(ctx context.Context, id int, rating float32, urlOptions map[string]string) (*Response, error)
+
+	// DeleteTVShowRating remove your rating for a TV show.
+	DeleteTVShowRating(id int, urlOptions map[string]string) (*Response, error)
+
+	// DeleteTVShowRatingWithContext is like DeleteTVShowRating but uses the
+	// given context for cancellation and deadlines.
+	DeleteTVShowRatingWithContext(ctx context.Context, id int, urlOptions map[string]string) (*Response, error)
+
+	// GetTVEpisodeGroupsDetails the details of a TV episode group.
+	// Groups support 7 different types which are enumerated as the following:
+	GetTVEpisodeGroupsDetails(id string, urlOptions map[string]string) (*TVEpisodeGroupsDetails, error)
+
+	// GetTVEpisodeGroupsDetailsWithContext is like GetTVEpisodeGroupsDetails but uses the
+	// given context for cancellation and deadlines.
+	GetTVEpisodeGroupsDetailsWithContext(ctx context.Context, id string, urlOptions map[string]string) (*TVEpisodeGroupsDetails, error)
+}
+
+var _ TVService = (*Client)(nil)
+
+// TVEpisodesService is the interface of the TV episodes endpoints.
+type TVEpisodesService interface {
+	// GetTVEpisodeDetails get the TV episode details by id.
+	GetTVEpisodeDetails(id int, seasonNumber int, episodeNumber int, urlOptions map[string]string) (*TVEpisodeDetails, error)
+
+	// GetTVEpisodeDetailsWithContext is like GetTVEpisodeDetails but uses the
+	// given context for cancellation and deadlines.
+	GetTVEpisodeDetailsWithContext(ctx context.Context, id int, seasonNumber int, episodeNumber int, urlOptions map[string]string) (*TVEpisodeDetails, error)
+
+	// GetTVEpisodeChanges get the changes for a TV episode.
+	// By default only the last 24 hours are returned.
+	GetTVEpisodeChanges(id int, urlOptions map[string]string) (*TVEpisodeChanges, error)
+
+	// GetTVEpisodeChangesWithContext is like GetTVEpisodeChanges but uses the
+	// given context for cancellation and deadlines.
+	GetTVEpisodeChangesWithContext(ctx context.Context, id int, urlOptions map[string]string) (*TVEpisodeChanges, error)
+
+	// GetTVEpisodeCredits get the credits (cast, crew and guest stars) for a TV episode.
+	GetTVEpisodeCredits(id int, seasonNumber int, episodeNumber int) (*TVEpisodeCredits, error)
+
+	// GetTVEpisodeCreditsWithContext is like GetTVEpisodeCredits but uses the
+	// given context for cancellation and deadlines.
+	GetTVEpisodeCreditsWithContext(ctx context.Context, id int, seasonNumber int, episodeNumber int) (*TVEpisodeCredits, error)
+
+	// GetTVEpisodeExternalIDs get the external ids for a TV episode.
+	// We currently support the following external sources.
+	GetTVEpisodeExternalIDs(id int, seasonNumber int, episodeNumber int) (*TVEpisodeExternalIDs, error)
+
+	// GetTVEpisodeExternalIDsWithContext is like GetTVEpisodeExternalIDs but uses the
+	// given context for cancellation and deadlines.
+	GetTVEpisodeExternalIDsWithContext(ctx context.Context, id int, seasonNumber int, episodeNumber int) (*TVEpisodeExternalIDs, error)
+
+	// GetTVEpisodeImages get the images that belong to a TV episode.
+	GetTVEpisodeImages(id int, seasonNumber int, episodeNumber int) (*TVEpisodeImages, error)
+
+	// GetTVEpisodeImagesWithContext is like GetTVEpisodeImages but uses the
+	// given context for cancellation and deadlines.
+	GetTVEpisodeImagesWithContext(ctx context.Context, id int, seasonNumber int, episodeNumber int) (*TVEpisodeImages, error)
+
+	// GetTVEpisodeTranslations get the translation data for an episode.
+	GetTVEpisodeTranslations(id int, seasonNumber int, episodeNumber int) (*TVEpisodeTranslations, error)
+
+	// GetTVEpisodeTranslationsWithContext is like GetTVEpisodeTranslations but uses the
+	// given context for cancellation and deadlines.
+	GetTVEpisodeTranslationsWithContext(ctx context.Context, id int, seasonNumber int, episodeNumber int) (*TVEpisodeTranslations, error)
+
+	// GetTVEpisodeAccountStates get your rating for an episode.
+	GetTVEpisodeAccountStates(id int, seasonNumber int, episodeNumber int, urlOptions map[string]string) (*TVEpisodeAccountStates, error)
+
+	// GetTVEpisodeAccountStatesWithContext is like GetTVEpisodeAccountStates
+	// but uses the given context for cancellation and deadlines.
+	GetTVEpisodeAccountStatesWithContext(ctx context.Context, id int, seasonNumber int, episodeNumber int, urlOptions map[string]string) (*TVEpisodeAccountStates, error)
+
+	// PostTVEpisodeRating rate a TV episode.
+	PostTVEpisodeRating(id int, seasonNumber int, episodeNumber int, rating float32, urlOptions map[string]string) (*TVEpisodeRate, error)
+
+	// PostTVEpisodeRatingWithContext is like PostTVEpisodeRating but uses the
+	// given context for cancellation and deadlines.
+	PostTVEpisodeRatingWithContext(ctx context.Context, id int, seasonNumber int, episodeNumber int, rating float32, urlOptions map[string]string) (*TVEpisodeRate, error)
+
+	// DeleteTVEpisodeRating remove your rating for a TV episode.
+	DeleteTVEpisodeRating(id int, seasonNumber int, episodeNumber int, urlOptions map[string]string) (*TVEpisodeRate, error)
+
+	// DeleteTVEpisodeRatingWithContext is like DeleteTVEpisodeRating but uses
+	// the given context for cancellation and deadlines.
+	DeleteTVEpisodeRatingWithContext(ctx context.Context, id int, seasonNumber int, episodeNumber int, urlOptions map[string]string) (*TVEpisodeRate, error)
+
+	// GetTVEpisodeVideos get the videos that have been added to a TV episode.
+	GetTVEpisodeVideos(id int, seasonNumber int, episodeNumber int, urlOptions map[string]string) (*VideoResults, error)
+
+	// GetTVEpisodeVideosWithContext is like GetTVEpisodeVideos but uses the
+	// given context for cancellation and deadlines.
+	GetTVEpisodeVideosWithContext(ctx context.Context, id int, seasonNumber int, episodeNumber int, urlOptions map[string]string) (*VideoResults, error)
+}
+
+var _ TVEpisodesService = (*Client)(nil)
+
+// TVSeasonsService is the interface of the TV seasons endpoints.
+type TVSeasonsService interface {
+	// GetTVSeasonDetails get the TV season details by id.
+	GetTVSeasonDetails(id int, seasonNumber int, urlOptions map[string]string) (*TVSeasonDetails, error)
+
+	// GetTVSeasonDetailsWithContext is like GetTVSeasonDetails but uses the
+	// given context for cancellation and deadlines.
+	GetTVSeasonDetailsWithContext(ctx context.Context, id int, seasonNumber int, urlOptions map[string]string) (*TVSeasonDetails, error)
+
+	// GetTVSeasonAccountStates get your ratings for the episodes of a season.
+	GetTVSeasonAccountStates(id int, seasonNumber int, urlOptions map[string]string) (*TVSeasonAccountStates, error)
+
+	// GetTVSeasonAccountStatesWithContext is like GetTVSeasonAccountStates
+	// but uses the given context for cancellation and deadlines.
+	GetTVSeasonAccountStatesWithContext(ctx context.Context, id int, seasonNumber int, urlOptions map[string]string) (*TVSeasonAccountStates, error)
+
+	// GetTVSeasonChanges get the changes for a TV season.
+	// By default only the last 24 hours are returned.
+	GetTVSeasonChanges(id int, urlOptions map[string]string) (*TVSeasonChanges, error)
+
+	// GetTVSeasonChangesWithContext is like GetTVSeasonChanges but uses the
+	// given context for cancellation and deadlines.
+	GetTVSeasonChangesWithContext(ctx context.Context, id int, urlOptions map[string]string) (*TVSeasonChanges, error)
+
+	// GetTVSeasonCredits get the credits for TV season.
+	GetTVSeasonCredits(id int, seasonNumber int, urlOptions map[string]string) (*TVSeasonCredits, error)
+
+	// GetTVSeasonCreditsWithContext is like GetTVSeasonCredits but uses the
+	// given context for cancellation and deadlines.
+	GetTVSeasonCreditsWithContext(ctx context.Context, id int, seasonNumber int, urlOptions map[string]string) (*TVSeasonCredits, error)
+
+	// GetTVSeasonExternalIDs get the external ids for a TV season.
+	// We currently support the following external sources.
+	GetTVSeasonExternalIDs(id int, seasonNumber int, urlOptions map[string]string) (*TVSeasonExternalIDs, error)
+
+	// GetTVSeasonExternalIDsWithContext is like GetTVSeasonExternalIDs but uses the
+	// given context for cancellation and deadlines.
+	GetTVSeasonExternalIDsWithContext(ctx context.Context, id int, seasonNumber int, urlOptions map[string]string) (*TVSeasonExternalIDs, error)
+
+	// GetTVSeasonImages get the images that belong to a TV season.
+	GetTVSeasonImages(id int, seasonNumber int, urlOptions map[string]string) (*TVSeasonImages, error)
+
+	// GetTVSeasonImagesWithContext is like GetTVSeasonImages but uses the
+	// given context for cancellation and deadlines.
+	GetTVSeasonImagesWithContext(ctx context.Context, id int, seasonNumber int, urlOptions map[string]string) (*TVSeasonImages, error)
+
+	// GetTVSeasonVideos get the videos that have been added to a TV season.
+	GetTVSeasonVideos(id int, seasonNumber int, urlOptions map[string]string) (*VideoResults, error)
+
+	// GetTVSeasonVideosWithContext is like GetTVSeasonVideos but uses the
+	// given context for cancellation and deadlines.
+	GetTVSeasonVideosWithContext(ctx context.Context, id int, seasonNumber int, urlOptions map[string]string) (*VideoResults, error)
+
+	// GetTVSeasonTranslations get the translation data for an season.
+	GetTVSeasonTranslations(id int, seasonNumber int) (*TVSeasonTranslations, error)
+
+	// GetTVSeasonTranslationsWithContext is like GetTVSeasonTranslations but uses the
+	// given context for cancellation and deadlines.
+	GetTVSeasonTranslationsWithContext(ctx context.Context, id int, seasonNumber int) (*TVSeasonTranslations, error)
+}
+
+var _ TVSeasonsService = (*Client)(nil)
+
+// WatchProvidersService is the interface of the watch providers endpoints.
+type WatchProvidersService interface {
+	// GetAvailableWatchProviderRegions get a list of all of the countries we have watch provider (OTT/streaming) data for.
+	GetAvailableWatchProviderRegions(urlOptions map[string]string) (*WatchRegionList, error)
+
+	// GetAvailableWatchProviderRegionsWithContext is like GetAvailableWatchProviderRegions but uses the
+	// given context for cancellation and deadlines.
+	GetAvailableWatchProviderRegionsWithContext(ctx context.Context, urlOptions map[string]string) (*WatchRegionList, error)
+
+	// GetWatchProvidersMovie get a list of the watch provider (OTT/streaming) data we have available for movies.
+	// You can specify a watch_region param if you want to further filter the list by country.
+	GetWatchProvidersMovie(urlOptions map[string]string) (*WatchProviderList, error)
+
+	// GetWatchProvidersMovieWithContext is like GetWatchProvidersMovie but uses the
+	// given context for cancellation and deadlines.
+	GetWatchProvidersMovieWithContext(ctx context.Context, urlOptions map[string]string) (*WatchProviderList, error)
+
+	// GetWatchProvidersTv get a list of the watch provider (OTT/streaming) data we have available for TV series.
+	// You can specify a watch_region param if you want to further filter the list by country.
+	GetWatchProvidersTv(urlOptions map[string]string) (*WatchProviderList, error)
+
+	// GetWatchProvidersTvWithContext is like GetWatchProvidersTv but uses the
+	// given context for cancellation and deadlines.
+	GetWatchProvidersTvWithContext(ctx context.Context, urlOptions map[string]string) (*WatchProviderList, error)
+}
+
+var _ WatchProvidersService = (*Client)(nil)
@@ -0,0 +1,55 @@
+package tmdb
+
+import (
+	"context"
+	"errors"
+)
+
+// movieTitle is a consumer depending only on the MoviesService.
+func movieTitle(movies MoviesService, id int) (string, error) {
+	movie, err := movies.GetMovieDetails(id, nil)
+	if err != nil {
+		return "", err
+	}
+	return movie.Title, nil
+}
+
+func (suite *TMBDTestSuite) TestMoviesServiceMock() {
+	mock := &MoviesServiceMock{
+		GetMovieDetailsFunc: func(id int, urlOptions map[string]string) (*MovieDetails, error) {
+			return &MovieDetails{ID: int64(id), Title: "Fight Club"}, nil
+		},
+	}
+	title, err := movieTitle(mock, 550)
+	suite.Nil(err)
+	suite.Equal("Fight Club", title)
+	calls := mock.CallsTo("GetMovieDetails")
+	suite.Len(calls, 1)
+	suite.Equal(550, calls[0].Args[0])
+	mock.ResetCalls()
+	suite.Empty(mock.Calls())
+}
+
+func (suite *TMBDTestSuite) TestServiceMockWithContextFallback() {
+	mock := &TVServiceMock{
+		GetTVDetailsWithContextFunc: func(ctx context.Context, id int, urlOptions map[string]string) (*TVDetails, error) {
+			suite.NotNil(ctx)
+			return &TVDetails{ID: int64(id)}, nil
+		},
+	}
+	tv, err := mock.GetTVDetails(1399, nil)
+	suite.Nil(err)
+	suite.Equal(int64(1399), tv.ID)
+	suite.Len(mock.CallsTo("GetTVDetails"), 1)
+	suite.Empty(mock.CallsTo("GetTVDetailsWithContext"))
+}
+
+func (suite *TMBDTestSuite) TestServiceMockNotStubbed() {
+	mock := &AccountV4ServiceMock{}
+	_, err := mock.GetAccountV4Lists("account", nil)
+	suite.True(errors.Is(err, ErrNotStubbed))
+	for _, err := range mock.AllAccountV4Lists(context.Background(), "account", nil, PaginateOptions{}) {
+		suite.True(errors.Is(err, ErrNotStubbed))
+	}
+	suite.Len(mock.Calls(), 2)
+}
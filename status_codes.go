@@ -0,0 +1,205 @@
+package tmdb
+
+import (
+	"net/http"
+	"strconv"
+)
+
+// StatusCode type is a status_code returned by the TMDb API.
+//
+// https://developer.themoviedb.org/docs/errors
+type StatusCode int
+
+// TMDb status codes.
+const (
+	StatusSuccess                 StatusCode = 1
+	StatusInvalidService          StatusCode = 2
+	StatusAuthenticationFailed    StatusCode = 3
+	StatusInvalidFormat           StatusCode = 4
+	StatusInvalidParameters       StatusCode = 5
+	StatusInvalidID               StatusCode = 6
+	StatusInvalidAPIKey           StatusCode = 7
+	StatusDuplicateEntry          StatusCode = 8
+	StatusServiceOffline          StatusCode = 9
+	StatusSuspendedAPIKey         StatusCode = 10
+	StatusInternalError           StatusCode = 11
+	StatusUpdated                 StatusCode = 12
+	StatusDeleted                 StatusCode = 13
+	StatusAuthenticationError     StatusCode = 14
+	StatusFailed                  StatusCode = 15
+	StatusDeviceDenied            StatusCode = 16
+	StatusSessionDenied           StatusCode = 17
+	StatusValidationFailed        StatusCode = 18
+	StatusInvalidAcceptHeader     StatusCode = 19
+	StatusInvalidDateRange        StatusCode = 20
+	StatusEntryNotFound           StatusCode = 21
+	StatusInvalidPage             StatusCode = 22
+	StatusInvalidDate             StatusCode = 23
+	StatusBackendTimeout          StatusCode = 24
+	StatusRequestLimitExceeded    StatusCode = 25
+	StatusCredentialsRequired     StatusCode = 26
+	StatusTooManyAppendToResponse StatusCode = 27
+	StatusInvalidTimezone         StatusCode = 28
+	StatusConfirmationRequired    StatusCode = 29
+	StatusInvalidLogin            StatusCode = 30
+	StatusAccountDisabled         StatusCode = 31
+	StatusEmailNotVerified        StatusCode = 32
+	StatusInvalidRequestToken     StatusCode = 33
+	StatusResourceNotFound        StatusCode = 34
+	StatusInvalidToken            StatusCode = 35
+	StatusWritePermissionRequired StatusCode = 36
+	StatusSessionNotFound         StatusCode = 37
+	StatusEditPermissionDenied    StatusCode = 38
+	StatusPrivateResource         StatusCode = 39
+	StatusNothingToUpdate         StatusCode = 40
+	StatusRequestTokenNotApproved StatusCode = 41
+	StatusMethodNotSupported      StatusCode = 42
+	StatusBackendUnreachable      StatusCode = 43
+	StatusIDInvalid               StatusCode = 44
+	StatusUserSuspended           StatusCode = 45
+	StatusMaintenance             StatusCode = 46
+	StatusInvalidInput            StatusCode = 47
+)
+
+// StatusClass type is the classification of a status code.
+type StatusClass int
+
+// Status code classes.
+const (
+	// StatusClassUnknown is the class of the undocumented codes.
+	StatusClassUnknown StatusClass = iota
+	// StatusClassSuccess is the class of the successful requests.
+	StatusClassSuccess
+	// StatusClassAuth is the class of the api key, token,
+	// session, login and permission errors.
+	StatusClassAuth
+	// StatusClassClientInput is the class of the invalid
+	// requests, like an unknown id or a bad parameter.
+	StatusClassClientInput
+	// StatusClassRateLimit is the class of the requests
+	// over the rate limit.
+	StatusClassRateLimit
+	// StatusClassServer is the class of the TMDb internal errors.
+	StatusClassServer
+	// StatusClassTransient is the class of the temporary
+	// outages, like maintenance and backend timeouts.
+	StatusClassTransient
+)
+
+var statusClassNames = map[StatusClass]string{
+	StatusClassUnknown:     "unknown",
+	StatusClassSuccess:     "success",
+	StatusClassAuth:        "auth",
+	StatusClassClientInput: "client_input",
+	StatusClassRateLimit:   "rate_limit",
+	StatusClassServer:      "server",
+	StatusClassTransient:   "transient",
+}
+
+// String returns the name of the class, like "rate_limit".
+func (c StatusClass) String() string {
+	if name, ok := statusClassNames[c]; ok {
+		return name
+	}
+	return "unknown"
+}
+
+// statusInfo documents a status code.
+type statusInfo struct {
+	httpStatus  int
+	class       StatusClass
+	description string
+}
+
+var statusCodes = map[StatusCode]statusInfo{
+	StatusSuccess:                 {http.StatusOK, StatusClassSuccess, "Success."},
+	StatusInvalidService:          {http.StatusNotImplemented, StatusClassClientInput, "Invalid service: this service does not exist."},
+	StatusAuthenticationFailed:    {http.StatusUnauthorized, StatusClassAuth, "Authentication failed: You do not have permissions to access the service."},
+	StatusInvalidFormat:           {http.StatusMethodNotAllowed, StatusClassClientInput, "Invalid format: This service doesn't exist in that format."},
+	StatusInvalidParameters:       {http.StatusUnprocessableEntity, StatusClassClientInput, "Invalid parameters: Your request parameters are incorrect."},
+	StatusInvalidID:               {http.StatusNotFound, StatusClassClientInput, "Invalid id: The pre-requisite id is invalid or not found."},
+	StatusInvalidAPIKey:           {http.StatusUnauthorized, StatusClassAuth, "Invalid API key: You must be granted a valid key."},
+	StatusDuplicateEntry:          {http.StatusForbidden, StatusClassClientInput, "Duplicate entry: The data you tried to submit already exists."},
+	StatusServiceOffline:          {http.StatusServiceUnavailable, StatusClassTransient, "Service offline: This service is temporarily offline, try again later."},
+	StatusSuspendedAPIKey:         {http.StatusUnauthorized, StatusClassAuth, "Suspended API key: Access to your account has been suspended, contact TMDB."},
+	StatusInternalError:           {http.StatusInternalServerError, StatusClassServer, "Internal error: Something went wrong, contact TMDB."},
+	StatusUpdated:                 {http.StatusCreated, StatusClassSuccess, "The item/record was updated successfully."},
+	StatusDeleted:                 {http.StatusOK, StatusClassSuccess, "The item/record was deleted successfully."},
+	StatusAuthenticationError:     {http.StatusUnauthorized, StatusClassAuth, "Authentication failed."},
+	StatusFailed:                  {http.StatusInternalServerError, StatusClassServer, "Failed."},
+	StatusDeviceDenied:            {http.StatusUnauthorized, StatusClassAuth, "Device denied."},
+	StatusSessionDenied:           {http.StatusUnauthorized, StatusClassAuth, "Session denied."},
+	StatusValidationFailed:        {http.StatusBadRequest, StatusClassClientInput, "Validation failed."},
+	StatusInvalidAcceptHeader:     {http.StatusNotAcceptable, StatusClassClientInput, "Invalid accept header."},
+	StatusInvalidDateRange:        {http.StatusUnprocessableEntity, StatusClassClientInput, "Invalid date range: Should be a range no longer than 14 days."},
+	StatusEntryNotFound:           {http.StatusOK, StatusClassClientInput, "Entry not found: The item you are trying to edit cannot be found."},
+	StatusInvalidPage:             {http.StatusBadRequest, StatusClassClientInput, "Invalid page: Pages start at 1 and max at 500. They are expected to be an integer."},
+	StatusInvalidDate:             {http.StatusBadRequest, StatusClassClientInput, "Invalid date: Format needs to be YYYY-MM-DD."},
+	StatusBackendTimeout:          {http.StatusGatewayTimeout, StatusClassTransient, "Your request to the backend server timed out. Try again."},
+	StatusRequestLimitExceeded:    {http.StatusTooManyRequests, StatusClassRateLimit, "Your request count (#) is over the allowed limit of (40)."},
+	StatusCredentialsRequired:     {http.StatusBadRequest, StatusClassClientInput, "You must provide a username and password."},
+	StatusTooManyAppendToResponse: {http.StatusBadRequest, StatusClassClientInput, "Too many append to response objects: The maximum number of remote calls is 20."},
+	StatusInvalidTimezone:         {http.StatusBadRequest, StatusClassClientInput, "Invalid timezone: Please consult the documentation for a valid timezone."},
+	StatusConfirmationRequired:    {http.StatusBadRequest, StatusClassClientInput, "You must confirm this action: Please provide a confirm=true parameter."},
+	StatusInvalidLogin:            {http.StatusUnauthorized, StatusClassAuth, "Invalid username and/or password: You did not provide a valid login."},
+	StatusAccountDisabled:         {http.StatusUnauthorized, StatusClassAuth, "Account disabled: Your account is no longer active. Contact TMDB if this is an error."},
+	StatusEmailNotVerified:        {http.StatusUnauthorized, StatusClassAuth, "Email not verified: Your email address has not been verified."},
+	StatusInvalidRequestToken:     {http.StatusUnauthorized, StatusClassAuth, "Invalid request token: The request token is either expired or invalid."},
+	StatusResourceNotFound:        {http.StatusNotFound, StatusClassClientInput, "The resource you requested could not be found."},
+	StatusInvalidToken:            {http.StatusUnauthorized, StatusClassAuth, "Invalid token."},
+	StatusWritePermissionRequired: {http.StatusUnauthorized, StatusClassAuth, "This token hasn't been granted write permission by the user."},
+	StatusSessionNotFound:         {http.StatusNotFound, StatusClassClientInput, "The requested session could not be found."},
+	StatusEditPermissionDenied:    {http.StatusUnauthorized, StatusClassAuth, "You don't have permission to edit this resource."},
+	StatusPrivateResource:         {http.StatusUnauthorized, StatusClassAuth, "This resource is private."},
+	StatusNothingToUpdate:         {http.StatusOK, StatusClassSuccess, "Nothing to update."},
+	StatusRequestTokenNotApproved: {http.StatusUnprocessableEntity, StatusClassAuth, "This request token hasn't been approved by the user."},
+	StatusMethodNotSupported:      {http.StatusMethodNotAllowed, StatusClassClientInput, "This request method is not supported for this resource."},
+	StatusBackendUnreachable:      {http.StatusBadGateway, StatusClassTransient, "Couldn't connect to the backend server."},
+	StatusIDInvalid:               {http.StatusInternalServerError, StatusClassClientInput, "The ID is invalid."},
+	StatusUserSuspended:           {http.StatusForbidden, StatusClassAuth, "This user has been suspended."},
+	StatusMaintenance:             {http.StatusServiceUnavailable, StatusClassTransient, "The API is undergoing maintenance. Try again later."},
+	StatusInvalidInput:            {http.StatusBadRequest, StatusClassClientInput, "The input is not valid."},
+}
+
+// Known reports whether the status code is documented by TMDb.
+func (s StatusCode) Known() bool {
+	_, ok := statusCodes[s]
+	return ok
+}
+
+// Description returns the documented message of the status
+// code, empty for the undocumented ones.
+func (s StatusCode) Description() string {
+	return statusCodes[s].description
+}
+
+// HTTPStatus returns the http status documented with the
+// status code, zero for the undocumented ones.
+func (s StatusCode) HTTPStatus() int {
+	return statusCodes[s].httpStatus
+}
+
+// Class returns the class of the status code.
+func (s StatusCode) Class() StatusClass {
+	return statusCodes[s].class
+}
+
+// Retryable reports whether a request that failed with
+// the status code may succeed if it is sent again: rate
+// limited requests, internal errors and temporary outages.
+func (s StatusCode) Retryable() bool {
+	switch s.Class() {
+	case StatusClassRateLimit, StatusClassServer, StatusClassTransient:
+		return true
+	}
+	return false
+}
+
+// String returns the code and its description.
+func (s StatusCode) String() string {
+	code := strconv.Itoa(int(s))
+	if description := s.Description(); description != "" {
+		return code + " (" + description + ")"
+	}
+	return code
+}
@@ -0,0 +1,60 @@
+package tmdb
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+)
+
+func (suite *TMBDTestSuite) TestStatusCodes() {
+	for code := StatusSuccess; code <= StatusInvalidInput; code++ {
+		suite.True(code.Known(), "status code %d", code)
+		suite.NotEmpty(code.Description(), "status code %d", code)
+		suite.NotZero(code.HTTPStatus(), "status code %d", code)
+		suite.NotEqual(StatusClassUnknown, code.Class(), "status code %d", code)
+	}
+	suite.False(StatusCode(48).Known())
+	suite.Equal(StatusClassUnknown, StatusCode(48).Class())
+	suite.Equal("48", StatusCode(48).String())
+	suite.Equal("34 (The resource you requested could not be found.)", StatusResourceNotFound.String())
+	suite.Equal(http.StatusTooManyRequests, StatusRequestLimitExceeded.HTTPStatus())
+	suite.Equal("rate_limit", StatusRequestLimitExceeded.Class().String())
+	suite.Equal(StatusClassAuth, StatusInvalidAPIKey.Class())
+	suite.Equal(StatusClassClientInput, StatusInvalidID.Class())
+	suite.Equal(StatusClassTransient, StatusMaintenance.Class())
+	suite.Equal(StatusClassSuccess, StatusDeleted.Class())
+}
+
+func (suite *TMBDTestSuite) TestStatusCodeRetryable() {
+	suite.True(StatusRequestLimitExceeded.Retryable())
+	suite.True(StatusInternalError.Retryable())
+	suite.True(StatusServiceOffline.Retryable())
+	suite.True(StatusBackendTimeout.Retryable())
+	suite.False(StatusInvalidAPIKey.Retryable())
+	suite.False(StatusResourceNotFound.Retryable())
+	suite.False(StatusIDInvalid.Retryable())
+	suite.False(StatusCode(48).Retryable())
+}
+
+func (suite *TMBDTestSuite) TestRetryPolicyStatusCode() {
+	var hits int32
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&hits, 1)
+		w.WriteHeader(http.StatusInternalServerError)
+		w.Write([]byte(`{"status_code":44,"status_message":"The ID is invalid.","success":false}`))
+	}))
+	defer ts.Close()
+	c, _ := Init(apiKey)
+	c.SetCustomBaseURL(ts.URL)
+	c.SetRetryPolicy(fastRetryPolicy)
+	_, err := c.GetMovieDetails(bumblebeeID, nil)
+	suite.ErrorIs(err, ErrInvalidID)
+	suite.Equal(int32(1), hits)
+}
+
+func (suite *TMBDTestSuite) TestErrorCode() {
+	suite.Equal(StatusInvalidAPIKey, Error{StatusCode: 7}.Code())
+	apiErr := &APIError{StatusCode: 25}
+	suite.Equal(StatusRequestLimitExceeded, apiErr.Code())
+	suite.Equal(StatusClassRateLimit, apiErr.Code().Class())
+}
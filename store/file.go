@@ -0,0 +1,352 @@
+package store
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+
+	tmdb "github.com/cyruzin/golang-tmdb"
+	json "github.com/goccy/go-json"
+)
+
+// FileStore type is a Store keeping one JSON file per item
+// in a directory:
+//
+//	<dir>/<kind>/<id>.json
+//	<dir>/external/<source>/<hex encoded external id>
+//	<dir>/checkpoints/<media>.json
+//
+// Files are written atomically, so a crash never leaves a
+// truncated item behind. A FileStore is safe for concurrent
+// use within one process.
+type FileStore struct {
+	mu  sync.RWMutex
+	dir string
+}
+
+// NewFileStore creates a FileStore in dir, creating
+// the directory if needed.
+func NewFileStore(dir string) (*FileStore, error) {
+	for _, sub := range []string{
+		string(Movie),
+		string(TV),
+		string(Person),
+		filepath.Join("external", string(IMDb)),
+		filepath.Join("external", string(TVDB)),
+		"checkpoints",
+	} {
+		if err := os.MkdirAll(filepath.Join(dir, sub), 0o755); err != nil {
+			return nil, err
+		}
+	}
+	return &FileStore{dir: dir}, nil
+}
+
+// GetMovie returns a stored movie.
+func (f *FileStore) GetMovie(ctx context.Context, id int64) (*MovieRecord, error) {
+	var movie MovieRecord
+	if err := f.get(ctx, Movie, id, &movie); err != nil {
+		return nil, err
+	}
+	return &movie, nil
+}
+
+// PutMovie stores a movie, replacing any previous version.
+func (f *FileStore) PutMovie(ctx context.Context, movie *MovieRecord) error {
+	if movie == nil || movie.Details == nil {
+		return errors.New("store: movie without details")
+	}
+	return f.put(ctx, Movie, movie.Details.ID, movie, movie.ExternalIDs())
+}
+
+// GetTV returns a stored TV show.
+func (f *FileStore) GetTV(ctx context.Context, id int64) (*TVRecord, error) {
+	var tv TVRecord
+	if err := f.get(ctx, TV, id, &tv); err != nil {
+		return nil, err
+	}
+	return &tv, nil
+}
+
+// PutTV stores a TV show, replacing any previous version.
+func (f *FileStore) PutTV(ctx context.Context, tv *TVRecord) error {
+	if tv == nil || tv.Details == nil {
+		return errors.New("store: TV show without details")
+	}
+	return f.put(ctx, TV, tv.Details.ID, tv, tv.ExternalIDs())
+}
+
+// GetPerson returns a stored person.
+func (f *FileStore) GetPerson(ctx context.Context, id int64) (*PersonRecord, error) {
+	var person PersonRecord
+	if err := f.get(ctx, Person, id, &person); err != nil {
+		return nil, err
+	}
+	return &person, nil
+}
+
+// PutPerson stores a person, replacing any previous version.
+func (f *FileStore) PutPerson(ctx context.Context, person *PersonRecord) error {
+	if person == nil || person.Details == nil {
+		return errors.New("store: person without details")
+	}
+	return f.put(ctx, Person, person.Details.ID, person, person.ExternalIDs())
+}
+
+// Delete removes an item and its external ids.
+func (f *FileStore) Delete(ctx context.Context, kind Kind, id int64) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	ids, err := f.externalIDs(kind, id)
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		return err
+	}
+	if err := f.unlink(kind, id, ids); err != nil {
+		return err
+	}
+	if err := os.Remove(f.itemPath(kind, id)); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// FindByExternalID looks up an item by one of its external ids.
+func (f *FileStore) FindByExternalID(
+	ctx context.Context,
+	source ExternalSource,
+	externalID string,
+) (Kind, int64, error) {
+	if err := ctx.Err(); err != nil {
+		return "", 0, err
+	}
+	if externalID == "" {
+		return "", 0, ErrNotFound
+	}
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	b, err := os.ReadFile(f.externalPath(source, externalID))
+	if errors.Is(err, fs.ErrNotExist) {
+		return "", 0, ErrNotFound
+	}
+	if err != nil {
+		return "", 0, err
+	}
+	kind, id, ok := strings.Cut(string(b), "/")
+	if !ok {
+		return "", 0, fmt.Errorf("store: invalid external id link %q", b)
+	}
+	n, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return "", 0, fmt.Errorf("store: invalid external id link %q", b)
+	}
+	return Kind(kind), n, nil
+}
+
+// Checkpoint returns the saved checkpoint of media.
+func (f *FileStore) Checkpoint(
+	ctx context.Context,
+	media tmdb.SyncMedia,
+) (tmdb.SyncCheckpoint, error) {
+	if err := ctx.Err(); err != nil {
+		return tmdb.SyncCheckpoint{}, err
+	}
+	path, err := f.checkpointPath(media)
+	if err != nil {
+		return tmdb.SyncCheckpoint{}, err
+	}
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	b, err := os.ReadFile(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return tmdb.SyncCheckpoint{Media: media}, nil
+	}
+	if err != nil {
+		return tmdb.SyncCheckpoint{}, err
+	}
+	var checkpoint tmdb.SyncCheckpoint
+	if err := json.Unmarshal(b, &checkpoint); err != nil {
+		return tmdb.SyncCheckpoint{}, err
+	}
+	return checkpoint, nil
+}
+
+// SaveCheckpoint persists a change feed checkpoint.
+func (f *FileStore) SaveCheckpoint(ctx context.Context, checkpoint tmdb.SyncCheckpoint) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	path, err := f.checkpointPath(checkpoint.Media)
+	if err != nil {
+		return err
+	}
+	b, err := json.Marshal(checkpoint)
+	if err != nil {
+		return err
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.write(path, b)
+}
+
+func (f *FileStore) get(ctx context.Context, kind Kind, id int64, v any) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	return f.read(kind, id, v)
+}
+
+func (f *FileStore) read(kind Kind, id int64, v any) error {
+	b, err := os.ReadFile(f.itemPath(kind, id))
+	if errors.Is(err, fs.ErrNotExist) {
+		return ErrNotFound
+	}
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, v)
+}
+
+// put writes an item and its external ids, removing
+// the links of ids the previous version no longer has.
+func (f *FileStore) put(
+	ctx context.Context,
+	kind Kind,
+	id int64,
+	v any,
+	ids map[ExternalSource]string,
+) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	previous, err := f.externalIDs(kind, id)
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		return err
+	}
+	stale := map[ExternalSource]string{}
+	for source, externalID := range previous {
+		if ids[source] != externalID {
+			stale[source] = externalID
+		}
+	}
+	if err := f.unlink(kind, id, stale); err != nil {
+		return err
+	}
+	if err := f.write(f.itemPath(kind, id), b); err != nil {
+		return err
+	}
+	link := []byte(fmt.Sprintf("%s/%d", kind, id))
+	for source, externalID := range ids {
+		if err := f.write(f.externalPath(source, externalID), link); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// externalIDs returns the external ids of the stored version of an item.
+func (f *FileStore) externalIDs(kind Kind, id int64) (map[ExternalSource]string, error) {
+	switch kind {
+	case Movie:
+		var movie MovieRecord
+		if err := f.read(kind, id, &movie); err != nil {
+			return nil, err
+		}
+		return movie.ExternalIDs(), nil
+	case TV:
+		var tv TVRecord
+		if err := f.read(kind, id, &tv); err != nil {
+			return nil, err
+		}
+		return tv.ExternalIDs(), nil
+	case Person:
+		var person PersonRecord
+		if err := f.read(kind, id, &person); err != nil {
+			return nil, err
+		}
+		return person.ExternalIDs(), nil
+	default:
+		return nil, fmt.Errorf("store: unknown kind %q", kind)
+	}
+}
+
+// unlink removes the external id links still pointing to an item.
+func (f *FileStore) unlink(kind Kind, id int64, ids map[ExternalSource]string) error {
+	link := fmt.Sprintf("%s/%d", kind, id)
+	for source, externalID := range ids {
+		path := f.externalPath(source, externalID)
+		b, err := os.ReadFile(path)
+		if errors.Is(err, fs.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if string(b) != link {
+			continue
+		}
+		if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return err
+		}
+	}
+	return nil
+}
+
+// write replaces a file atomically.
+func (f *FileStore) write(path string, b []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), "tmp-*")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(b); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return nil
+}
+
+func (f *FileStore) itemPath(kind Kind, id int64) string {
+	return filepath.Join(f.dir, string(kind), strconv.FormatInt(id, 10)+".json")
+}
+
+// externalPath hex encodes the external id, so that no id, like
+// ".." or "a/b", can name a file outside of the source directory.
+func (f *FileStore) externalPath(source ExternalSource, externalID string) string {
+	return filepath.Join(f.dir, "external", string(source), hex.EncodeToString([]byte(externalID)))
+}
+
+// checkpointPath rejects the unknown media, which
+// could name a file outside of the checkpoints directory.
+func (f *FileStore) checkpointPath(media tmdb.SyncMedia) (string, error) {
+	switch media {
+	case tmdb.SyncMovie, tmdb.SyncTV, tmdb.SyncPerson:
+		return filepath.Join(f.dir, "checkpoints", string(media)+".json"), nil
+	}
+	return "", fmt.Errorf("store: unknown sync media %q", media)
+}
@@ -0,0 +1,252 @@
+// Package store keeps a local mirror of TMDb movies, TV shows and
+// people, with their credits and external ids, for offline use.
+//
+// The Store interface can be implemented by any backend. FileStore
+// is a pure Go implementation keeping one JSON file per item:
+//
+//	mirror, err := store.NewFileStore("/var/lib/tmdb")
+//	...
+//	err = store.Load(ctx, tmdbClient, mirror, store.Movie, 550)
+//	...
+//	kind, id, err := mirror.FindByExternalID(ctx, store.IMDb, "tt0137523")
+//
+// The mirror is kept fresh from the change feed with a SyncSink.
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	tmdb "github.com/cyruzin/golang-tmdb"
+)
+
+// ErrNotFound is returned when an item is not in the store.
+var ErrNotFound = errors.New("store: not found")
+
+// Kind type is the kind of a stored item.
+type Kind string
+
+// Kinds of stored items, matching the tmdb.SyncMedia values.
+const (
+	Movie  Kind = Kind(tmdb.SyncMovie)
+	TV     Kind = Kind(tmdb.SyncTV)
+	Person Kind = Kind(tmdb.SyncPerson)
+)
+
+// ExternalSource type is a source of external ids.
+type ExternalSource string
+
+// External sources indexed by the store.
+const (
+	IMDb ExternalSource = "imdb_id"
+	TVDB ExternalSource = "tvdb_id"
+)
+
+// MovieRecord type is a stored movie.
+type MovieRecord struct {
+	Details   *tmdb.MovieDetails `json:"details"`
+	UpdatedAt time.Time          `json:"updated_at"`
+}
+
+// Credits returns the credits of the movie, nil when
+// they were not appended to the details.
+func (m *MovieRecord) Credits() *tmdb.MovieCredits {
+	if m.Details == nil || m.Details.MovieCreditsAppend == nil {
+		return nil
+	}
+	return m.Details.Credits.MovieCredits
+}
+
+// ExternalIDs returns the external ids of the movie.
+func (m *MovieRecord) ExternalIDs() map[ExternalSource]string {
+	ids := map[ExternalSource]string{}
+	if m.Details == nil {
+		return ids
+	}
+	setExternalID(ids, IMDb, m.Details.IMDbID)
+	if m.Details.MovieExternalIDsAppend != nil && m.Details.MovieExternalIDs != nil {
+		setExternalID(ids, IMDb, m.Details.MovieExternalIDs.IMDbID)
+	}
+	return ids
+}
+
+// TVRecord type is a stored TV show.
+type TVRecord struct {
+	Details   *tmdb.TVDetails `json:"details"`
+	UpdatedAt time.Time       `json:"updated_at"`
+}
+
+// Credits returns the credits of the TV show, nil when
+// they were not appended to the details.
+func (t *TVRecord) Credits() *tmdb.TVCredits {
+	if t.Details == nil || t.Details.TVCreditsAppend == nil {
+		return nil
+	}
+	return t.Details.Credits.TVCredits
+}
+
+// ExternalIDs returns the external ids of the TV show.
+func (t *TVRecord) ExternalIDs() map[ExternalSource]string {
+	ids := map[ExternalSource]string{}
+	if t.Details == nil ||
+		t.Details.TVExternalIDsAppend == nil ||
+		t.Details.TVExternalIDs == nil {
+		return ids
+	}
+	setExternalID(ids, IMDb, t.Details.TVExternalIDs.IMDbID)
+	if t.Details.TVExternalIDs.TVDBID != 0 {
+		setExternalID(ids, TVDB, strconv.FormatInt(t.Details.TVExternalIDs.TVDBID, 10))
+	}
+	return ids
+}
+
+// PersonRecord type is a stored person.
+type PersonRecord struct {
+	Details   *tmdb.PersonDetails `json:"details"`
+	UpdatedAt time.Time           `json:"updated_at"`
+}
+
+// Credits returns the combined credits of the person, nil
+// when they were not appended to the details.
+func (p *PersonRecord) Credits() *tmdb.PersonCombinedCredits {
+	if p.Details == nil || p.Details.PersonCombinedCreditsAppend == nil {
+		return nil
+	}
+	return p.Details.CombinedCredits
+}
+
+// ExternalIDs returns the external ids of the person.
+func (p *PersonRecord) ExternalIDs() map[ExternalSource]string {
+	ids := map[ExternalSource]string{}
+	if p.Details == nil {
+		return ids
+	}
+	setExternalID(ids, IMDb, p.Details.IMDbID)
+	if p.Details.PersonExternalIDsAppend != nil && p.Details.ExternalIDs != nil {
+		setExternalID(ids, IMDb, p.Details.ExternalIDs.IMDbID)
+	}
+	return ids
+}
+
+func setExternalID(ids map[ExternalSource]string, source ExternalSource, id string) {
+	if id = strings.TrimSpace(id); id != "" {
+		ids[source] = id
+	}
+}
+
+// Store is the interface implemented by the mirror backends.
+//
+// The getters return ErrNotFound for unknown items.
+type Store interface {
+	GetMovie(ctx context.Context, id int64) (*MovieRecord, error)
+	PutMovie(ctx context.Context, movie *MovieRecord) error
+	GetTV(ctx context.Context, id int64) (*TVRecord, error)
+	PutTV(ctx context.Context, tv *TVRecord) error
+	GetPerson(ctx context.Context, id int64) (*PersonRecord, error)
+	PutPerson(ctx context.Context, person *PersonRecord) error
+	// Delete removes an item and its external ids.
+	// Deleting an unknown item is not an error.
+	Delete(ctx context.Context, kind Kind, id int64) error
+	// FindByExternalID looks up an item by one of its external
+	// ids, as returned by GetMovieExternalIDs or GetTVExternalIDs.
+	FindByExternalID(
+		ctx context.Context,
+		source ExternalSource,
+		externalID string,
+	) (Kind, int64, error)
+	// Checkpoint returns the change feed checkpoint of
+	// media, or a zero checkpoint when none was saved.
+	Checkpoint(ctx context.Context, media tmdb.SyncMedia) (tmdb.SyncCheckpoint, error)
+	// SaveCheckpoint persists a change feed checkpoint.
+	SaveCheckpoint(ctx context.Context, checkpoint tmdb.SyncCheckpoint) error
+}
+
+// AppendToResponse returns the append_to_response values used
+// to fetch the details of kind with their credits and external ids.
+func AppendToResponse(kind Kind) []string {
+	if kind == Person {
+		return []string{"combined_credits", "external_ids"}
+	}
+	return []string{"credits", "external_ids"}
+}
+
+// Load fetches the details of an item with its credits and
+// external ids and puts it in the store.
+func Load(
+	ctx context.Context,
+	client *tmdb.Client,
+	s Store,
+	kind Kind,
+	id int64,
+) error {
+	options := tmdb.DetailsOptions(tmdb.AppendToResponse(AppendToResponse(kind)...))
+	now := time.Now().UTC()
+	switch kind {
+	case Movie:
+		details, err := client.GetMovieDetailsWithContext(ctx, int(id), options)
+		if err != nil {
+			return err
+		}
+		return s.PutMovie(ctx, &MovieRecord{Details: details, UpdatedAt: now})
+	case TV:
+		details, err := client.GetTVDetailsWithContext(ctx, int(id), options)
+		if err != nil {
+			return err
+		}
+		return s.PutTV(ctx, &TVRecord{Details: details, UpdatedAt: now})
+	case Person:
+		details, err := client.GetPersonDetailsWithContext(ctx, int(id), options)
+		if err != nil {
+			return err
+		}
+		return s.PutPerson(ctx, &PersonRecord{Details: details, UpdatedAt: now})
+	default:
+		return fmt.Errorf("store: unknown kind %q", kind)
+	}
+}
+
+// SyncSink type is a tmdb.SyncSink keeping a Store fresh
+// from the change feed.
+//
+// The tmdb.Syncer must fetch the details, using the
+// AppendToResponse of its media to keep the credits and
+// external ids. Deleted items are removed from the store.
+type SyncSink struct {
+	store Store
+}
+
+// NewSyncSink creates a SyncSink writing to s.
+func NewSyncSink(s Store) *SyncSink {
+	return &SyncSink{store: s}
+}
+
+// HandleChange stores the details of the event.
+func (s *SyncSink) HandleChange(ctx context.Context, event tmdb.SyncEvent) error {
+	if event.Deleted {
+		return s.store.Delete(ctx, Kind(event.Media), event.ID)
+	}
+	now := time.Now().UTC()
+	switch {
+	case event.Movie != nil:
+		return s.store.PutMovie(ctx, &MovieRecord{Details: event.Movie, UpdatedAt: now})
+	case event.TV != nil:
+		return s.store.PutTV(ctx, &TVRecord{Details: event.TV, UpdatedAt: now})
+	case event.Person != nil:
+		return s.store.PutPerson(ctx, &PersonRecord{Details: event.Person, UpdatedAt: now})
+	default:
+		return fmt.Errorf(
+			"store: %s %d has no details, the syncer must fetch them",
+			event.Media,
+			event.ID,
+		)
+	}
+}
+
+// SaveCheckpoint saves the checkpoint in the store.
+func (s *SyncSink) SaveCheckpoint(ctx context.Context, checkpoint tmdb.SyncCheckpoint) error {
+	return s.store.SaveCheckpoint(ctx, checkpoint)
+}
@@ -0,0 +1,178 @@
+package store
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	tmdb "github.com/cyruzin/golang-tmdb"
+	json "github.com/goccy/go-json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const fightClub = `{
+	"id": 550,
+	"title": "Fight Club",
+	"imdb_id": "tt0137523",
+	"credits": {
+		"id": 550,
+		"cast": [{"id": 819, "name": "Edward Norton", "character": "The Narrator"}]
+	},
+	"external_ids": {"id": 550, "imdb_id": "tt0137523"}
+}`
+
+const gameOfThrones = `{
+	"id": 1399,
+	"name": "Game of Thrones",
+	"credits": {
+		"id": 1399,
+		"cast": [{"id": 22970, "name": "Peter Dinklage"}]
+	},
+	"external_ids": {"id": 1399, "imdb_id": "tt0944947", "tvdb_id": 121361}
+}`
+
+func decode[T any](t *testing.T, data string) *T {
+	t.Helper()
+	var v T
+	require.NoError(t, json.Unmarshal([]byte(data), &v))
+	return &v
+}
+
+func newFileStore(t *testing.T) *FileStore {
+	t.Helper()
+	s, err := NewFileStore(t.TempDir())
+	require.NoError(t, err)
+	return s
+}
+
+func TestFileStoreMovie(t *testing.T) {
+	ctx := context.Background()
+	s := newFileStore(t)
+	_, err := s.GetMovie(ctx, 550)
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	movie := &MovieRecord{Details: decode[tmdb.MovieDetails](t, fightClub)}
+	require.NoError(t, s.PutMovie(ctx, movie))
+	got, err := s.GetMovie(ctx, 550)
+	require.NoError(t, err)
+	assert.Equal(t, "Fight Club", got.Details.Title)
+	require.NotNil(t, got.Credits())
+	assert.Equal(t, "Edward Norton", got.Credits().Cast[0].Name)
+
+	kind, id, err := s.FindByExternalID(ctx, IMDb, "tt0137523")
+	require.NoError(t, err)
+	assert.Equal(t, Movie, kind)
+	assert.Equal(t, int64(550), id)
+
+	require.NoError(t, s.Delete(ctx, Movie, 550))
+	_, err = s.GetMovie(ctx, 550)
+	assert.ErrorIs(t, err, ErrNotFound)
+	_, _, err = s.FindByExternalID(ctx, IMDb, "tt0137523")
+	assert.ErrorIs(t, err, ErrNotFound)
+	assert.NoError(t, s.Delete(ctx, Movie, 550))
+}
+
+func TestFileStoreTVExternalIDs(t *testing.T) {
+	ctx := context.Background()
+	s := newFileStore(t)
+	tv := &TVRecord{Details: decode[tmdb.TVDetails](t, gameOfThrones)}
+	require.NoError(t, s.PutTV(ctx, tv))
+	kind, id, err := s.FindByExternalID(ctx, TVDB, "121361")
+	require.NoError(t, err)
+	assert.Equal(t, TV, kind)
+	assert.Equal(t, int64(1399), id)
+
+	tv.Details.TVExternalIDs.IMDbID = "tt9999999"
+	require.NoError(t, s.PutTV(ctx, tv))
+	_, _, err = s.FindByExternalID(ctx, IMDb, "tt0944947")
+	assert.ErrorIs(t, err, ErrNotFound)
+	_, id, err = s.FindByExternalID(ctx, IMDb, "tt9999999")
+	require.NoError(t, err)
+	assert.Equal(t, int64(1399), id)
+}
+
+func TestFileStoreExternalIDPath(t *testing.T) {
+	ctx := context.Background()
+	s := newFileStore(t)
+	for _, externalID := range []string{"..", ".", "../movie/550", ""} {
+		_, _, err := s.FindByExternalID(ctx, IMDb, externalID)
+		assert.ErrorIs(t, err, ErrNotFound, externalID)
+	}
+	movie := &MovieRecord{Details: decode[tmdb.MovieDetails](t, fightClub)}
+	movie.Details.MovieExternalIDs.IMDbID = ".."
+	require.NoError(t, s.PutMovie(ctx, movie))
+	_, id, err := s.FindByExternalID(ctx, IMDb, "..")
+	require.NoError(t, err)
+	assert.Equal(t, int64(550), id)
+	assert.FileExists(t, filepath.Join(s.dir, "external", string(IMDb), "2e2e"))
+}
+
+func TestFileStoreCheckpoint(t *testing.T) {
+	ctx := context.Background()
+	s := newFileStore(t)
+	cp, err := s.Checkpoint(ctx, tmdb.SyncMovie)
+	require.NoError(t, err)
+	assert.True(t, cp.Until.IsZero())
+
+	until := time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC)
+	require.NoError(t, s.SaveCheckpoint(ctx, tmdb.SyncCheckpoint{Media: tmdb.SyncMovie, Until: until}))
+	cp, err = s.Checkpoint(ctx, tmdb.SyncMovie)
+	require.NoError(t, err)
+	assert.Equal(t, tmdb.SyncMovie, cp.Media)
+	assert.True(t, until.Equal(cp.Until))
+
+	for _, media := range []tmdb.SyncMedia{"../../x", "", "movie/../tv"} {
+		err = s.SaveCheckpoint(ctx, tmdb.SyncCheckpoint{Media: media, Until: until})
+		assert.Error(t, err, media)
+		_, err = s.Checkpoint(ctx, media)
+		assert.Error(t, err, media)
+	}
+	assert.NoFileExists(t, filepath.Join(s.dir, "..", "x.json"))
+}
+
+func TestLoad(t *testing.T) {
+	var query string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query = r.URL.Query().Get("append_to_response")
+		w.Write([]byte(fightClub))
+	}))
+	defer ts.Close()
+	client, err := tmdb.Init("key")
+	require.NoError(t, err)
+	client.SetCustomBaseURL(ts.URL)
+
+	ctx := context.Background()
+	s := newFileStore(t)
+	require.NoError(t, Load(ctx, client, s, Movie, 550))
+	assert.Equal(t, "credits,external_ids", query)
+	got, err := s.GetMovie(ctx, 550)
+	require.NoError(t, err)
+	assert.False(t, got.UpdatedAt.IsZero())
+}
+
+func TestSyncSink(t *testing.T) {
+	ctx := context.Background()
+	s := newFileStore(t)
+	sink := NewSyncSink(s)
+	movie := decode[tmdb.MovieDetails](t, fightClub)
+	require.NoError(t, sink.HandleChange(ctx, tmdb.SyncEvent{Media: tmdb.SyncMovie, ID: 550, Movie: movie}))
+	_, err := s.GetMovie(ctx, 550)
+	require.NoError(t, err)
+
+	err = sink.HandleChange(ctx, tmdb.SyncEvent{Media: tmdb.SyncMovie, ID: 551})
+	assert.Error(t, err)
+
+	require.NoError(t, sink.HandleChange(ctx, tmdb.SyncEvent{Media: tmdb.SyncMovie, ID: 550, Deleted: true}))
+	_, err = s.GetMovie(ctx, 550)
+	assert.ErrorIs(t, err, ErrNotFound)
+
+	until := time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC)
+	require.NoError(t, sink.SaveCheckpoint(ctx, tmdb.SyncCheckpoint{Media: tmdb.SyncMovie, Until: until}))
+	cp, err := s.Checkpoint(ctx, tmdb.SyncMovie)
+	require.NoError(t, err)
+	assert.True(t, until.Equal(cp.Until))
+}
@@ -0,0 +1,263 @@
+package tmdb
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// SyncMedia type is the media type of a change feed.
+type SyncMedia string
+
+// Media types of the change feeds.
+const (
+	SyncMovie  SyncMedia = "movie"
+	SyncTV     SyncMedia = "tv"
+	SyncPerson SyncMedia = "person"
+)
+
+// maxSyncWindowDays is the longest window accepted by the change lists.
+const maxSyncWindowDays = 14
+
+// SyncCheckpoint type is the persisted progress of a Syncer.
+type SyncCheckpoint struct {
+	Media SyncMedia `json:"media"`
+	// Until is the last day whose changes were delivered to the
+	// sink. The next run starts from it again, since the changes
+	// of that day may not have been complete.
+	Until time.Time `json:"until"`
+}
+
+// SyncEvent type is an event emitted by a Syncer for a changed id.
+type SyncEvent struct {
+	Media SyncMedia
+	ID    int64
+	Adult bool
+	// From and To are the days of the window the change was found in.
+	From time.Time
+	To   time.Time
+	// Deleted reports whether the details were not found, which
+	// means the item was removed. Only set when fetching details.
+	Deleted bool
+	// Movie, TV or Person are the details of the changed id,
+	// depending on the media, when fetching details.
+	Movie  *MovieDetails
+	TV     *TVDetails
+	Person *PersonDetails
+}
+
+// SyncSink receives the events of a Syncer.
+//
+// Events are delivered at least once: a window interrupted by
+// an error or a crash is delivered again by the next run, so
+// HandleChange must be idempotent.
+type SyncSink interface {
+	// HandleChange handles the change of an id.
+	HandleChange(ctx context.Context, event SyncEvent) error
+	// SaveCheckpoint persists the checkpoint once all of
+	// the events of a window were handled.
+	SaveCheckpoint(ctx context.Context, checkpoint SyncCheckpoint) error
+}
+
+// SyncOptions type is a struct to configure a Syncer.
+type SyncOptions struct {
+	// FetchDetails fetches the details of every changed id.
+	FetchDetails bool
+	// AppendToResponse is appended to the details requests.
+	AppendToResponse []string
+	// WindowDays is the number of days of each change window,
+	// up to 14. Zero means 14.
+	WindowDays int
+	// Now returns the current time, time.Now when nil.
+	Now func() time.Time
+}
+
+// Syncer type walks the change feed of a media type and
+// emits an event for every changed id to a SyncSink.
+type Syncer struct {
+	client *Client
+	media  SyncMedia
+	sink   SyncSink
+	opts   SyncOptions
+}
+
+// NewSyncer creates a Syncer for the change feed of media.
+func NewSyncer(
+	client *Client,
+	media SyncMedia,
+	sink SyncSink,
+	opts SyncOptions,
+) (*Syncer, error) {
+	if client == nil {
+		return nil, errors.New("client is nil")
+	}
+	if sink == nil {
+		return nil, errors.New("sink is nil")
+	}
+	switch media {
+	case SyncMovie, SyncTV, SyncPerson:
+	default:
+		return nil, fmt.Errorf("unknown sync media: %q", media)
+	}
+	if opts.WindowDays < 0 || opts.WindowDays > maxSyncWindowDays {
+		return nil, fmt.Errorf(
+			"window days must be between 1 and %d",
+			maxSyncWindowDays,
+		)
+	}
+	if opts.WindowDays == 0 {
+		opts.WindowDays = maxSyncWindowDays
+	}
+	if opts.Now == nil {
+		opts.Now = time.Now
+	}
+	return &Syncer{client: client, media: media, sink: sink, opts: opts}, nil
+}
+
+// Run walks the change windows from the checkpoint up to today
+// and returns the last saved checkpoint. A zero checkpoint
+// starts from yesterday.
+//
+// Every window is walked across its pages. An id is delivered
+// to the sink once per run, with the window it was first found
+// in, even when it changed again in a later window. The
+// checkpoint is saved after each window, so a run stopped
+// by an error resumes from the last saved checkpoint.
+func (s *Syncer) Run(
+	ctx context.Context,
+	checkpoint SyncCheckpoint,
+) (SyncCheckpoint, error) {
+	if checkpoint.Media != "" && checkpoint.Media != s.media {
+		return checkpoint, fmt.Errorf(
+			"checkpoint of %q used to sync %q",
+			checkpoint.Media,
+			s.media,
+		)
+	}
+	checkpoint.Media = s.media
+	today := truncateDay(s.opts.Now())
+	from := today.AddDate(0, 0, -1)
+	if !checkpoint.Until.IsZero() {
+		from = truncateDay(checkpoint.Until)
+	}
+	seen := map[int64]bool{}
+	for !from.After(today) {
+		to := from.AddDate(0, 0, s.opts.WindowDays-1)
+		if to.After(today) {
+			to = today
+		}
+		if err := s.syncWindow(ctx, from, to, seen); err != nil {
+			return checkpoint, err
+		}
+		next := SyncCheckpoint{Media: s.media, Until: to}
+		if err := s.sink.SaveCheckpoint(ctx, next); err != nil {
+			return checkpoint, err
+		}
+		checkpoint = next
+		from = to.AddDate(0, 0, 1)
+	}
+	return checkpoint, nil
+}
+
+// syncWindow delivers the changed ids of a window to the
+// sink, skipping the ids in seen, where it adds them.
+func (s *Syncer) syncWindow(
+	ctx context.Context,
+	from, to time.Time,
+	seen map[int64]bool,
+) error {
+	options := map[string]string{
+		"start_date": from.Format(time.DateOnly),
+		"end_date":   to.Format(time.DateOnly),
+	}
+	pages := paginateOptions(
+		ctx,
+		s.changes,
+		options,
+		func(changes *ChangesMovie) []ChangesResult {
+			return changes.Results
+		},
+		PaginateOptions{},
+	)
+	for result, err := range pages {
+		if err != nil {
+			return err
+		}
+		if seen[result.ID] {
+			continue
+		}
+		seen[result.ID] = true
+		event := SyncEvent{
+			Media: s.media,
+			ID:    result.ID,
+			Adult: result.Adult,
+			From:  from,
+			To:    to,
+		}
+		if s.opts.FetchDetails {
+			if err := s.details(ctx, &event); err != nil {
+				return err
+			}
+		}
+		if err := s.sink.HandleChange(ctx, event); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// changes fetches a page of the change list of the media.
+func (s *Syncer) changes(
+	ctx context.Context,
+	urlOptions map[string]string,
+) (*ChangesMovie, error) {
+	switch s.media {
+	case SyncTV:
+		changes, err := s.client.GetChangesTVWithContext(ctx, urlOptions)
+		if err != nil {
+			return nil, err
+		}
+		return changes.ChangesMovie, nil
+	case SyncPerson:
+		changes, err := s.client.GetChangesPersonWithContext(ctx, urlOptions)
+		if err != nil {
+			return nil, err
+		}
+		return changes.ChangesMovie, nil
+	default:
+		return s.client.GetChangesMovieWithContext(ctx, urlOptions)
+	}
+}
+
+// details fetches the details of the event id.
+func (s *Syncer) details(ctx context.Context, event *SyncEvent) error {
+	var options map[string]string
+	if len(s.opts.AppendToResponse) > 0 {
+		options = map[string]string{
+			"append_to_response": strings.Join(s.opts.AppendToResponse, ","),
+		}
+	}
+	id := int(event.ID)
+	var err error
+	switch s.media {
+	case SyncTV:
+		event.TV, err = s.client.GetTVDetailsWithContext(ctx, id, options)
+	case SyncPerson:
+		event.Person, err = s.client.GetPersonDetailsWithContext(ctx, id, options)
+	default:
+		event.Movie, err = s.client.GetMovieDetailsWithContext(ctx, id, options)
+	}
+	if errors.Is(err, ErrNotFound) {
+		event.Deleted = true
+		return nil
+	}
+	return err
+}
+
+// truncateDay truncates t to the start of its day in UTC.
+func truncateDay(t time.Time) time.Time {
+	year, month, d := t.UTC().Date()
+	return time.Date(year, month, d, 0, 0, 0, 0, time.UTC)
+}
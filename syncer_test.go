@@ -0,0 +1,143 @@
+package tmdb
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+)
+
+type memorySink struct {
+	mu          sync.Mutex
+	events      []SyncEvent
+	checkpoints []SyncCheckpoint
+	failOn      int64
+}
+
+func (s *memorySink) HandleChange(_ context.Context, event SyncEvent) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if event.ID == s.failOn {
+		return errors.New("sink failure")
+	}
+	s.events = append(s.events, event)
+	return nil
+}
+
+func (s *memorySink) SaveCheckpoint(_ context.Context, checkpoint SyncCheckpoint) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.checkpoints = append(s.checkpoints, checkpoint)
+	return nil
+}
+
+// newChangesServer serves two pages of movie changes for every
+// window, with 2 repeated across the pages, and the movie details.
+// The movie 3 is not found.
+func (suite *TMBDTestSuite) newChangesServer(windows *[]string) *httptest.Server {
+	var mu sync.Mutex
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		switch {
+		case r.URL.Path == "/movie/changes" && query.Get("page") == "1":
+			mu.Lock()
+			*windows = append(*windows, query.Get("start_date")+"/"+query.Get("end_date"))
+			mu.Unlock()
+			w.Write([]byte(`{"results":[{"id":1,"adult":false},{"id":2,"adult":true}],"page":1,"total_pages":2,"total_results":4}`))
+		case r.URL.Path == "/movie/changes":
+			w.Write([]byte(`{"results":[{"id":2,"adult":true},{"id":3,"adult":false}],"page":2,"total_pages":2,"total_results":4}`))
+		case r.URL.Path == "/movie/3":
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"success":false,"status_code":34,"status_message":"The resource you requested could not be found."}`))
+		case strings.HasPrefix(r.URL.Path, "/movie/"):
+			id := strings.TrimPrefix(r.URL.Path, "/movie/")
+			w.Write([]byte(`{"id":` + id + `,"title":"Movie ` + id + `","credits":{"cast":[]},"append":"` + query.Get("append_to_response") + `"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func syncNow() time.Time {
+	return time.Date(2026, 10, 17, 15, 4, 5, 0, time.UTC)
+}
+
+func (suite *TMBDTestSuite) TestSyncerRun() {
+	var windows []string
+	ts := suite.newChangesServer(&windows)
+	defer ts.Close()
+	c, _ := Init(apiKey)
+	c.SetCustomBaseURL(ts.URL)
+	sink := &memorySink{}
+	syncer, err := NewSyncer(c, SyncMovie, sink, SyncOptions{
+		FetchDetails:     true,
+		AppendToResponse: []string{"credits"},
+		WindowDays:       7,
+		Now:              syncNow,
+	})
+	suite.Nil(err)
+	checkpoint, err := syncer.Run(context.Background(), SyncCheckpoint{
+		Until: time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC),
+	})
+	suite.Nil(err)
+	suite.Equal([]string{
+		"2026-10-01/2026-10-07",
+		"2026-10-08/2026-10-14",
+		"2026-10-15/2026-10-17",
+	}, windows)
+	suite.Equal(SyncCheckpoint{
+		Media: SyncMovie,
+		Until: time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC),
+	}, checkpoint)
+	suite.Len(sink.checkpoints, 3)
+	// The ids changed in every window are delivered once.
+	suite.Len(sink.events, 3)
+	suite.Equal(time.Date(2026, 10, 1, 0, 0, 0, 0, time.UTC), sink.events[0].From)
+	suite.Equal(int64(1), sink.events[0].ID)
+	suite.Equal("Movie 1", sink.events[0].Movie.Title)
+	suite.True(sink.events[1].Adult)
+	suite.Equal(int64(3), sink.events[2].ID)
+	suite.True(sink.events[2].Deleted)
+	suite.Nil(sink.events[2].Movie)
+}
+
+func (suite *TMBDTestSuite) TestSyncerResume() {
+	var windows []string
+	ts := suite.newChangesServer(&windows)
+	defer ts.Close()
+	c, _ := Init(apiKey)
+	c.SetCustomBaseURL(ts.URL)
+	sink := &memorySink{failOn: 3}
+	syncer, _ := NewSyncer(c, SyncMovie, sink, SyncOptions{WindowDays: 14, Now: syncNow})
+	checkpoint, err := syncer.Run(context.Background(), SyncCheckpoint{})
+	suite.Equal("sink failure", err.Error())
+	suite.Equal(SyncCheckpoint{Media: SyncMovie}, checkpoint)
+	suite.Empty(sink.checkpoints)
+	sink.failOn = 0
+	checkpoint, err = syncer.Run(context.Background(), checkpoint)
+	suite.Nil(err)
+	suite.Equal([]string{"2026-10-16/2026-10-17", "2026-10-16/2026-10-17"}, windows)
+	suite.Len(sink.events, 5)
+	suite.Nil(sink.events[0].Movie)
+	checkpoint, err = syncer.Run(context.Background(), checkpoint)
+	suite.Nil(err)
+	suite.Equal("2026-10-17/2026-10-17", windows[2])
+	tvSyncer, err := NewSyncer(c, SyncTV, sink, SyncOptions{})
+	suite.Nil(err)
+	_, err = tvSyncer.Run(context.Background(), checkpoint)
+	suite.NotNil(err)
+}
+
+func (suite *TMBDTestSuite) TestNewSyncerFail() {
+	c, _ := Init(apiKey)
+	sink := &memorySink{}
+	_, err := NewSyncer(c, "collection", sink, SyncOptions{})
+	suite.NotNil(err)
+	_, err = NewSyncer(c, SyncMovie, sink, SyncOptions{WindowDays: 15})
+	suite.NotNil(err)
+	_, err = NewSyncer(c, SyncMovie, nil, SyncOptions{})
+	suite.NotNil(err)
+}
@@ -5,6 +5,7 @@ package tmdb
 
 import (
 	"bytes"
+	"cmp"
 	"context"
 	"errors"
 	"fmt"
@@ -12,6 +13,7 @@ import (
 	"net/http"
 	"net/url"
 	"strconv"
+	"strings"
 	"time"
 
 	json "github.com/goccy/go-json"
@@ -19,32 +21,33 @@ import (
 
 // TMDb constants
 const (
-	defaultBaseURL    = "https://api.themoviedb.org/3"
-	alternateBaseURL  = "https://api.tmdb.org/3"
-	permissionURL     = "https://www.themoviedb.org/authenticate/"
-	authenticationURL = "/authentication/"
-	movieURL          = "/movie/"
-	tvURL             = "/tv/"
-	tvSeasonURL       = "/season/"
-	tvEpisodeURL      = "/episode/"
-	personURL         = "/person/"
-	searchURL         = "/search/"
-	collectionURL     = "/collection/"
-	companyURL        = "/company/"
-	configurationURL  = "/configuration/"
-	creditURL         = "/credit/"
-	discoverURL       = "/discover/"
-	networkURL        = "/network/"
-	keywordURL        = "/keyword/"
-	genreURL          = "/genre/"
-	guestSessionURL   = "/guest_session/"
-	listURL           = "/list/"
-	accountURL        = "/account/"
-	watchProvidersURL = "/watch/providers/"
+	defaultBaseURL         = "https://api.themoviedb.org/3"
+	defaultBaseURLV4       = "https://api.themoviedb.org/4"
+	alternateBaseURL       = "https://api.tmdb.org/3"
+	defaultPermissionURL   = "https://www.themoviedb.org/authenticate/"
+	defaultPermissionURLV4 = "https://www.themoviedb.org/auth/access"
+	authenticationURL      = "/authentication/"
+	authV4URL              = "/auth/"
+	movieURL               = "/movie/"
+	tvURL                  = "/tv/"
+	tvSeasonURL            = "/season/"
+	tvEpisodeURL           = "/episode/"
+	personURL              = "/person/"
+	searchURL              = "/search/"
+	collectionURL          = "/collection/"
+	companyURL             = "/company/"
+	configurationURL       = "/configuration/"
+	creditURL              = "/credit/"
+	discoverURL            = "/discover/"
+	networkURL             = "/network/"
+	keywordURL             = "/keyword/"
+	genreURL               = "/genre/"
+	guestSessionURL        = "/guest_session/"
+	listURL                = "/list/"
+	accountURL             = "/account/"
+	watchProvidersURL      = "/watch/providers/"
 )
 
-var baseURL = defaultBaseURL
-
 // Client type is a struct to instantiate this pkg.
 type Client struct {
 	// TMDb apiKey to use the client.
@@ -53,9 +56,29 @@ type Client struct {
 	bearerToken string
 	// sessionId to use the client.
 	sessionID string
+	// baseURL is the API base url used by this client.
+	baseURL string
+	// baseURLV4 is the API v4 base url used by this client.
+	baseURLV4 string
+	// imageBaseURL is the image base url used by this client.
+	imageBaseURL string
+	// permissionURL is the url where users approve request tokens.
+	permissionURL string
+	// permissionURLV4 is the url where users approve v4 request tokens.
+	permissionURLV4 string
 	// Auto retry flag to indicates if the client
 	// should retry the previous operation.
 	autoRetry bool
+	// middlewares wrapping every request, outermost first.
+	middlewares []Middleware
+	// rateLimiter paces the requests, nil means unlimited.
+	rateLimiter *RateLimiter
+	// retryPolicy overrides the autoRetry behavior when set.
+	retryPolicy *RetryPolicy
+	// cache stores GET responses, nil disables caching.
+	cache Cache
+	// cacheTTLs overrides DefaultCacheTTLs per family.
+	cacheTTLs map[CacheFamily]time.Duration
 	// http.Client for custom configuration.
 	http http.Client
 }
@@ -91,6 +114,16 @@ func (c *Client) SetSessionID(sid string) error {
 	return nil
 }
 
+// SetBearerToken will set the bearer token used for v4 requests,
+// e.g. to switch from the read access token to a user access token.
+func (c *Client) SetBearerToken(token string) error {
+	if token == "" {
+		return errors.New("the bearer token is empty")
+	}
+	c.bearerToken = token
+	return nil
+}
+
 // SetClientConfig sets a custom configuration for the http.Client.
 func (c *Client) SetClientConfig(httpClient http.Client) {
 	c.http = httpClient
@@ -106,65 +139,131 @@ const defaultRetryDuration = time.Second * 5
 
 // retryDuration calculates the retry duration time.
 func retryDuration(resp *http.Response) time.Duration {
+	if wait, ok := retryAfter(resp); ok {
+		return wait
+	}
+	return defaultRetryDuration
+}
+
+// retryAfter parses the Retry-After header in seconds.
+func retryAfter(resp *http.Response) (time.Duration, bool) {
 	retryTime := resp.Header.Get("Retry-After")
 	if retryTime == "" {
-		return defaultRetryDuration
+		return 0, false
 	}
 	seconds, err := strconv.ParseInt(retryTime, 10, 32)
-	if err != nil {
-		return defaultRetryDuration
+	if err != nil || seconds < 0 {
+		return 0, false
 	}
-	return time.Duration(seconds) * time.Second
+	return time.Duration(seconds) * time.Second, true
 }
 
-// shouldRetry determines whether the status code indicates that the
-// previous operation should be retried at a later time.
-func shouldRetry(status int) bool {
-	return status == http.StatusAccepted || status == http.StatusTooManyRequests
+// sleepContext pauses the current goroutine for the duration d
+// or until the context is done, whichever happens first.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
 }
 
-func (c *Client) get(url string, data any) error {
+func (c *Client) get(
+	ctx context.Context,
+	endpoint string,
+	url string,
+	data any,
+) error {
 	if url == "" {
 		return errors.New("url field is empty")
 	}
+	// The timeout applies to each attempt, the
+	// retry policy limits the time spent retrying.
 	if c.http.Timeout == 0 {
 		c.http.Timeout = time.Second * 10
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), c.http.Timeout)
-	defer cancel()
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
 	if err != nil {
-		return fmt.Errorf("could not fetch the url: %s", err)
+		return fmt.Errorf("could not fetch the url: %w", err)
 	}
 	req.Header.Add("content-type", "application/json;charset=utf-8")
 	if c.bearerToken != "" {
 		req.Header.Add("Authorization", "Bearer "+c.bearerToken)
 	}
-	for {
-		res, err := c.http.Do(req)
-		if err != nil {
-			return err
-		}
-		defer res.Body.Close()
-		if res.StatusCode == http.StatusTooManyRequests && c.autoRetry {
-			time.Sleep(retryDuration(res))
-			continue
-		}
-		if res.StatusCode == http.StatusNoContent {
-			return nil
-		}
-		if res.StatusCode != http.StatusOK {
-			return c.decodeError(res)
+	var key string
+	var ttl time.Duration
+	cacheable := false
+	if c.cache != nil {
+		key, cacheable = cacheKey(req.URL)
+		ttl = c.cacheTTL(req.URL.Path)
+		cacheable = cacheable && ttl > 0
+	}
+	var cached *CacheEntry
+	if cacheable {
+		if entry, ok := c.cache.Get(key); ok {
+			if entry.Fresh() {
+				return decodeData(entry.Body, data)
+			}
+			cached = entry
+			cached.setConditionalHeaders(req)
 		}
+	}
+	res, err := c.roundTrip(ctx, endpoint, req)
+	if res == nil {
+		return err
+	}
+	defer res.Body.Close()
+	if err != nil {
+		return err
+	}
+	if res.StatusCode == http.StatusNoContent {
+		return nil
+	}
+	if res.StatusCode == http.StatusNotModified && cached != nil {
+		c.cache.Set(key, &CacheEntry{
+			Body:         cached.Body,
+			Expires:      time.Now().Add(ttl),
+			ETag:         cmp.Or(res.Header.Get("ETag"), cached.ETag),
+			LastModified: cmp.Or(res.Header.Get("Last-Modified"), cached.LastModified),
+		})
+		return decodeData(cached.Body, data)
+	}
+	if res.StatusCode != http.StatusOK {
+		return c.decodeError(res)
+	}
+	if !cacheable {
 		if err = json.NewDecoder(res.Body).Decode(data); err != nil {
-			return fmt.Errorf("could not decode the data: %s", err)
+			return fmt.Errorf("could not decode the data: %w", err)
 		}
-		break
+		return nil
+	}
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		return fmt.Errorf("could not read body response: %w", err)
+	}
+	if err := decodeData(body, data); err != nil {
+		return err
+	}
+	if entry, ok := newCacheEntry(res, body, ttl); ok {
+		c.cache.Set(key, entry)
+	}
+	return nil
+}
+
+// decodeData decodes a JSON response body into data.
+func decodeData(body []byte, data any) error {
+	if err := json.NewDecoder(bytes.NewReader(body)).Decode(data); err != nil {
+		return fmt.Errorf("could not decode the data: %w", err)
 	}
 	return nil
 }
 
 func (c *Client) request(
+	ctx context.Context,
+	endpoint string,
 	url string,
 	body any,
 	method string,
@@ -173,15 +272,15 @@ func (c *Client) request(
 	if url == "" {
 		return errors.New("url field is empty")
 	}
+	// The timeout applies to each attempt, the
+	// retry policy limits the time spent retrying.
 	if c.http.Timeout == 0 {
 		c.http.Timeout = time.Second * 10
 	}
-	ctx, cancel := context.WithTimeout(context.Background(), c.http.Timeout)
-	defer cancel()
 	bodyBytes := new(bytes.Buffer)
 	err := json.NewEncoder(bodyBytes).Encode(body)
 	if err != nil {
-		return fmt.Errorf("failed to encode request body to JSON: %s", err)
+		return fmt.Errorf("failed to encode request body to JSON: %w", err)
 	}
 	req, err := http.NewRequestWithContext(
 		ctx,
@@ -190,33 +289,25 @@ func (c *Client) request(
 		bytes.NewBuffer(bodyBytes.Bytes()),
 	)
 	if err != nil {
-		return fmt.Errorf("could not fetch the url: %s", err)
+		return fmt.Errorf("could not fetch the url: %w", err)
 	}
 	req.Header.Add("content-type", "application/json;charset=utf-8")
 	if c.bearerToken != "" {
 		req.Header.Add("Authorization", "Bearer "+c.bearerToken)
 	}
-	for {
-		res, err := c.http.Do(req)
-		if err != nil {
-			return errors.New(err.Error())
-		}
-		defer res.Body.Close()
-		if c.autoRetry && shouldRetry(res.StatusCode) {
-			time.Sleep(retryDuration(res))
-			continue
-		}
-		// Checking if the response is greater or equal
-		// to 300 or less than 200.
-		if res.StatusCode >= http.StatusMultipleChoices ||
-			res.StatusCode < http.StatusOK ||
-			res.StatusCode == http.StatusNoContent {
-			return c.decodeError(res)
-		}
-		if err = json.NewDecoder(res.Body).Decode(data); err != nil {
-			return fmt.Errorf("could not decode the data: %s", err)
-		}
-		break
+	res, err := c.roundTrip(ctx, endpoint, req)
+	if res == nil {
+		return err
+	}
+	defer res.Body.Close()
+	if err != nil {
+		return err
+	}
+	if res.StatusCode == http.StatusNoContent {
+		return c.decodeError(res)
+	}
+	if err = json.NewDecoder(res.Body).Decode(data); err != nil {
+		return fmt.Errorf("could not decode the data: %w", err)
 	}
 	return nil
 }
@@ -237,28 +328,107 @@ func (c *Client) fmtOptions(
 	return options
 }
 
+// fmtOptionsV4 formats the url options as the query
+// string of a v4 request, which has no api key.
+func (c *Client) fmtOptionsV4(
+	urlOptions map[string]string,
+) string {
+	return strings.Replace(c.fmtOptions(urlOptions), "&", "?", 1)
+}
+
 // SetAlternateBaseURL sets an alternate base url.
 func (c *Client) SetAlternateBaseURL() {
-	baseURL = alternateBaseURL
+	c.baseURL = alternateBaseURL
 }
 
 // SetCustomBaseURL sets an custom base url.
 func (c *Client) SetCustomBaseURL(url string) {
-	baseURL = url
+	c.baseURL = url
 }
 
 // GetBaseURL gets the current base url.
 func (c *Client) GetBaseURL() string {
-	return baseURL
+	if c.baseURL == "" {
+		return defaultBaseURL
+	}
+	return c.baseURL
+}
+
+// SetCustomBaseURLV4 sets an custom base url for v4 requests.
+func (c *Client) SetCustomBaseURLV4(url string) {
+	c.baseURLV4 = url
+}
+
+// GetBaseURLV4 gets the current base url for v4 requests.
+//
+// When no v4 base url is set, it is derived from the
+// current base url, so "https://api.tmdb.org/3" becomes
+// "https://api.tmdb.org/4" and "http://localhost:3000"
+// becomes "http://localhost:3000/4".
+func (c *Client) GetBaseURLV4() string {
+	if c.baseURLV4 != "" {
+		return c.baseURLV4
+	}
+	if c.baseURL == "" {
+		return defaultBaseURLV4
+	}
+	return strings.TrimSuffix(c.baseURL, "/3") + "/4"
+}
+
+// SetImageBaseURL sets a custom image base url.
+func (c *Client) SetImageBaseURL(url string) {
+	c.imageBaseURL = url
+}
+
+// GetImageBaseURL gets the current image base url.
+func (c *Client) GetImageBaseURL() string {
+	if c.imageBaseURL == "" {
+		return imageURL
+	}
+	return c.imageBaseURL
+}
+
+// SetPermissionURL sets a custom permission url.
+func (c *Client) SetPermissionURL(url string) {
+	c.permissionURL = url
+}
+
+// GetPermissionURL gets the current permission url.
+func (c *Client) GetPermissionURL() string {
+	if c.permissionURL == "" {
+		return defaultPermissionURL
+	}
+	return c.permissionURL
+}
+
+// SetPermissionURLV4 sets a custom permission url for v4 request tokens.
+func (c *Client) SetPermissionURLV4(url string) {
+	c.permissionURLV4 = url
+}
+
+// GetPermissionURLV4 gets the current permission url for v4 request tokens.
+func (c *Client) GetPermissionURLV4() string {
+	if c.permissionURLV4 == "" {
+		return defaultPermissionURLV4
+	}
+	return c.permissionURLV4
 }
 
 // Error type represents an error returned by the TMDB API.
+//
+// The client returns an *APIError, which can still be
+// matched as an Error with errors.As.
 type Error struct {
 	StatusMessage string `json:"status_message,omitempty"`
 	Success       bool   `json:"success,omitempty"`
 	StatusCode    int    `json:"status_code,omitempty"`
 }
 
+// Code returns the TMDb status code of the error.
+func (e Error) Code() StatusCode {
+	return StatusCode(e.StatusCode)
+}
+
 func (e Error) Error() string {
 	return fmt.Sprintf(
 		"code: %d | success: %t | message: %s",
@@ -268,26 +438,30 @@ func (e Error) Error() string {
 	)
 }
 
+// decodeError decodes the error of a failed response
+// into an APIError.
 func (c *Client) decodeError(r *http.Response) error {
 	resBody, err := io.ReadAll(r.Body)
 	if err != nil {
-		return fmt.Errorf("could not read body response: %s", err)
+		return fmt.Errorf("could not read body response: %w", err)
 	}
+	apiErr := newAPIError(r)
 	if len(resBody) == 0 {
-		return fmt.Errorf(
-			"[%d]: empty body %s",
-			r.StatusCode,
-			http.StatusText(r.StatusCode),
-		)
+		return apiErr
 	}
 	buf := bytes.NewBuffer(resBody)
 	var clientError Error
 	if err := json.NewDecoder(buf).Decode(&clientError); err != nil {
-		return fmt.Errorf(
-			"couldn't decode error: (%d) [%s]",
-			len(resBody),
-			resBody,
-		)
+		apiErr.Body = resBody
+		return apiErr
+	}
+	apiErr.StatusCode = clientError.StatusCode
+	apiErr.StatusMessage = clientError.StatusMessage
+	apiErr.Success = clientError.Success
+	// Some errors, like the validation ones, have no
+	// status code but an errors list, kept in the body.
+	if apiErr.StatusCode == 0 && apiErr.StatusMessage == "" {
+		apiErr.Body = resBody
 	}
-	return clientError
+	return apiErr
 }
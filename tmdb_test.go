@@ -1,13 +1,18 @@
 package tmdb
 
 import (
+	"context"
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/cyruzin/golang-tmdb/tmdbrecord"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -16,12 +21,67 @@ const apiKey = "9aca69849a23528a419aea463387945f"
 
 type TMBDTestSuite struct {
 	suite.Suite
-	client Client
+	client   Client
+	cassette *cassetteTransport
 }
 
+// SetupTest points the suite client to the real API, or, when
+// TMDB_CASSETTES is "record" or "replay", records or replays
+// the interactions of each test in testdata/cassettes.
 func (suite *TMBDTestSuite) SetupTest() {
 	suite.client.apiKey = apiKey
 	suite.client.SetClientAutoRetry()
+	suite.cassette = nil
+	cassettes := os.Getenv("TMDB_CASSETTES")
+	if cassettes == "" {
+		return
+	}
+	mode, err := tmdbrecord.ParseMode(cassettes)
+	suite.Require().NoError(err)
+	suite.cassette = &cassetteTransport{
+		t:    suite.T(),
+		mode: mode,
+		path: filepath.Join("testdata", "cassettes", suite.T().Name()+".json"),
+	}
+	suite.client.http = http.Client{Transport: suite.cassette}
+}
+
+func (suite *TMBDTestSuite) TearDownTest() {
+	if suite.cassette != nil && suite.cassette.recorder != nil {
+		suite.NoError(suite.cassette.recorder.Save())
+	}
+}
+
+// cassetteTransport opens the cassette of a test on its first
+// request to the API, so tests that make no request neither
+// need nor write one. In replay mode, a test calling the API
+// without a cassette is skipped.
+type cassetteTransport struct {
+	t        *testing.T
+	mode     tmdbrecord.Mode
+	path     string
+	mu       sync.Mutex
+	recorder *tmdbrecord.Recorder
+}
+
+func (c *cassetteTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	c.mu.Lock()
+	if c.recorder == nil {
+		if c.mode == tmdbrecord.Replay {
+			if _, err := os.Stat(c.path); err != nil {
+				c.mu.Unlock()
+				c.t.Skipf("no cassette for %s", c.t.Name())
+			}
+		}
+		recorder, err := tmdbrecord.New(c.path, tmdbrecord.Options{Mode: c.mode})
+		if err != nil {
+			c.mu.Unlock()
+			return nil, err
+		}
+		c.recorder = recorder
+	}
+	c.mu.Unlock()
+	return c.recorder.RoundTrip(req)
 }
 
 func TestSuite(t *testing.T) {
@@ -29,29 +89,29 @@ func TestSuite(t *testing.T) {
 }
 
 func (suite *TMBDTestSuite) TestGetFail() {
-	err := suite.client.get("http://www.testfakewebsite.org", nil)
+	err := suite.client.get(context.Background(), "", "http://www.testfakewebsite.org", nil)
 	suite.Error(err)
 	suite.Contains(err.Error(), "no such host")
-	err = suite.client.get("https://api.themoviedb.org/3/movie/7578000?language=en-US", nil)
+	err = suite.client.get(context.Background(), "", "https://api.themoviedb.org/3/movie/7578000?language=en-US", nil)
 	suite.Error(err)
 	suite.Contains(err.Error(), "code: 7 | success: false | message: Invalid API key: You must be granted a valid key.")
-	err = suite.client.get("", nil)
+	err = suite.client.get(context.Background(), "", "", nil)
 	suite.Error(err)
 	suite.Equal("url field is empty", err.Error())
 	var invalidTarget int
-	err = suite.client.get("https://www.google.com.br", &invalidTarget)
+	err = suite.client.get(context.Background(), "", "https://www.google.com.br", &invalidTarget)
 	if err != nil {
 		suite.Contains(err.Error(), "could not decode the data")
 	}
 }
 
 func (suite *TMBDTestSuite) TestGetSpecialCases() {
-	err := suite.client.get("http://[::1]:namedport", nil)
+	err := suite.client.get(context.Background(), "", "http://[::1]:namedport", nil)
 	suite.Error(err)
 	suite.Contains(err.Error(), "could not fetch the url")
 
 	c := Client{bearerToken: "FAKE_BEARER_TOKEN"}
-	err = c.get("https://api.themoviedb.org/3/movie/7578000?language=en-US", nil)
+	err = c.get(context.Background(), "", "https://api.themoviedb.org/3/movie/7578000?language=en-US", nil)
 	suite.Error(err)
 	suite.True(
 		strings.Contains(err.Error(), "Invalid access token") ||
@@ -66,7 +126,7 @@ func (suite *TMBDTestSuite) TestGetSpecialCases() {
 		w.Write([]byte(`{"status_code":25,"status_message":"Your request count (#) is over the allowed limit of (40).","success":false}`))
 	}))
 	defer tsRetry.Close()
-	err = suite.client.get(tsRetry.URL, nil)
+	err = suite.client.get(context.Background(), "", tsRetry.URL, nil)
 	suite.Error(err)
 	suite.True(retryCalled)
 	suite.True(
@@ -78,12 +138,14 @@ func (suite *TMBDTestSuite) TestGetSpecialCases() {
 		w.WriteHeader(http.StatusNoContent)
 	}))
 	defer tsNoContent.Close()
-	err = suite.client.get(tsNoContent.URL, nil)
+	err = suite.client.get(context.Background(), "", tsNoContent.URL, nil)
 	suite.Nil(err)
 }
 
 func (suite *TMBDTestSuite) TestRequestFail() {
 	err := suite.client.request(
+		context.Background(),
+		"",
 		"http://www.testfakewebsite.org",
 		[]byte{},
 		"POST",
@@ -93,6 +155,8 @@ func (suite *TMBDTestSuite) TestRequestFail() {
 	suite.Contains(err.Error(), "no such host")
 
 	err = suite.client.request(
+		context.Background(),
+		"",
 		"https://api.themoviedb.org/3/authentication/session/new",
 		[]byte{},
 		"POST",
@@ -104,12 +168,12 @@ func (suite *TMBDTestSuite) TestRequestFail() {
 		"code: 7 | success: false | message: Invalid API key: You must be granted a valid key.",
 	)
 
-	err = suite.client.request("", []byte{}, "POST", nil)
+	err = suite.client.request(context.Background(), "", "", []byte{}, "POST", nil)
 	suite.Error(err)
 	suite.Equal("url field is empty", err.Error())
 
 	var invalidTarget int
-	err = suite.client.request("https://api.themoviedb.org/3/movie/7578000?language=en-US", nil, "GET", &invalidTarget)
+	err = suite.client.request(context.Background(), "", "https://api.themoviedb.org/3/movie/7578000?language=en-US", nil, "GET", &invalidTarget)
 	suite.Error(err)
 	suite.True(
 		strings.Contains(err.Error(), "could not decode the data") ||
@@ -121,17 +185,17 @@ func (suite *TMBDTestSuite) TestEncodeBodyError() {
 	body := struct {
 		Fn func()
 	}{Fn: func() {}}
-	err := suite.client.request("http://www.testfakewebsite.org", body, "POST", nil)
+	err := suite.client.request(context.Background(), "", "http://www.testfakewebsite.org", body, "POST", nil)
 	suite.Error(err)
 }
 
 func (suite *TMBDTestSuite) TestRequestSpecialCases() {
-	err := suite.client.request("http://[::1]:namedport", nil, "GET", nil)
+	err := suite.client.request(context.Background(), "", "http://[::1]:namedport", nil, "GET", nil)
 	suite.Error(err)
 	suite.Contains(err.Error(), "could not fetch the url")
 
 	c := Client{bearerToken: "FAKE_BEARER_TOKEN"}
-	err = c.request("https://api.themoviedb.org/3/movie/7578000?language=en-US", nil, "GET", nil)
+	err = c.request(context.Background(), "", "https://api.themoviedb.org/3/movie/7578000?language=en-US", nil, "GET", nil)
 	suite.Error(err)
 	suite.True(
 		strings.Contains(err.Error(), "Invalid") ||
@@ -150,7 +214,7 @@ func (suite *TMBDTestSuite) TestRequestSpecialCases() {
 	}))
 	defer tsRetry.Close()
 	c.http.Timeout = time.Second * 10
-	err = c.request(tsRetry.URL, nil, "GET", nil)
+	err = c.request(context.Background(), "", tsRetry.URL, nil, "GET", nil)
 	suite.Error(err)
 	suite.True(retryCalled)
 	suite.True(
@@ -165,14 +229,14 @@ func (suite *TMBDTestSuite) TestRequestSpecialCases() {
 		w.Write([]byte(`{"foo": "bar"}`))
 	}))
 	defer tsDecode.Close()
-	err = suite.client.request(tsDecode.URL, nil, "GET", &invalidTarget)
+	err = suite.client.request(context.Background(), "", tsDecode.URL, nil, "GET", &invalidTarget)
 	suite.Error(err)
 	suite.Contains(err.Error(), "could not decode the data")
 }
 
 func (suite *TMBDTestSuite) TestDecodeDataFail() {
 	b := []byte(`{}`)
-	err := suite.client.get("https://www.google.com.br", b)
+	err := suite.client.get(context.Background(), "", "https://www.google.com.br", b)
 	suite.Contains(err.Error(), "could not decode the data")
 }
 
@@ -287,3 +351,75 @@ func (suite *TMBDTestSuite) TestCustomBaseURL() {
 
 	suite.Equal(suite.client.GetBaseURL(), "https://api.themoviedb.org/3")
 }
+
+func (suite *TMBDTestSuite) TestGetRetryContextCanceled() {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "5")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer ts.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*100)
+	defer cancel()
+	start := time.Now()
+	err := suite.client.get(ctx, "", ts.URL, nil)
+	suite.ErrorIs(err, context.DeadlineExceeded)
+	suite.Less(time.Since(start), time.Second*5)
+}
+
+func (suite *TMBDTestSuite) TestRequestRetryContextCanceled() {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "5")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer ts.Close()
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err := suite.client.request(ctx, "", ts.URL, nil, http.MethodPost, nil)
+	suite.ErrorIs(err, context.Canceled)
+}
+
+func (suite *TMBDTestSuite) TestGetMovieDetailsWithContextCanceled() {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	_, err := suite.client.GetMovieDetailsWithContext(ctx, bumblebeeID, nil)
+	suite.ErrorIs(err, context.Canceled)
+}
+
+func (suite *TMBDTestSuite) TestBaseURLPerClient() {
+	newServer := func(title string) *httptest.Server {
+		return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{"title":"` + title + `"}`))
+		}))
+	}
+	tsA, tsB := newServer("A"), newServer("B")
+	defer tsA.Close()
+	defer tsB.Close()
+	a, _ := Init(apiKey)
+	b, _ := Init(apiKey)
+	a.SetCustomBaseURL(tsA.URL)
+	b.SetCustomBaseURL(tsB.URL)
+	movieA, err := a.GetMovieDetails(bumblebeeID, nil)
+	suite.Nil(err)
+	movieB, err := b.GetMovieDetails(bumblebeeID, nil)
+	suite.Nil(err)
+	suite.Equal("A", movieA.Title)
+	suite.Equal("B", movieB.Title)
+	c, _ := Init(apiKey)
+	suite.Equal(defaultBaseURL, c.GetBaseURL())
+}
+
+func (suite *TMBDTestSuite) TestPermissionURL() {
+	c, _ := Init(apiKey)
+	suite.Equal("https://www.themoviedb.org/authenticate/", c.GetPermissionURL())
+	c.SetPermissionURL("http://localhost:3000/authenticate/")
+	suite.Equal("http://localhost:3000/authenticate/", c.GetPermissionURL())
+}
+
+func (suite *TMBDTestSuite) TestPermissionURLV4() {
+	c, _ := Init(apiKey)
+	suite.Equal("https://www.themoviedb.org/auth/access", c.GetPermissionURLV4())
+	suite.Equal("https://www.themoviedb.org/auth/access?request_token=rt", c.GetApprovalURLV4("rt"))
+	c.SetPermissionURLV4("http://localhost:3000/auth/access")
+	suite.Equal("http://localhost:3000/auth/access", c.GetPermissionURLV4())
+	suite.Equal("http://localhost:3000/auth/access?request_token=rt", c.GetApprovalURLV4("rt"))
+}
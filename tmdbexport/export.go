@@ -0,0 +1,202 @@
+// Package tmdbexport reads the daily ID export files published by TMDb.
+//
+// The files list the valid ids of every movie, TV series, person,
+// collection, TV network, keyword and production company, one JSON
+// object per line, and are the way to bootstrap a full mirror:
+//
+//	res, err := http.Get(tmdbexport.URL(tmdbexport.Movie, time.Now()))
+//	...
+//	reader, err := tmdbexport.NewReader(res.Body)
+//	...
+//	for record, err := range reader.All() {
+//		...
+//	}
+//
+// https://developer.themoviedb.org/docs/daily-id-exports
+package tmdbexport
+
+import (
+	"bufio"
+	"bytes"
+	"cmp"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"iter"
+	"slices"
+	"time"
+
+	json "github.com/goccy/go-json"
+)
+
+// baseURL is where the export files are published.
+const baseURL = "http://files.tmdb.org/p/exports/"
+
+// Kind type is the kind of ids listed by an export file.
+type Kind string
+
+// Kinds of export files.
+const (
+	Movie             Kind = "movie"
+	TVSeries          Kind = "tv_series"
+	Person            Kind = "person"
+	Collection        Kind = "collection"
+	TVNetwork         Kind = "tv_network"
+	Keyword           Kind = "keyword"
+	ProductionCompany Kind = "production_company"
+)
+
+// FileName returns the name of the export file of kind for the
+// day of date, like "movie_ids_05_15_2024.json.gz". The files
+// are published around 8:00 AM UTC.
+func FileName(kind Kind, date time.Time) string {
+	return fmt.Sprintf("%s_ids_%s.json.gz", kind, date.UTC().Format("01_02_2006"))
+}
+
+// URL returns the url of the export file of kind for the day of date.
+func URL(kind Kind, date time.Time) string {
+	return baseURL + FileName(kind, date)
+}
+
+// Record type is a struct for a line of an export file.
+// Which fields are set depends on the kind of the file.
+type Record struct {
+	ID            int64   `json:"id"`
+	Adult         bool    `json:"adult,omitempty"`          // Movie and Person
+	Video         bool    `json:"video,omitempty"`          // Movie
+	Popularity    float64 `json:"popularity,omitempty"`     // Movie, TVSeries and Person
+	OriginalTitle string  `json:"original_title,omitempty"` // Movie
+	OriginalName  string  `json:"original_name,omitempty"`  // TVSeries
+	Name          string  `json:"name,omitempty"`           // Others
+}
+
+// Title returns the title or name of the record, whichever is set.
+func (r Record) Title() string {
+	return cmp.Or(r.OriginalTitle, r.OriginalName, r.Name)
+}
+
+// gzipMagic are the first bytes of a gzip stream.
+var gzipMagic = []byte{0x1f, 0x8b}
+
+// Reader type decodes the records of an export file.
+type Reader struct {
+	gz   *gzip.Reader
+	buf  *bufio.Reader
+	line int
+}
+
+// NewReader creates a Reader decoding r, which can be either
+// the gzip'd file as published or the uncompressed lines.
+func NewReader(r io.Reader) (*Reader, error) {
+	buf := bufio.NewReader(r)
+	magic, err := buf.Peek(len(gzipMagic))
+	if err != nil && err != io.EOF {
+		return nil, fmt.Errorf("could not read export file: %w", err)
+	}
+	if !bytes.Equal(magic, gzipMagic) {
+		return &Reader{buf: buf}, nil
+	}
+	gz, err := gzip.NewReader(buf)
+	if err != nil {
+		return nil, fmt.Errorf("could not decompress export file: %w", err)
+	}
+	return &Reader{gz: gz, buf: bufio.NewReader(gz)}, nil
+}
+
+// Read returns the next record, or io.EOF after the last one.
+func (r *Reader) Read() (Record, error) {
+	for {
+		line, err := r.buf.ReadBytes('\n')
+		if len(line) == 0 && err != nil {
+			if err == io.EOF {
+				return Record{}, io.EOF
+			}
+			return Record{}, fmt.Errorf("could not read export file: %w", err)
+		}
+		r.line++
+		line = bytes.TrimSpace(line)
+		if len(line) == 0 {
+			continue
+		}
+		var record Record
+		if err := json.Unmarshal(line, &record); err != nil {
+			return Record{}, fmt.Errorf("line %d: could not decode record: %w", r.line, err)
+		}
+		return record, nil
+	}
+}
+
+// All returns an iterator over the remaining records. A decoding
+// error is yielded once and ends the iteration.
+func (r *Reader) All() iter.Seq2[Record, error] {
+	return func(yield func(Record, error) bool) {
+		for {
+			record, err := r.Read()
+			if err == io.EOF {
+				return
+			}
+			if !yield(record, err) || err != nil {
+				return
+			}
+		}
+	}
+}
+
+// Close closes the gzip stream. It does not close the
+// underlying reader.
+func (r *Reader) Close() error {
+	if r.gz == nil {
+		return nil
+	}
+	return r.gz.Close()
+}
+
+// Diff type is a struct for the ids added and removed between
+// two export files, sorted by id.
+type Diff struct {
+	Added   []Record
+	Removed []int64
+}
+
+// DiffFiles compares two export files of the same kind, like the
+// files of two consecutive days, and returns the added and removed
+// ids. The ids of the previous file are kept in memory while the
+// next file is streamed.
+func DiffFiles(previous, next io.Reader) (*Diff, error) {
+	previousReader, err := NewReader(previous)
+	if err != nil {
+		return nil, err
+	}
+	defer previousReader.Close()
+	ids := map[int64]struct{}{}
+	for record, err := range previousReader.All() {
+		if err != nil {
+			return nil, err
+		}
+		ids[record.ID] = struct{}{}
+	}
+	nextReader, err := NewReader(next)
+	if err != nil {
+		return nil, err
+	}
+	defer nextReader.Close()
+	diff := &Diff{}
+	for record, err := range nextReader.All() {
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := ids[record.ID]; ok {
+			delete(ids, record.ID)
+			continue
+		}
+		diff.Added = append(diff.Added, record)
+	}
+	for id := range ids {
+		diff.Removed = append(diff.Removed, id)
+	}
+	slices.SortFunc(diff.Added, func(a, b Record) int {
+		return cmp.Compare(a.ID, b.ID)
+	})
+	slices.Sort(diff.Removed)
+	return diff, nil
+}
@@ -0,0 +1,96 @@
+package tmdbexport
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func gzipFixture(t *testing.T, name string) *bytes.Buffer {
+	t.Helper()
+	data, err := os.ReadFile("testdata/" + name)
+	require.NoError(t, err)
+	buf := &bytes.Buffer{}
+	gz := gzip.NewWriter(buf)
+	_, err = gz.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, gz.Close())
+	return buf
+}
+
+func TestFileName(t *testing.T) {
+	date := time.Date(2026, 10, 17, 9, 0, 0, 0, time.UTC)
+	assert.Equal(t, "movie_ids_10_17_2026.json.gz", FileName(Movie, date))
+	assert.Equal(
+		t,
+		"http://files.tmdb.org/p/exports/tv_series_ids_10_17_2026.json.gz",
+		URL(TVSeries, date),
+	)
+}
+
+func TestReader(t *testing.T) {
+	reader, err := NewReader(gzipFixture(t, "movie_ids_10_16_2026.json"))
+	require.NoError(t, err)
+	defer reader.Close()
+	records := []Record{}
+	for record, err := range reader.All() {
+		require.NoError(t, err)
+		records = append(records, record)
+	}
+	require.Len(t, records, 4)
+	assert.Equal(t, Record{
+		ID:            3924,
+		Popularity:    2.4,
+		OriginalTitle: "Blondie",
+	}, records[0])
+	assert.True(t, records[2].Adult)
+	assert.True(t, records[3].Video)
+	assert.Equal(t, "Love at Twenty", records[2].Title())
+	_, err = reader.Read()
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestReaderUncompressed(t *testing.T) {
+	input := `{"id":1,"name":"Pixar"}` + "\n" + `{"id":2,"name":"Studio Ghibli"}`
+	reader, err := NewReader(strings.NewReader(input))
+	require.NoError(t, err)
+	record, err := reader.Read()
+	require.NoError(t, err)
+	assert.Equal(t, "Pixar", record.Title())
+	record, err = reader.Read()
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), record.ID)
+	_, err = reader.Read()
+	assert.Equal(t, io.EOF, err)
+}
+
+func TestReaderInvalidLine(t *testing.T) {
+	reader, err := NewReader(strings.NewReader("{\"id\":1}\n{\"id\":\n"))
+	require.NoError(t, err)
+	count := 0
+	for _, err := range reader.All() {
+		count++
+		if count == 2 {
+			assert.ErrorContains(t, err, "line 2")
+		}
+	}
+	assert.Equal(t, 2, count)
+}
+
+func TestDiffFiles(t *testing.T) {
+	diff, err := DiffFiles(
+		gzipFixture(t, "movie_ids_10_16_2026.json"),
+		gzipFixture(t, "movie_ids_10_17_2026.json"),
+	)
+	require.NoError(t, err)
+	require.Len(t, diff.Added, 1)
+	assert.Equal(t, int64(1000001), diff.Added[0].ID)
+	assert.Equal(t, []int64{6124}, diff.Removed)
+}
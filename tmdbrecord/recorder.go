@@ -0,0 +1,357 @@
+// Package tmdbrecord provides an http.RoundTripper that records
+// TMDb responses into cassette files and replays them, so tests
+// run deterministically without network.
+//
+// In record mode the requests go to the real API and the
+// interactions are saved, without the credentials, by Save:
+//
+//	recorder, err := tmdbrecord.New("testdata/movies.json", tmdbrecord.Options{
+//		Mode: tmdbrecord.Record,
+//	})
+//	defer recorder.Save()
+//	tmdbClient.SetClientConfig(http.Client{Transport: recorder})
+//
+// In replay mode the requests are answered from the cassette,
+// matching the method, path and canonical query string.
+package tmdbrecord
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	json "github.com/goccy/go-json"
+)
+
+// ErrNoInteraction is returned in replay mode when the
+// cassette has no interaction matching a request.
+var ErrNoInteraction = errors.New("tmdbrecord: no recorded interaction")
+
+// Mode type is the mode of a Recorder.
+type Mode int
+
+// Recorder modes.
+const (
+	// Replay answers the requests from the cassette.
+	Replay Mode = iota
+	// Record sends the requests to the real API
+	// and records the interactions.
+	Record
+)
+
+// ParseMode parses "record" or "replay".
+func ParseMode(s string) (Mode, error) {
+	switch strings.ToLower(s) {
+	case "replay":
+		return Replay, nil
+	case "record":
+		return Record, nil
+	default:
+		return 0, fmt.Errorf("tmdbrecord: unknown mode %q", s)
+	}
+}
+
+// String returns the name of the mode.
+func (m Mode) String() string {
+	if m == Record {
+		return "record"
+	}
+	return "replay"
+}
+
+// redacted replaces the scrubbed values in the request and response bodies.
+const redacted = "REDACTED"
+
+// DefaultScrubParams are the query parameters and JSON
+// body fields never written to a cassette.
+var DefaultScrubParams = []string{
+	"api_key",
+	"session_id",
+	"guest_session_id",
+	"access_token",
+	"password",
+}
+
+// Options type is a struct to configure a Recorder.
+type Options struct {
+	// Mode is the recorder mode, Replay by default.
+	Mode Mode
+	// Transport sends the requests in record mode,
+	// http.DefaultTransport when nil.
+	Transport http.RoundTripper
+	// ScrubParams are scrubbed on top of DefaultScrubParams.
+	ScrubParams []string
+}
+
+// Cassette type is the content of a cassette file.
+type Cassette struct {
+	Interactions []Interaction `json:"interactions"`
+}
+
+// Interaction type is a recorded request and its response.
+type Interaction struct {
+	Request  RecordedRequest  `json:"request"`
+	Response RecordedResponse `json:"response"`
+}
+
+// RecordedRequest type is a request without its credentials.
+type RecordedRequest struct {
+	Method string `json:"method"`
+	Path   string `json:"path"`
+	// Query is the canonical query string, without
+	// the scrubbed parameters.
+	Query string `json:"query,omitempty"`
+	Body  string `json:"body,omitempty"`
+}
+
+// RecordedResponse type is a recorded response.
+type RecordedResponse struct {
+	StatusCode int         `json:"status_code"`
+	Header     http.Header `json:"header,omitempty"`
+	Body       string      `json:"body"`
+}
+
+// Recorder type is an http.RoundTripper recording
+// or replaying TMDb interactions. It is safe for
+// concurrent use.
+type Recorder struct {
+	path      string
+	mode      Mode
+	transport http.RoundTripper
+	scrub     []string
+
+	mu       sync.Mutex
+	cassette Cassette
+	// replayed counts the replays of each request, so
+	// repeated requests get the recorded responses in order.
+	replayed map[string]int
+}
+
+// New creates a Recorder for the cassette at path. In
+// replay mode the cassette must exist, in record mode it
+// is created or replaced by Save.
+func New(path string, opts Options) (*Recorder, error) {
+	r := &Recorder{
+		path:      path,
+		mode:      opts.Mode,
+		transport: opts.Transport,
+		scrub:     append(slices.Clone(DefaultScrubParams), opts.ScrubParams...),
+		replayed:  map[string]int{},
+	}
+	if r.transport == nil {
+		r.transport = http.DefaultTransport
+	}
+	if r.mode == Replay {
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("tmdbrecord: could not read the cassette: %w", err)
+		}
+		if err := json.Unmarshal(b, &r.cassette); err != nil {
+			return nil, fmt.Errorf("tmdbrecord: could not decode the cassette: %w", err)
+		}
+	}
+	return r, nil
+}
+
+// Mode returns the mode of the recorder.
+func (r *Recorder) Mode() Mode {
+	return r.mode
+}
+
+// RoundTrip implements http.RoundTripper.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	if r.mode == Replay {
+		return r.replay(req)
+	}
+	return r.record(req)
+}
+
+func (r *Recorder) replay(req *http.Request) (*http.Response, error) {
+	if req.Body != nil {
+		req.Body.Close()
+	}
+	key := r.key(req.Method, req.URL)
+	r.mu.Lock()
+	var matches []Interaction
+	for _, interaction := range r.cassette.Interactions {
+		recorded := interaction.Request
+		if recorded.Method+" "+recorded.Path+"?"+recorded.Query == key {
+			matches = append(matches, interaction)
+		}
+	}
+	n := r.replayed[key]
+	r.replayed[key]++
+	r.mu.Unlock()
+	if len(matches) == 0 {
+		return nil, fmt.Errorf("%w for %s", ErrNoInteraction, key)
+	}
+	// Past the recorded responses, the last one is repeated.
+	response := matches[min(n, len(matches)-1)].Response
+	return &http.Response{
+		Status:        fmt.Sprintf("%d %s", response.StatusCode, http.StatusText(response.StatusCode)),
+		StatusCode:    response.StatusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        response.Header.Clone(),
+		Body:          io.NopCloser(strings.NewReader(response.Body)),
+		ContentLength: int64(len(response.Body)),
+		Request:       req,
+	}, nil
+}
+
+func (r *Recorder) record(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		req.Body = io.NopCloser(bytes.NewReader(body))
+	}
+	res, err := r.transport.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	resBody, err := io.ReadAll(res.Body)
+	res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	res.Body = io.NopCloser(bytes.NewReader(resBody))
+	header := res.Header.Clone()
+	header.Del("Set-Cookie")
+	interaction := Interaction{
+		Request: RecordedRequest{
+			Method: req.Method,
+			Path:   req.URL.Path,
+			Query:  r.canonicalQuery(req.URL),
+			Body:   r.scrubBody(body),
+		},
+		Response: RecordedResponse{
+			StatusCode: res.StatusCode,
+			Header:     header,
+			Body:       r.scrubBody(resBody),
+		},
+	}
+	r.mu.Lock()
+	r.cassette.Interactions = append(r.cassette.Interactions, interaction)
+	r.mu.Unlock()
+	return res, nil
+}
+
+// Save writes the recorded interactions to the cassette
+// file, creating its directory if needed. It does nothing
+// in replay mode or when no interaction was recorded.
+func (r *Recorder) Save() error {
+	if r.mode != Record {
+		return nil
+	}
+	r.mu.Lock()
+	if len(r.cassette.Interactions) == 0 {
+		r.mu.Unlock()
+		return nil
+	}
+	b, err := json.MarshalIndent(r.cassette, "", "  ")
+	r.mu.Unlock()
+	if err != nil {
+		return err
+	}
+	dir := filepath.Dir(r.path)
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(dir, "tmp-*")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(append(b, '\n')); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := os.Rename(tmp.Name(), r.path); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return nil
+}
+
+// Interactions returns the recorded or loaded interactions.
+func (r *Recorder) Interactions() []Interaction {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return slices.Clone(r.cassette.Interactions)
+}
+
+// key identifies a request for the replay.
+func (r *Recorder) key(method string, u *url.URL) string {
+	return method + " " + u.Path + "?" + r.canonicalQuery(u)
+}
+
+// canonicalQuery returns the query string of u without the
+// scrubbed parameters, with the keys and values sorted.
+func (r *Recorder) canonicalQuery(u *url.URL) string {
+	query := u.Query()
+	for _, param := range r.scrub {
+		query.Del(param)
+	}
+	for _, values := range query {
+		slices.Sort(values)
+	}
+	return query.Encode()
+}
+
+// scrubBody replaces the scrubbed fields of a JSON body.
+// Other bodies are kept as they are.
+func (r *Recorder) scrubBody(body []byte) string {
+	var v any
+	if len(body) == 0 || json.Unmarshal(body, &v) != nil {
+		return string(body)
+	}
+	if !r.scrubValue(v) {
+		return string(body)
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		return string(body)
+	}
+	return string(b)
+}
+
+// scrubValue scrubs v in place and reports whether it changed.
+func (r *Recorder) scrubValue(v any) bool {
+	changed := false
+	switch v := v.(type) {
+	case map[string]any:
+		for key, value := range v {
+			if slices.Contains(r.scrub, key) {
+				if _, ok := value.(string); ok {
+					v[key] = redacted
+					changed = true
+				}
+				continue
+			}
+			changed = r.scrubValue(value) || changed
+		}
+	case []any:
+		for _, value := range v {
+			changed = r.scrubValue(value) || changed
+		}
+	}
+	return changed
+}
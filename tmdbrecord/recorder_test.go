@@ -0,0 +1,102 @@
+package tmdbrecord
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	tmdb "github.com/cyruzin/golang-tmdb"
+	"github.com/cyruzin/golang-tmdb/tmdbtest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecordReplay(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassettes", "movies.json")
+	server := tmdbtest.NewServer()
+	defer server.Close()
+
+	recorder, err := New(path, Options{Mode: Record})
+	require.NoError(t, err)
+	client, err := server.NewClient()
+	require.NoError(t, err)
+	require.NoError(t, client.SetSessionID(tmdbtest.SessionID))
+	client.SetClientConfig(http.Client{Transport: recorder})
+	options := map[string]string{"language": "en-US", "append_to_response": "credits"}
+	_, err = client.GetMovieDetails(550, options)
+	require.NoError(t, err)
+	_, err = client.GetMovieDetails(1, nil)
+	require.Error(t, err)
+	_, err = client.GetAccountDetails()
+	require.NoError(t, err)
+	require.NoError(t, recorder.Save())
+
+	b, err := os.ReadFile(path)
+	require.NoError(t, err)
+	assert.NotContains(t, string(b), tmdbtest.APIKey)
+	assert.NotContains(t, string(b), tmdbtest.SessionID)
+	assert.Len(t, recorder.Interactions(), 3)
+
+	// The server is gone and the credentials differ, the
+	// requests are answered from the cassette.
+	server.Close()
+	replayer, err := New(path, Options{})
+	require.NoError(t, err)
+	replay, err := tmdb.Init("another-key")
+	require.NoError(t, err)
+	server.Configure(replay)
+	replay.SetClientConfig(http.Client{Transport: replayer})
+	movie, err := replay.GetMovieDetails(550, map[string]string{
+		"append_to_response": "credits",
+		"language":           "en-US",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "Fight Club", movie.Title)
+	_, err = replay.GetMovieDetails(1, nil)
+	assert.Contains(t, err.Error(), "code: 34")
+	_, err = replay.GetMovieDetails(603, nil)
+	assert.Contains(t, err.Error(), ErrNoInteraction.Error())
+}
+
+func TestReplayNoInteraction(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "empty.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"interactions":[]}`), 0o644))
+	recorder, err := New(path, Options{})
+	require.NoError(t, err)
+	req, err := http.NewRequest(http.MethodGet, "https://api.themoviedb.org/3/movie/550", nil)
+	require.NoError(t, err)
+	_, err = recorder.RoundTrip(req)
+	assert.ErrorIs(t, err, ErrNoInteraction)
+
+	_, err = New(filepath.Join(t.TempDir(), "missing.json"), Options{})
+	assert.Error(t, err)
+}
+
+func TestRecordNothing(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "cassettes", "nothing.json")
+	recorder, err := New(path, Options{Mode: Record})
+	require.NoError(t, err)
+	require.NoError(t, recorder.Save())
+	assert.NoFileExists(t, path)
+}
+
+func TestCanonicalQuery(t *testing.T) {
+	recorder := &Recorder{scrub: DefaultScrubParams}
+	a, err := http.NewRequest(http.MethodGet, "https://example.com/3/search/movie?query=fight&api_key=a&page=1", nil)
+	require.NoError(t, err)
+	b, err := http.NewRequest(http.MethodGet, "https://example.com/3/search/movie?page=1&query=fight&api_key=b", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "page=1&query=fight", recorder.canonicalQuery(a.URL))
+	assert.Equal(t, recorder.key(a.Method, a.URL), recorder.key(b.Method, b.URL))
+}
+
+func TestScrubBody(t *testing.T) {
+	recorder := &Recorder{scrub: append(DefaultScrubParams, "request_token")}
+	body := recorder.scrubBody([]byte(`{"access_token":"secret","account_id":"abc","nested":[{"request_token":"rt"}]}`))
+	assert.False(t, strings.Contains(body, "secret"))
+	assert.False(t, strings.Contains(body, `"rt"`))
+	assert.Contains(t, body, `"account_id":"abc"`)
+	assert.Equal(t, "not json", recorder.scrubBody([]byte("not json")))
+}
@@ -0,0 +1,375 @@
+// Package tmdbtest provides an in-process fake of the TMDb v3 API
+// for tests that must run offline.
+//
+// The fake serves the movie, TV, season, episode, person, search,
+// discover, account and list routes from fixtures, validates the
+// api key, bearer token and session, and can inject failures:
+//
+//	server := tmdbtest.NewServer()
+//	defer server.Close()
+//
+//	client, err := server.NewClient()
+//	movie, err := client.GetMovieDetails(550, nil)
+//
+//	server.Inject(tmdbtest.RateLimited(time.Second))
+package tmdbtest
+
+import (
+	"embed"
+	"io/fs"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	tmdb "github.com/cyruzin/golang-tmdb"
+	json "github.com/goccy/go-json"
+)
+
+// Credentials accepted by the fake server.
+const (
+	APIKey         = "tmdbtest-api-key"
+	BearerToken    = "tmdbtest-bearer-token"
+	SessionID      = "tmdbtest-session-id"
+	GuestSessionID = "tmdbtest-guest-session-id"
+	AccountID      = 1
+	ListID         = 1
+)
+
+// fixtures are the seeded responses, one file per
+// route, like fixtures/movie/550.json for /movie/550.
+//
+//go:embed fixtures
+var fixtures embed.FS
+
+// Request type is a request received by the fake server.
+type Request struct {
+	Method string
+	Path   string
+	Query  url.Values
+	Header http.Header
+}
+
+// Server type is a fake TMDb API server.
+//
+// Routes are the v3 paths, without the "/3" prefix, like
+// "/movie/550". GET requests are served from the fixtures,
+// write requests (ratings, favorites, watchlist and lists)
+// answer like TMDb without changing them.
+type Server struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	fixtures map[string][]byte
+	faults   []*Fault
+	requests []Request
+}
+
+// NewServer starts a fake server seeded with the default fixtures.
+// The caller must Close it when done.
+func NewServer() *Server {
+	s := &Server{fixtures: map[string][]byte{}}
+	err := fs.WalkDir(fixtures, "fixtures", func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		b, err := fixtures.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		route := strings.TrimSuffix(strings.TrimPrefix(path, "fixtures"), ".json")
+		s.fixtures[route] = b
+		return nil
+	})
+	if err != nil {
+		panic("tmdbtest: could not load the fixtures: " + err.Error())
+	}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// NewClient creates a client authenticated with
+// APIKey, pointing to the fake server.
+func (s *Server) NewClient() (*tmdb.Client, error) {
+	client, err := tmdb.Init(APIKey)
+	if err != nil {
+		return nil, err
+	}
+	s.Configure(client)
+	return client, nil
+}
+
+// Configure points client to the fake server.
+func (s *Server) Configure(client *tmdb.Client) {
+	client.SetCustomBaseURL(s.URL + "/3")
+}
+
+// Seed sets the JSON body served for GET requests to
+// path, like "/movie/603", replacing any fixture.
+func (s *Server) Seed(path string, body []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.fixtures["/"+strings.Trim(path, "/")] = body
+}
+
+// Unseed removes the fixture of path, which is then not found.
+func (s *Server) Unseed(path string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.fixtures, "/"+strings.Trim(path, "/"))
+}
+
+// Requests returns the requests received so far.
+func (s *Server) Requests() []Request {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]Request(nil), s.requests...)
+}
+
+// Fault type is a failure injected in the responses
+// of the fake server.
+type Fault struct {
+	// Path restricts the fault to a route and the routes
+	// below it, like "/movie/550" for /movie/550 and
+	// /movie/550/credits. Empty means every route.
+	Path string
+	// Status is the http status of the response.
+	Status int
+	// StatusCode and StatusMessage are the TMDb error.
+	StatusCode    int
+	StatusMessage string
+	// RetryAfter sets the Retry-After header, in seconds.
+	RetryAfter time.Duration
+	// Times is the number of responses to fail, zero
+	// means every response until ClearFaults.
+	Times int
+}
+
+// newFault returns a fault answering with status and the
+// documented message of code.
+func newFault(status int, code tmdb.StatusCode) Fault {
+	return Fault{
+		Status:        status,
+		StatusCode:    int(code),
+		StatusMessage: code.Description(),
+	}
+}
+
+// Unauthorized is a fault answering like an invalid api key.
+func Unauthorized() Fault {
+	return newFault(http.StatusUnauthorized, tmdb.StatusInvalidAPIKey)
+}
+
+// NotFound is a fault answering like an unknown resource.
+func NotFound() Fault {
+	return newFault(http.StatusNotFound, tmdb.StatusResourceNotFound)
+}
+
+// RateLimited is a fault answering like a request over the rate
+// limit, asking to retry after the given duration when positive.
+func RateLimited(retryAfter time.Duration) Fault {
+	fault := newFault(http.StatusTooManyRequests, tmdb.StatusRequestLimitExceeded)
+	fault.RetryAfter = retryAfter
+	return fault
+}
+
+// ServerError is a fault answering with a 5xx status.
+func ServerError(status int) Fault {
+	switch status {
+	case http.StatusServiceUnavailable:
+		return newFault(status, tmdb.StatusServiceOffline)
+	case http.StatusGatewayTimeout:
+		return newFault(status, tmdb.StatusBackendTimeout)
+	case http.StatusBadGateway:
+		return newFault(status, tmdb.StatusBackendUnreachable)
+	default:
+		return newFault(status, tmdb.StatusInternalError)
+	}
+}
+
+// Inject adds a fault, checked before the
+// credentials and the routes, in insertion order.
+func (s *Server) Inject(fault Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = append(s.faults, &fault)
+}
+
+// ClearFaults removes the injected faults.
+func (s *Server) ClearFaults() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.faults = nil
+}
+
+// Errors answered by the fake server.
+var (
+	errInvalidAPIKey        = Unauthorized()
+	errAuthenticationFailed = newFault(http.StatusUnauthorized, tmdb.StatusAuthenticationFailed)
+	errNotFound             = NotFound()
+	errInvalidMethod        = newFault(http.StatusMethodNotAllowed, tmdb.StatusMethodNotSupported)
+)
+
+// routes are the top-level v3 routes served by the fake server.
+var routes = map[string]bool{
+	"movie":    true,
+	"tv":       true,
+	"person":   true,
+	"search":   true,
+	"discover": true,
+	"account":  true,
+	"list":     true,
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	path, ok := strings.CutPrefix(r.URL.Path, "/3")
+	if !ok {
+		writeFault(w, errNotFound)
+		return
+	}
+	query := r.URL.Query()
+	s.mu.Lock()
+	s.requests = append(s.requests, Request{
+		Method: r.Method,
+		Path:   path,
+		Query:  query,
+		Header: r.Header.Clone(),
+	})
+	fault, faulted := s.fault(path)
+	body, seeded := s.fixtures[path]
+	s.mu.Unlock()
+
+	if faulted {
+		writeFault(w, fault)
+		return
+	}
+	if query.Get("api_key") != APIKey &&
+		r.Header.Get("Authorization") != "Bearer "+BearerToken {
+		writeFault(w, errInvalidAPIKey)
+		return
+	}
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+	if !routes[segments[0]] {
+		writeFault(w, errNotFound)
+		return
+	}
+	if needsSession(r.Method, segments) && !validSession(query, segments) {
+		writeFault(w, errAuthenticationFailed)
+		return
+	}
+	switch r.Method {
+	case http.MethodGet:
+		if !seeded {
+			writeFault(w, errNotFound)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json;charset=utf-8")
+		w.Write(body)
+	case http.MethodPost, http.MethodDelete:
+		s.write(w, r.Method, segments)
+	default:
+		writeFault(w, errInvalidMethod)
+	}
+}
+
+// fault returns the first injected fault matching path,
+// consuming one of its times. It must be called with s.mu held.
+func (s *Server) fault(path string) (Fault, bool) {
+	for i, fault := range s.faults {
+		if path != fault.Path &&
+			!strings.HasPrefix(path, strings.TrimSuffix(fault.Path, "/")+"/") {
+			continue
+		}
+		if fault.Times > 0 {
+			fault.Times--
+			if fault.Times == 0 {
+				s.faults = append(s.faults[:i:i], s.faults[i+1:]...)
+			}
+		}
+		return *fault, true
+	}
+	return Fault{}, false
+}
+
+// needsSession reports whether a route needs a user session.
+func needsSession(method string, segments []string) bool {
+	last := segments[len(segments)-1]
+	return segments[0] == "account" ||
+		last == "account_states" ||
+		last == "rating" ||
+		(segments[0] == "list" && method != http.MethodGet)
+}
+
+// validSession reports whether the request carries a valid
+// session. Guest sessions can only rate and read ratings.
+func validSession(query url.Values, segments []string) bool {
+	if query.Get("session_id") == SessionID {
+		return true
+	}
+	last := segments[len(segments)-1]
+	return query.Get("guest_session_id") == GuestSessionID &&
+		(last == "rating" || last == "account_states")
+}
+
+// write answers the write routes like TMDb does.
+func (s *Server) write(w http.ResponseWriter, method string, segments []string) {
+	last := segments[len(segments)-1]
+	switch {
+	case last == "rating" && method == http.MethodPost,
+		segments[0] == "account" && (last == "favorite" || last == "watchlist"):
+		writeJSON(w, http.StatusCreated, map[string]any{
+			"success":        true,
+			"status_code":    int(tmdb.StatusSuccess),
+			"status_message": tmdb.StatusSuccess.Description(),
+		})
+	case last == "rating" && method == http.MethodDelete:
+		writeJSON(w, http.StatusOK, map[string]any{
+			"success":        true,
+			"status_code":    int(tmdb.StatusDeleted),
+			"status_message": tmdb.StatusDeleted.Description(),
+		})
+	case segments[0] == "list" && len(segments) == 1 && method == http.MethodPost:
+		writeJSON(w, http.StatusCreated, map[string]any{
+			"success":        true,
+			"status_code":    int(tmdb.StatusSuccess),
+			"status_message": "The item/record was created successfully.",
+			"list_id":        ListID,
+		})
+	case segments[0] == "list" && len(segments) == 3 && method == http.MethodPost:
+		writeJSON(w, http.StatusCreated, map[string]any{
+			"success":        true,
+			"status_code":    int(tmdb.StatusUpdated),
+			"status_message": tmdb.StatusUpdated.Description(),
+		})
+	case segments[0] == "list" && len(segments) == 2 && method == http.MethodDelete:
+		writeJSON(w, http.StatusOK, map[string]any{
+			"success":        true,
+			"status_code":    int(tmdb.StatusDeleted),
+			"status_message": tmdb.StatusDeleted.Description(),
+		})
+	default:
+		writeFault(w, errInvalidMethod)
+	}
+}
+
+func writeFault(w http.ResponseWriter, fault Fault) {
+	if fault.RetryAfter > 0 {
+		seconds := int64((fault.RetryAfter + time.Second - 1) / time.Second)
+		w.Header().Set("Retry-After", strconv.FormatInt(seconds, 10))
+	}
+	writeJSON(w, fault.Status, map[string]any{
+		"success":        false,
+		"status_code":    fault.StatusCode,
+		"status_message": fault.StatusMessage,
+	})
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	b, _ := json.Marshal(v)
+	w.Header().Set("Content-Type", "application/json;charset=utf-8")
+	w.WriteHeader(status)
+	w.Write(b)
+}
@@ -0,0 +1,153 @@
+package tmdbtest
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	tmdb "github.com/cyruzin/golang-tmdb"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newClient(t *testing.T) (*Server, *tmdb.Client) {
+	t.Helper()
+	server := NewServer()
+	t.Cleanup(server.Close)
+	client, err := server.NewClient()
+	require.NoError(t, err)
+	return server, client
+}
+
+func TestFixtures(t *testing.T) {
+	_, client := newClient(t)
+	movie, err := client.GetMovieDetails(550, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "Fight Club", movie.Title)
+
+	episode, err := client.GetTVEpisodeDetails(1399, 1, 1, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "Winter Is Coming", episode.Name)
+
+	ids, err := client.GetTVExternalIDs(1399, nil)
+	require.NoError(t, err)
+	assert.Equal(t, int64(121361), ids.TVDBID)
+
+	person, err := client.GetPersonDetails(287, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "Brad Pitt", person.Name)
+
+	search, err := client.GetSearchMulti("fight", nil)
+	require.NoError(t, err)
+	assert.Len(t, search.Results, 3)
+
+	discover, err := client.GetDiscoverMovie(nil)
+	require.NoError(t, err)
+	assert.Equal(t, int64(550), discover.Results[0].ID)
+
+	list, err := client.GetListDetails(ListID, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "Favorites", list.Name)
+}
+
+func TestNotFound(t *testing.T) {
+	_, client := newClient(t)
+	_, err := client.GetMovieDetails(1, nil)
+	assert.Contains(t, err.Error(), "code: 34")
+}
+
+func TestSeed(t *testing.T) {
+	server, client := newClient(t)
+	server.Seed("/movie/603", []byte(`{"id":603,"title":"The Matrix"}`))
+	movie, err := client.GetMovieDetails(603, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "The Matrix", movie.Title)
+	server.Unseed("/movie/603")
+	_, err = client.GetMovieDetails(603, nil)
+	assert.Error(t, err)
+}
+
+func TestCredentials(t *testing.T) {
+	server, client := newClient(t)
+	_, err := client.GetAccountDetails()
+	assert.Contains(t, err.Error(), "code: 3")
+	require.NoError(t, client.SetSessionID(SessionID))
+	account, err := client.GetAccountDetails()
+	require.NoError(t, err)
+	assert.Equal(t, int64(AccountID), account.ID)
+
+	invalid, err := tmdb.Init("invalid")
+	require.NoError(t, err)
+	server.Configure(invalid)
+	_, err = invalid.GetMovieDetails(550, nil)
+	assert.Contains(t, err.Error(), "code: 7")
+
+	bearer, err := tmdb.InitV4(BearerToken)
+	require.NoError(t, err)
+	server.Configure(bearer)
+	_, err = bearer.GetMovieDetails(550, nil)
+	assert.NoError(t, err)
+}
+
+func TestGuestSession(t *testing.T) {
+	_, client := newClient(t)
+	guest := map[string]string{"guest_session_id": GuestSessionID}
+	rate, err := client.PostMovieRating(550, 8, guest)
+	require.NoError(t, err)
+	assert.Equal(t, 1, rate.StatusCode)
+	_, err = client.GetFavoriteMovies(AccountID, guest)
+	assert.Contains(t, err.Error(), "code: 3")
+}
+
+func TestInjectRateLimited(t *testing.T) {
+	server, client := newClient(t)
+	fault := RateLimited(time.Second)
+	fault.Times = 1
+	server.Inject(fault)
+	client.SetRetryPolicy(tmdb.RetryPolicy{
+		MaxAttempts:       2,
+		RetryableStatuses: []int{http.StatusTooManyRequests},
+	})
+	start := time.Now()
+	_, err := client.GetMovieDetails(550, nil)
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, time.Since(start), time.Second)
+	assert.Len(t, server.Requests(), 2)
+}
+
+func TestInjectPath(t *testing.T) {
+	server, client := newClient(t)
+	server.Seed("/movie/5500", []byte(`{"id":5500,"title":"Sibling"}`))
+	fault := ServerError(http.StatusServiceUnavailable)
+	fault.Path = "/movie/550"
+	server.Inject(fault)
+	_, err := client.GetMovieDetails(550, nil)
+	assert.Contains(t, err.Error(), "code: 9")
+	_, err = client.GetMovieCredits(550, nil)
+	assert.Contains(t, err.Error(), "code: 9")
+	movie, err := client.GetMovieDetails(5500, nil)
+	require.NoError(t, err)
+	assert.Equal(t, "Sibling", movie.Title)
+}
+
+func TestDeleteList(t *testing.T) {
+	_, client := newClient(t)
+	require.NoError(t, client.SetSessionID(SessionID))
+	res, err := client.DeleteList(ListID)
+	require.NoError(t, err)
+	assert.Equal(t, 13, res.StatusCode)
+}
+
+func TestInjectServerError(t *testing.T) {
+	server, client := newClient(t)
+	fault := ServerError(http.StatusServiceUnavailable)
+	fault.Path = "/tv/"
+	server.Inject(fault)
+	_, err := client.GetTVExternalIDs(1399, nil)
+	assert.Contains(t, err.Error(), "code: 9")
+	_, err = client.GetMovieDetails(550, nil)
+	assert.NoError(t, err)
+	server.ClearFaults()
+	_, err = client.GetTVExternalIDs(1399, nil)
+	assert.NoError(t, err)
+}
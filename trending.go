@@ -1,6 +1,7 @@
 package tmdb
 
 import (
+	"context"
 	"fmt"
 )
 
@@ -39,18 +40,34 @@ func (c *Client) GetTrending(
 	mediaType string,
 	timeWindow string,
 	urlOptions map[string]string,
+) (*Trending, error) {
+	return c.GetTrendingWithContext(
+		context.Background(),
+		mediaType,
+		timeWindow,
+		urlOptions,
+	)
+}
+
+// GetTrendingWithContext is like GetTrending but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetTrendingWithContext(
+	ctx context.Context,
+	mediaType string,
+	timeWindow string,
+	urlOptions map[string]string,
 ) (*Trending, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s/trending/%s/%s?api_key=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		mediaType,
 		timeWindow,
 		c.apiKey,
 		options,
 	)
 	trending := Trending{}
-	if err := c.get(tmdbURL, &trending); err != nil {
+	if err := c.get(ctx, "GetTrending", tmdbURL, &trending); err != nil {
 		return nil, err
 	}
 	return &trending, nil
@@ -1,10 +1,9 @@
 package tmdb
 
 import (
+	"context"
 	"fmt"
 	"net/http"
-
-	json "github.com/goccy/go-json"
 )
 
 // TVDetails type is a struct for details JSON response.
@@ -160,18 +159,28 @@ type TVWatchProvidersAppend struct {
 func (c *Client) GetTVDetails(
 	id int,
 	urlOptions map[string]string,
+) (*TVDetails, error) {
+	return c.GetTVDetailsWithContext(context.Background(), id, urlOptions)
+}
+
+// GetTVDetailsWithContext is like GetTVDetails but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetTVDetailsWithContext(
+	ctx context.Context,
+	id int,
+	urlOptions map[string]string,
 ) (*TVDetails, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%s%d?api_key=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		tvURL,
 		id,
 		c.apiKey,
 		options,
 	)
 	tvDetails := TVDetails{}
-	if err := c.get(tmdbURL, &tvDetails); err != nil {
+	if err := c.get(ctx, "GetTVDetails", tmdbURL, &tvDetails); err != nil {
 		return nil, err
 	}
 	return &tvDetails, nil
@@ -179,10 +188,10 @@ func (c *Client) GetTVDetails(
 
 // TVAccountStates type is a struct for account states JSON response.
 type TVAccountStates struct {
-	ID        int64           `json:"id"`
-	Favorite  bool            `json:"favorite"`
-	Rated     json.RawMessage `json:"rated"`
-	Watchlist bool            `json:"watchlist"`
+	ID        int64      `json:"id"`
+	Favorite  bool       `json:"favorite"`
+	Rated     RatedValue `json:"rated"`
+	Watchlist bool       `json:"watchlist"`
 }
 
 // GetTVAccountStates grab the following account states for a session:
@@ -197,18 +206,28 @@ type TVAccountStates struct {
 func (c *Client) GetTVAccountStates(
 	id int,
 	urlOptions map[string]string,
+) (*TVAccountStates, error) {
+	return c.GetTVAccountStatesWithContext(context.Background(), id, urlOptions)
+}
+
+// GetTVAccountStatesWithContext is like GetTVAccountStates but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetTVAccountStatesWithContext(
+	ctx context.Context,
+	id int,
+	urlOptions map[string]string,
 ) (*TVAccountStates, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%s%d/account_states?api_key=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		tvURL,
 		id,
 		c.apiKey,
 		options,
 	)
 	tvAccountStates := TVAccountStates{}
-	if err := c.get(tmdbURL, &tvAccountStates); err != nil {
+	if err := c.get(ctx, "GetTVAccountStates", tmdbURL, &tvAccountStates); err != nil {
 		return nil, err
 	}
 	return &tvAccountStates, nil
@@ -259,18 +278,28 @@ type TVAggregateCredits struct {
 func (c *Client) GetTVAggregateCredits(
 	id int,
 	urlOptions map[string]string,
+) (*TVAggregateCredits, error) {
+	return c.GetTVAggregateCreditsWithContext(context.Background(), id, urlOptions)
+}
+
+// GetTVAggregateCreditsWithContext is like GetTVAggregateCredits but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetTVAggregateCreditsWithContext(
+	ctx context.Context,
+	id int,
+	urlOptions map[string]string,
 ) (*TVAggregateCredits, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%s%d/aggregate_credits?api_key=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		tvURL,
 		id,
 		c.apiKey,
 		options,
 	)
 	tvAggregateCredits := TVAggregateCredits{}
-	if err := c.get(tmdbURL, &tvAggregateCredits); err != nil {
+	if err := c.get(ctx, "GetTVAggregateCredits", tmdbURL, &tvAggregateCredits); err != nil {
 		return nil, err
 	}
 	return &tvAggregateCredits, nil
@@ -288,18 +317,32 @@ type TVAlternativeTitles struct {
 func (c *Client) GetTVAlternativeTitles(
 	id int,
 	urlOptions map[string]string,
+) (*TVAlternativeTitles, error) {
+	return c.GetTVAlternativeTitlesWithContext(
+		context.Background(),
+		id,
+		urlOptions,
+	)
+}
+
+// GetTVAlternativeTitlesWithContext is like GetTVAlternativeTitles but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetTVAlternativeTitlesWithContext(
+	ctx context.Context,
+	id int,
+	urlOptions map[string]string,
 ) (*TVAlternativeTitles, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%s%d/alternative_titles?api_key=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		tvURL,
 		id,
 		c.apiKey,
 		options,
 	)
 	tvAlternativeTitles := TVAlternativeTitles{}
-	if err := c.get(tmdbURL, &tvAlternativeTitles); err != nil {
+	if err := c.get(ctx, "GetTVAlternativeTitles", tmdbURL, &tvAlternativeTitles); err != nil {
 		return nil, err
 	}
 	return &tvAlternativeTitles, nil
@@ -307,18 +350,7 @@ func (c *Client) GetTVAlternativeTitles(
 
 // TVChanges type is a struct for changes JSON response.
 type TVChanges struct {
-	Changes []struct {
-		Key   string `json:"key"`
-		Items []struct {
-			ID            string          `json:"id"`
-			Action        string          `json:"action"`
-			Time          string          `json:"time"`
-			Iso639_1      string          `json:"iso_639_1"`
-			Iso3166_1     string          `json:"iso_3166_1"`
-			Value         json.RawMessage `json:"value"`
-			OriginalValue json.RawMessage `json:"original_value"`
-		} `json:"items"`
-	} `json:"changes"`
+	Changes []Change `json:"changes"`
 }
 
 // GetTVChanges get the changes for a TV show.
@@ -337,18 +369,28 @@ type TVChanges struct {
 func (c *Client) GetTVChanges(
 	id int,
 	urlOptions map[string]string,
+) (*TVChanges, error) {
+	return c.GetTVChangesWithContext(context.Background(), id, urlOptions)
+}
+
+// GetTVChangesWithContext is like GetTVChanges but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetTVChangesWithContext(
+	ctx context.Context,
+	id int,
+	urlOptions map[string]string,
 ) (*TVChanges, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%s%d/changes?api_key=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		tvURL,
 		id,
 		c.apiKey,
 		options,
 	)
 	tVChanges := TVChanges{}
-	if err := c.get(tmdbURL, &tVChanges); err != nil {
+	if err := c.get(ctx, "GetTVChanges", tmdbURL, &tVChanges); err != nil {
 		return nil, err
 	}
 	return &tVChanges, nil
@@ -366,18 +408,28 @@ type TVContentRatings struct {
 func (c *Client) GetTVContentRatings(
 	id int,
 	urlOptions map[string]string,
+) (*TVContentRatings, error) {
+	return c.GetTVContentRatingsWithContext(context.Background(), id, urlOptions)
+}
+
+// GetTVContentRatingsWithContext is like GetTVContentRatings but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetTVContentRatingsWithContext(
+	ctx context.Context,
+	id int,
+	urlOptions map[string]string,
 ) (*TVContentRatings, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%s%d/content_ratings?api_key=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		tvURL,
 		id,
 		c.apiKey,
 		options,
 	)
 	tvContentRatings := TVContentRatings{}
-	if err := c.get(tmdbURL, &tvContentRatings); err != nil {
+	if err := c.get(ctx, "GetTVContentRatings", tmdbURL, &tvContentRatings); err != nil {
 		return nil, err
 	}
 	return &tvContentRatings, nil
@@ -418,18 +470,28 @@ type TVCredits struct {
 func (c *Client) GetTVCredits(
 	id int,
 	urlOptions map[string]string,
+) (*TVCredits, error) {
+	return c.GetTVCreditsWithContext(context.Background(), id, urlOptions)
+}
+
+// GetTVCreditsWithContext is like GetTVCredits but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetTVCreditsWithContext(
+	ctx context.Context,
+	id int,
+	urlOptions map[string]string,
 ) (*TVCredits, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%s%d/credits?api_key=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		tvURL,
 		id,
 		c.apiKey,
 		options,
 	)
 	tvCredits := TVCredits{}
-	if err := c.get(tmdbURL, &tvCredits); err != nil {
+	if err := c.get(ctx, "GetTVCredits", tmdbURL, &tvCredits); err != nil {
 		return nil, err
 	}
 	return &tvCredits, nil
@@ -449,18 +511,28 @@ type TVEpisodeGroups struct {
 func (c *Client) GetTVEpisodeGroups(
 	id int,
 	urlOptions map[string]string,
+) (*TVEpisodeGroups, error) {
+	return c.GetTVEpisodeGroupsWithContext(context.Background(), id, urlOptions)
+}
+
+// GetTVEpisodeGroupsWithContext is like GetTVEpisodeGroups but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetTVEpisodeGroupsWithContext(
+	ctx context.Context,
+	id int,
+	urlOptions map[string]string,
 ) (*TVEpisodeGroups, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%s%d/episode_groups?api_key=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		tvURL,
 		id,
 		c.apiKey,
 		options,
 	)
 	tVEpisodeGroups := TVEpisodeGroups{}
-	if err := c.get(tmdbURL, &tVEpisodeGroups); err != nil {
+	if err := c.get(ctx, "GetTVEpisodeGroups", tmdbURL, &tVEpisodeGroups); err != nil {
 		return nil, err
 	}
 	return &tVEpisodeGroups, nil
@@ -494,18 +566,28 @@ type TVExternalIDs struct {
 func (c *Client) GetTVExternalIDs(
 	id int,
 	urlOptions map[string]string,
+) (*TVExternalIDs, error) {
+	return c.GetTVExternalIDsWithContext(context.Background(), id, urlOptions)
+}
+
+// GetTVExternalIDsWithContext is like GetTVExternalIDs but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetTVExternalIDsWithContext(
+	ctx context.Context,
+	id int,
+	urlOptions map[string]string,
 ) (*TVExternalIDs, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%s%d/external_ids?api_key=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		tvURL,
 		id,
 		c.apiKey,
 		options,
 	)
 	tvExternalIDs := TVExternalIDs{}
-	if err := c.get(tmdbURL, &tvExternalIDs); err != nil {
+	if err := c.get(ctx, "GetTVExternalIDs", tmdbURL, &tvExternalIDs); err != nil {
 		return nil, err
 	}
 	return &tvExternalIDs, nil
@@ -536,18 +618,28 @@ type TVImages struct {
 func (c *Client) GetTVImages(
 	id int,
 	urlOptions map[string]string,
+) (*TVImages, error) {
+	return c.GetTVImagesWithContext(context.Background(), id, urlOptions)
+}
+
+// GetTVImagesWithContext is like GetTVImages but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetTVImagesWithContext(
+	ctx context.Context,
+	id int,
+	urlOptions map[string]string,
 ) (*TVImages, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%s%d/images?api_key=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		tvURL,
 		id,
 		c.apiKey,
 		options,
 	)
 	tvImages := TVImages{}
-	if err := c.get(tmdbURL, &tvImages); err != nil {
+	if err := c.get(ctx, "GetTVImages", tmdbURL, &tvImages); err != nil {
 		return nil, err
 	}
 	return &tvImages, nil
@@ -564,16 +656,25 @@ type TVKeywords struct {
 // https://developers.themoviedb.org/3/tv/get-tv-keywords
 func (c *Client) GetTVKeywords(
 	id int,
+) (*TVKeywords, error) {
+	return c.GetTVKeywordsWithContext(context.Background(), id)
+}
+
+// GetTVKeywordsWithContext is like GetTVKeywords but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetTVKeywordsWithContext(
+	ctx context.Context,
+	id int,
 ) (*TVKeywords, error) {
 	tmdbURL := fmt.Sprintf(
 		"%s%s%d/keywords?api_key=%s",
-		baseURL,
+		c.GetBaseURL(),
 		tvURL,
 		id,
 		c.apiKey,
 	)
 	tvKeywords := TVKeywords{}
-	if err := c.get(tmdbURL, &tvKeywords); err != nil {
+	if err := c.get(ctx, "GetTVKeywords", tmdbURL, &tvKeywords); err != nil {
 		return nil, err
 	}
 	return &tvKeywords, nil
@@ -591,18 +692,28 @@ type TVRecommendations struct {
 func (c *Client) GetTVRecommendations(
 	id int,
 	urlOptions map[string]string,
+) (*TVRecommendations, error) {
+	return c.GetTVRecommendationsWithContext(context.Background(), id, urlOptions)
+}
+
+// GetTVRecommendationsWithContext is like GetTVRecommendations but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetTVRecommendationsWithContext(
+	ctx context.Context,
+	id int,
+	urlOptions map[string]string,
 ) (*TVRecommendations, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%s%d/recommendations?api_key=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		tvURL,
 		id,
 		c.apiKey,
 		options,
 	)
 	tvRecommendations := TVRecommendations{}
-	if err := c.get(tmdbURL, &tvRecommendations); err != nil {
+	if err := c.get(ctx, "GetTVRecommendations", tmdbURL, &tvRecommendations); err != nil {
 		return nil, err
 	}
 	return &tvRecommendations, nil
@@ -621,18 +732,28 @@ type TVReviews struct {
 func (c *Client) GetTVReviews(
 	id int,
 	urlOptions map[string]string,
+) (*TVReviews, error) {
+	return c.GetTVReviewsWithContext(context.Background(), id, urlOptions)
+}
+
+// GetTVReviewsWithContext is like GetTVReviews but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetTVReviewsWithContext(
+	ctx context.Context,
+	id int,
+	urlOptions map[string]string,
 ) (*TVReviews, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%s%d/reviews?api_key=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		tvURL,
 		id,
 		c.apiKey,
 		options,
 	)
 	tvReviews := TVReviews{}
-	if err := c.get(tmdbURL, &tvReviews); err != nil {
+	if err := c.get(ctx, "GetTVReviews", tmdbURL, &tvReviews); err != nil {
 		return nil, err
 	}
 	return &tvReviews, nil
@@ -650,16 +771,25 @@ type TVScreenedTheatrically struct {
 // https://developers.themoviedb.org/3/tv/get-screened-theatrically
 func (c *Client) GetTVScreenedTheatrically(
 	id int,
+) (*TVScreenedTheatrically, error) {
+	return c.GetTVScreenedTheatricallyWithContext(context.Background(), id)
+}
+
+// GetTVScreenedTheatricallyWithContext is like GetTVScreenedTheatrically but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetTVScreenedTheatricallyWithContext(
+	ctx context.Context,
+	id int,
 ) (*TVScreenedTheatrically, error) {
 	tmdbURL := fmt.Sprintf(
 		"%s%s%d/screened_theatrically?api_key=%s",
-		baseURL,
+		c.GetBaseURL(),
 		tvURL,
 		id,
 		c.apiKey,
 	)
 	tvScreenedTheatrically := TVScreenedTheatrically{}
-	if err := c.get(tmdbURL, &tvScreenedTheatrically); err != nil {
+	if err := c.get(ctx, "GetTVScreenedTheatrically", tmdbURL, &tvScreenedTheatrically); err != nil {
 		return nil, err
 	}
 	return &tvScreenedTheatrically, nil
@@ -677,18 +807,28 @@ type TVSimilar struct {
 func (c *Client) GetTVSimilar(
 	id int,
 	urlOptions map[string]string,
+) (*TVSimilar, error) {
+	return c.GetTVSimilarWithContext(context.Background(), id, urlOptions)
+}
+
+// GetTVSimilarWithContext is like GetTVSimilar but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetTVSimilarWithContext(
+	ctx context.Context,
+	id int,
+	urlOptions map[string]string,
 ) (*TVSimilar, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%s%d/similar?api_key=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		tvURL,
 		id,
 		c.apiKey,
 		options,
 	)
 	tVSimilar := TVSimilar{}
-	if err := c.get(tmdbURL, &tVSimilar); err != nil {
+	if err := c.get(ctx, "GetTVSimilar", tmdbURL, &tVSimilar); err != nil {
 		return nil, err
 	}
 	return &tVSimilar, nil
@@ -700,18 +840,28 @@ func (c *Client) GetTVSimilar(
 func (c *Client) GetTVWatchProviders(
 	id int,
 	urlOptions map[string]string,
+) (*WatchProviderResults, error) {
+	return c.GetTVWatchProvidersWithContext(context.Background(), id, urlOptions)
+}
+
+// GetTVWatchProvidersWithContext is like GetTVWatchProviders but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetTVWatchProvidersWithContext(
+	ctx context.Context,
+	id int,
+	urlOptions map[string]string,
 ) (*WatchProviderResults, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%s%d/watch/providers?api_key=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		tvURL,
 		id,
 		c.apiKey,
 		options,
 	)
 	tvWatchProviders := WatchProviderResults{}
-	if err := c.get(tmdbURL, &tvWatchProviders); err != nil {
+	if err := c.get(ctx, "GetTVWatchProviders", tmdbURL, &tvWatchProviders); err != nil {
 		return nil, err
 	}
 	return &tvWatchProviders, nil
@@ -729,18 +879,28 @@ type TVTranslations struct {
 func (c *Client) GetTVTranslations(
 	id int,
 	urlOptions map[string]string,
+) (*TVTranslations, error) {
+	return c.GetTVTranslationsWithContext(context.Background(), id, urlOptions)
+}
+
+// GetTVTranslationsWithContext is like GetTVTranslations but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetTVTranslationsWithContext(
+	ctx context.Context,
+	id int,
+	urlOptions map[string]string,
 ) (*TVTranslations, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%s%d/translations?api_key=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		tvURL,
 		id,
 		c.apiKey,
 		options,
 	)
 	tvTranslations := TVTranslations{}
-	if err := c.get(tmdbURL, &tvTranslations); err != nil {
+	if err := c.get(ctx, "GetTVTranslations", tmdbURL, &tvTranslations); err != nil {
 		return nil, err
 	}
 	return &tvTranslations, nil
@@ -752,18 +912,28 @@ func (c *Client) GetTVTranslations(
 func (c *Client) GetTVVideos(
 	id int,
 	urlOptions map[string]string,
+) (*VideoResults, error) {
+	return c.GetTVVideosWithContext(context.Background(), id, urlOptions)
+}
+
+// GetTVVideosWithContext is like GetTVVideos but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetTVVideosWithContext(
+	ctx context.Context,
+	id int,
+	urlOptions map[string]string,
 ) (*VideoResults, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%s%d/videos?api_key=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		tvURL,
 		id,
 		c.apiKey,
 		options,
 	)
 	tvVideos := VideoResults{}
-	if err := c.get(tmdbURL, &tvVideos); err != nil {
+	if err := c.get(ctx, "GetTVVideos", tmdbURL, &tvVideos); err != nil {
 		return nil, err
 	}
 	return &tvVideos, nil
@@ -781,17 +951,26 @@ type TVLatest struct {
 // https://developers.themoviedb.org/3/tv/get-latest-tv
 func (c *Client) GetTVLatest(
 	urlOptions map[string]string,
+) (*TVLatest, error) {
+	return c.GetTVLatestWithContext(context.Background(), urlOptions)
+}
+
+// GetTVLatestWithContext is like GetTVLatest but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetTVLatestWithContext(
+	ctx context.Context,
+	urlOptions map[string]string,
 ) (*TVLatest, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%slatest?api_key=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		tvURL,
 		c.apiKey,
 		options,
 	)
 	tvLatest := TVLatest{}
-	if err := c.get(tmdbURL, &tvLatest); err != nil {
+	if err := c.get(ctx, "GetTVLatest", tmdbURL, &tvLatest); err != nil {
 		return nil, err
 	}
 	return &tvLatest, nil
@@ -814,17 +993,26 @@ type TVAiringToday struct {
 // https://developers.themoviedb.org/3/tv/get-tv-airing-today
 func (c *Client) GetTVAiringToday(
 	urlOptions map[string]string,
+) (*TVAiringToday, error) {
+	return c.GetTVAiringTodayWithContext(context.Background(), urlOptions)
+}
+
+// GetTVAiringTodayWithContext is like GetTVAiringToday but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetTVAiringTodayWithContext(
+	ctx context.Context,
+	urlOptions map[string]string,
 ) (*TVAiringToday, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%sairing_today?api_key=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		tvURL,
 		c.apiKey,
 		options,
 	)
 	tvAiringToday := TVAiringToday{}
-	if err := c.get(tmdbURL, &tvAiringToday); err != nil {
+	if err := c.get(ctx, "GetTVAiringToday", tmdbURL, &tvAiringToday); err != nil {
 		return nil, err
 	}
 	return &tvAiringToday, nil
@@ -843,17 +1031,26 @@ type TVOnTheAir struct {
 // https://developers.themoviedb.org/3/tv/get-tv-on-the-air
 func (c *Client) GetTVOnTheAir(
 	urlOptions map[string]string,
+) (*TVOnTheAir, error) {
+	return c.GetTVOnTheAirWithContext(context.Background(), urlOptions)
+}
+
+// GetTVOnTheAirWithContext is like GetTVOnTheAir but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetTVOnTheAirWithContext(
+	ctx context.Context,
+	urlOptions map[string]string,
 ) (*TVOnTheAir, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%son_the_air?api_key=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		tvURL,
 		c.apiKey,
 		options,
 	)
 	tvOnTheAir := TVOnTheAir{}
-	if err := c.get(tmdbURL, &tvOnTheAir); err != nil {
+	if err := c.get(ctx, "GetTVOnTheAir", tmdbURL, &tvOnTheAir); err != nil {
 		return nil, err
 	}
 	return &tvOnTheAir, nil
@@ -870,17 +1067,26 @@ type TVPopular struct {
 // https://developers.themoviedb.org/3/tv/get-popular-tv-shows
 func (c *Client) GetTVPopular(
 	urlOptions map[string]string,
+) (*TVPopular, error) {
+	return c.GetTVPopularWithContext(context.Background(), urlOptions)
+}
+
+// GetTVPopularWithContext is like GetTVPopular but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetTVPopularWithContext(
+	ctx context.Context,
+	urlOptions map[string]string,
 ) (*TVPopular, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%spopular?api_key=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		tvURL,
 		c.apiKey,
 		options,
 	)
 	tvPopular := TVPopular{}
-	if err := c.get(tmdbURL, &tvPopular); err != nil {
+	if err := c.get(ctx, "GetTVPopular", tmdbURL, &tvPopular); err != nil {
 		return nil, err
 	}
 	return &tvPopular, nil
@@ -896,17 +1102,26 @@ type TVTopRated struct {
 // https://developers.themoviedb.org/3/tv/get-top-rated-tv
 func (c *Client) GetTVTopRated(
 	urlOptions map[string]string,
+) (*TVTopRated, error) {
+	return c.GetTVTopRatedWithContext(context.Background(), urlOptions)
+}
+
+// GetTVTopRatedWithContext is like GetTVTopRated but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetTVTopRatedWithContext(
+	ctx context.Context,
+	urlOptions map[string]string,
 ) (*TVTopRated, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%stop_rated?api_key=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		tvURL,
 		c.apiKey,
 		options,
 	)
 	tvTopRated := TVTopRated{}
-	if err := c.get(tmdbURL, &tvTopRated); err != nil {
+	if err := c.get(ctx, "GetTVTopRated", tmdbURL, &tvTopRated); err != nil {
 		return nil, err
 	}
 	return &tvTopRated, nil
@@ -924,11 +1139,27 @@ func (c *Client) PostTVShowRating(
 	id int,
 	rating float32,
 	urlOptions map[string]string,
+) (*Response, error) {
+	return c.PostTVShowRatingWithContext(
+		context.Background(),
+		id,
+		rating,
+		urlOptions,
+	)
+}
+
+// PostTVShowRatingWithContext is like PostTVShowRating but uses the
+// given context for cancellation and deadlines.
+func (c *Client) PostTVShowRatingWithContext(
+	ctx context.Context,
+	id int,
+	rating float32,
+	urlOptions map[string]string,
 ) (*Response, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%s%d/rating?api_key=%s&session_id=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		tvURL,
 		id,
 		c.apiKey,
@@ -940,6 +1171,8 @@ func (c *Client) PostTVShowRating(
 	}{Value: rating}
 	tvShowRating := Response{}
 	if err := c.request(
+		ctx,
+		"PostTVShowRating",
 		tmdbURL,
 		body,
 		http.MethodPost,
@@ -961,11 +1194,21 @@ func (c *Client) PostTVShowRating(
 func (c *Client) DeleteTVShowRating(
 	id int,
 	urlOptions map[string]string,
+) (*Response, error) {
+	return c.DeleteTVShowRatingWithContext(context.Background(), id, urlOptions)
+}
+
+// DeleteTVShowRatingWithContext is like DeleteTVShowRating but uses the
+// given context for cancellation and deadlines.
+func (c *Client) DeleteTVShowRatingWithContext(
+	ctx context.Context,
+	id int,
+	urlOptions map[string]string,
 ) (*Response, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%s%d/rating?api_key=%s&session_id=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		tvURL,
 		id,
 		c.apiKey,
@@ -974,6 +1217,8 @@ func (c *Client) DeleteTVShowRating(
 	)
 	tvShowRating := Response{}
 	if err := c.request(
+		ctx,
+		"DeleteTVShowRating",
 		tmdbURL,
 		[]byte{},
 		http.MethodDelete,
@@ -1,6 +1,7 @@
 package tmdb
 
 import (
+	"context"
 	"fmt"
 
 	json "github.com/goccy/go-json"
@@ -56,18 +57,32 @@ type TVEpisodeGroupsDetails struct {
 func (c *Client) GetTVEpisodeGroupsDetails(
 	id string,
 	urlOptions map[string]string,
+) (*TVEpisodeGroupsDetails, error) {
+	return c.GetTVEpisodeGroupsDetailsWithContext(
+		context.Background(),
+		id,
+		urlOptions,
+	)
+}
+
+// GetTVEpisodeGroupsDetailsWithContext is like GetTVEpisodeGroupsDetails but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetTVEpisodeGroupsDetailsWithContext(
+	ctx context.Context,
+	id string,
+	urlOptions map[string]string,
 ) (*TVEpisodeGroupsDetails, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%sepisode_group/%s?api_key=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		tvURL,
 		id,
 		c.apiKey,
 		options,
 	)
 	tvEpisodeGroupDetails := TVEpisodeGroupsDetails{}
-	if err := c.get(tmdbURL, &tvEpisodeGroupDetails); err != nil {
+	if err := c.get(ctx, "GetTVEpisodeGroupsDetails", tmdbURL, &tvEpisodeGroupDetails); err != nil {
 		return nil, err
 	}
 	return &tvEpisodeGroupDetails, nil
@@ -1,9 +1,9 @@
 package tmdb
 
 import (
+	"context"
 	"fmt"
-
-	json "github.com/goccy/go-json"
+	"net/http"
 )
 
 // TVEpisodeDetails type is a struct for details JSON response.
@@ -83,11 +83,29 @@ func (c *Client) GetTVEpisodeDetails(
 	seasonNumber int,
 	episodeNumber int,
 	urlOptions map[string]string,
+) (*TVEpisodeDetails, error) {
+	return c.GetTVEpisodeDetailsWithContext(
+		context.Background(),
+		id,
+		seasonNumber,
+		episodeNumber,
+		urlOptions,
+	)
+}
+
+// GetTVEpisodeDetailsWithContext is like GetTVEpisodeDetails but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetTVEpisodeDetailsWithContext(
+	ctx context.Context,
+	id int,
+	seasonNumber int,
+	episodeNumber int,
+	urlOptions map[string]string,
 ) (*TVEpisodeDetails, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%s%d%s%d%s%d?api_key=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		tvURL,
 		id,
 		tvSeasonURL,
@@ -98,7 +116,7 @@ func (c *Client) GetTVEpisodeDetails(
 		options,
 	)
 	tvEpisodeDetails := TVEpisodeDetails{}
-	if err := c.get(tmdbURL, &tvEpisodeDetails); err != nil {
+	if err := c.get(ctx, "GetTVEpisodeDetails", tmdbURL, &tvEpisodeDetails); err != nil {
 		return nil, err
 	}
 	return &tvEpisodeDetails, nil
@@ -106,23 +124,7 @@ func (c *Client) GetTVEpisodeDetails(
 
 // TVEpisodeChanges type is a struct for changes JSON response.
 type TVEpisodeChanges struct {
-	Changes []struct {
-		Key   string `json:"key"`
-		Items []struct {
-			ID            string `json:"id"`
-			Action        string `json:"action"`
-			Time          string `json:"time"`
-			Iso639_1      string `json:"iso_639_1"`
-			Iso3166_1     string `json:"iso_3166_1"`
-			OriginalValue struct {
-				PersonID  int64  `json:"person_id"`
-				Character string `json:"character"`
-				Order     int64  `json:"order"`
-				CreditID  string `json:"credit_id"`
-			} `json:"original_values,omitempty"`
-			Value json.RawMessage `json:"value,omitempty"`
-		} `json:"items"`
-	} `json:"changes"`
+	Changes []Change `json:"changes"`
 }
 
 // GetTVEpisodeChanges get the changes for a TV episode.
@@ -135,18 +137,28 @@ type TVEpisodeChanges struct {
 func (c *Client) GetTVEpisodeChanges(
 	id int,
 	urlOptions map[string]string,
+) (*TVEpisodeChanges, error) {
+	return c.GetTVEpisodeChangesWithContext(context.Background(), id, urlOptions)
+}
+
+// GetTVEpisodeChangesWithContext is like GetTVEpisodeChanges but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetTVEpisodeChangesWithContext(
+	ctx context.Context,
+	id int,
+	urlOptions map[string]string,
 ) (*TVEpisodeChanges, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%sepisode/%d/changes?api_key=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		tvURL,
 		id,
 		c.apiKey,
 		options,
 	)
 	tvEpisodeChanges := TVEpisodeChanges{}
-	if err := c.get(tmdbURL, &tvEpisodeChanges); err != nil {
+	if err := c.get(ctx, "GetTVEpisodeChanges", tmdbURL, &tvEpisodeChanges); err != nil {
 		return nil, err
 	}
 	return &tvEpisodeChanges, nil
@@ -191,10 +203,26 @@ func (c *Client) GetTVEpisodeCredits(
 	id int,
 	seasonNumber int,
 	episodeNumber int,
+) (*TVEpisodeCredits, error) {
+	return c.GetTVEpisodeCreditsWithContext(
+		context.Background(),
+		id,
+		seasonNumber,
+		episodeNumber,
+	)
+}
+
+// GetTVEpisodeCreditsWithContext is like GetTVEpisodeCredits but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetTVEpisodeCreditsWithContext(
+	ctx context.Context,
+	id int,
+	seasonNumber int,
+	episodeNumber int,
 ) (*TVEpisodeCredits, error) {
 	tmdbURL := fmt.Sprintf(
 		"%s%s%d%s%d%s%d/credits?api_key=%s",
-		baseURL,
+		c.GetBaseURL(),
 		tvURL,
 		id,
 		tvSeasonURL,
@@ -204,7 +232,7 @@ func (c *Client) GetTVEpisodeCredits(
 		c.apiKey,
 	)
 	tvEpisodeCredits := TVEpisodeCredits{}
-	if err := c.get(tmdbURL, &tvEpisodeCredits); err != nil {
+	if err := c.get(ctx, "GetTVEpisodeCredits", tmdbURL, &tvEpisodeCredits); err != nil {
 		return nil, err
 	}
 	return &tvEpisodeCredits, nil
@@ -232,10 +260,26 @@ func (c *Client) GetTVEpisodeExternalIDs(
 	id int,
 	seasonNumber int,
 	episodeNumber int,
+) (*TVEpisodeExternalIDs, error) {
+	return c.GetTVEpisodeExternalIDsWithContext(
+		context.Background(),
+		id,
+		seasonNumber,
+		episodeNumber,
+	)
+}
+
+// GetTVEpisodeExternalIDsWithContext is like GetTVEpisodeExternalIDs but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetTVEpisodeExternalIDsWithContext(
+	ctx context.Context,
+	id int,
+	seasonNumber int,
+	episodeNumber int,
 ) (*TVEpisodeExternalIDs, error) {
 	tmdbURL := fmt.Sprintf(
 		"%s%s%d%s%d%s%d/external_ids?api_key=%s",
-		baseURL,
+		c.GetBaseURL(),
 		tvURL,
 		id,
 		tvSeasonURL,
@@ -245,7 +289,7 @@ func (c *Client) GetTVEpisodeExternalIDs(
 		c.apiKey,
 	)
 	tvEpisodeExternalIDs := TVEpisodeExternalIDs{}
-	if err := c.get(tmdbURL, &tvEpisodeExternalIDs); err != nil {
+	if err := c.get(ctx, "GetTVEpisodeExternalIDs", tmdbURL, &tvEpisodeExternalIDs); err != nil {
 		return nil, err
 	}
 	return &tvEpisodeExternalIDs, nil
@@ -275,10 +319,26 @@ func (c *Client) GetTVEpisodeImages(
 	id int,
 	seasonNumber int,
 	episodeNumber int,
+) (*TVEpisodeImages, error) {
+	return c.GetTVEpisodeImagesWithContext(
+		context.Background(),
+		id,
+		seasonNumber,
+		episodeNumber,
+	)
+}
+
+// GetTVEpisodeImagesWithContext is like GetTVEpisodeImages but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetTVEpisodeImagesWithContext(
+	ctx context.Context,
+	id int,
+	seasonNumber int,
+	episodeNumber int,
 ) (*TVEpisodeImages, error) {
 	tmdbURL := fmt.Sprintf(
 		"%s%s%d%s%d%s%d/images?api_key=%s",
-		baseURL,
+		c.GetBaseURL(),
 		tvURL,
 		id,
 		tvSeasonURL,
@@ -288,7 +348,7 @@ func (c *Client) GetTVEpisodeImages(
 		c.apiKey,
 	)
 	tvEpisodeImages := TVEpisodeImages{}
-	if err := c.get(tmdbURL, &tvEpisodeImages); err != nil {
+	if err := c.get(ctx, "GetTVEpisodeImages", tmdbURL, &tvEpisodeImages); err != nil {
 		return nil, err
 	}
 	return &tvEpisodeImages, nil
@@ -307,10 +367,26 @@ func (c *Client) GetTVEpisodeTranslations(
 	id int,
 	seasonNumber int,
 	episodeNumber int,
+) (*TVEpisodeTranslations, error) {
+	return c.GetTVEpisodeTranslationsWithContext(
+		context.Background(),
+		id,
+		seasonNumber,
+		episodeNumber,
+	)
+}
+
+// GetTVEpisodeTranslationsWithContext is like GetTVEpisodeTranslations but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetTVEpisodeTranslationsWithContext(
+	ctx context.Context,
+	id int,
+	seasonNumber int,
+	episodeNumber int,
 ) (*TVEpisodeTranslations, error) {
 	tmdbURL := fmt.Sprintf(
 		"%s%s%d%s%d%s%d/translations?api_key=%s",
-		baseURL,
+		c.GetBaseURL(),
 		tvURL,
 		id,
 		tvSeasonURL,
@@ -320,7 +396,7 @@ func (c *Client) GetTVEpisodeTranslations(
 		c.apiKey,
 	)
 	tvEpisodeTranslations := TVEpisodeTranslations{}
-	if err := c.get(tmdbURL, &tvEpisodeTranslations); err != nil {
+	if err := c.get(ctx, "GetTVEpisodeTranslations", tmdbURL, &tvEpisodeTranslations); err != nil {
 		return nil, err
 	}
 	return &tvEpisodeTranslations, nil
@@ -332,6 +408,192 @@ type TVEpisodeRate struct {
 	StatusMessage string `json:"status_message"`
 }
 
+// TVEpisodeAccountStates type is a struct for account states JSON response.
+type TVEpisodeAccountStates struct {
+	ID    int64      `json:"id"`
+	Rated RatedValue `json:"rated"`
+}
+
+// GetTVEpisodeAccountStates get your rating for an episode.
+//
+// A valid session or guest session ID is required.
+//
+// https://developers.themoviedb.org/3/tv-episodes/get-tv-episode-account-states
+func (c *Client) GetTVEpisodeAccountStates(
+	id int,
+	seasonNumber int,
+	episodeNumber int,
+	urlOptions map[string]string,
+) (*TVEpisodeAccountStates, error) {
+	return c.GetTVEpisodeAccountStatesWithContext(
+		context.Background(),
+		id,
+		seasonNumber,
+		episodeNumber,
+		urlOptions,
+	)
+}
+
+// GetTVEpisodeAccountStatesWithContext is like GetTVEpisodeAccountStates
+// but uses the given context for cancellation and deadlines.
+func (c *Client) GetTVEpisodeAccountStatesWithContext(
+	ctx context.Context,
+	id int,
+	seasonNumber int,
+	episodeNumber int,
+	urlOptions map[string]string,
+) (*TVEpisodeAccountStates, error) {
+	options := c.fmtOptions(urlOptions)
+	tmdbURL := fmt.Sprintf(
+		"%s%s%d%s%d%s%d/account_states?api_key=%s%s",
+		c.GetBaseURL(),
+		tvURL,
+		id,
+		tvSeasonURL,
+		seasonNumber,
+		tvEpisodeURL,
+		episodeNumber,
+		c.apiKey,
+		options,
+	)
+	tvEpisodeAccountStates := TVEpisodeAccountStates{}
+	if err := c.get(
+		ctx,
+		"GetTVEpisodeAccountStates",
+		tmdbURL,
+		&tvEpisodeAccountStates,
+	); err != nil {
+		return nil, err
+	}
+	return &tvEpisodeAccountStates, nil
+}
+
+// PostTVEpisodeRating rate a TV episode.
+//
+// A valid session or guest session ID is required.
+//
+// You can read more about how this works:
+// https://developers.themoviedb.org/3/authentication/how-do-i-generate-a-session-id
+//
+// https://developers.themoviedb.org/3/tv-episodes/rate-tv-episode
+func (c *Client) PostTVEpisodeRating(
+	id int,
+	seasonNumber int,
+	episodeNumber int,
+	rating float32,
+	urlOptions map[string]string,
+) (*TVEpisodeRate, error) {
+	return c.PostTVEpisodeRatingWithContext(
+		context.Background(),
+		id,
+		seasonNumber,
+		episodeNumber,
+		rating,
+		urlOptions,
+	)
+}
+
+// PostTVEpisodeRatingWithContext is like PostTVEpisodeRating but uses the
+// given context for cancellation and deadlines.
+func (c *Client) PostTVEpisodeRatingWithContext(
+	ctx context.Context,
+	id int,
+	seasonNumber int,
+	episodeNumber int,
+	rating float32,
+	urlOptions map[string]string,
+) (*TVEpisodeRate, error) {
+	options := c.fmtOptions(urlOptions)
+	tmdbURL := fmt.Sprintf(
+		"%s%s%d%s%d%s%d/rating?api_key=%s&session_id=%s%s",
+		c.GetBaseURL(),
+		tvURL,
+		id,
+		tvSeasonURL,
+		seasonNumber,
+		tvEpisodeURL,
+		episodeNumber,
+		c.apiKey,
+		c.sessionID,
+		options,
+	)
+	body := struct {
+		Value float32 `json:"value"`
+	}{Value: rating}
+	tvEpisodeRate := TVEpisodeRate{}
+	if err := c.request(
+		ctx,
+		"PostTVEpisodeRating",
+		tmdbURL,
+		body,
+		http.MethodPost,
+		&tvEpisodeRate,
+	); err != nil {
+		return nil, err
+	}
+	return &tvEpisodeRate, nil
+}
+
+// DeleteTVEpisodeRating remove your rating for a TV episode.
+//
+// A valid session or guest session ID is required.
+//
+// You can read more about how this works:
+// https://developers.themoviedb.org/3/authentication/how-do-i-generate-a-session-id
+//
+// https://developers.themoviedb.org/3/tv-episodes/delete-tv-episode-rating
+func (c *Client) DeleteTVEpisodeRating(
+	id int,
+	seasonNumber int,
+	episodeNumber int,
+	urlOptions map[string]string,
+) (*TVEpisodeRate, error) {
+	return c.DeleteTVEpisodeRatingWithContext(
+		context.Background(),
+		id,
+		seasonNumber,
+		episodeNumber,
+		urlOptions,
+	)
+}
+
+// DeleteTVEpisodeRatingWithContext is like DeleteTVEpisodeRating but uses
+// the given context for cancellation and deadlines.
+func (c *Client) DeleteTVEpisodeRatingWithContext(
+	ctx context.Context,
+	id int,
+	seasonNumber int,
+	episodeNumber int,
+	urlOptions map[string]string,
+) (*TVEpisodeRate, error) {
+	options := c.fmtOptions(urlOptions)
+	tmdbURL := fmt.Sprintf(
+		"%s%s%d%s%d%s%d/rating?api_key=%s&session_id=%s%s",
+		c.GetBaseURL(),
+		tvURL,
+		id,
+		tvSeasonURL,
+		seasonNumber,
+		tvEpisodeURL,
+		episodeNumber,
+		c.apiKey,
+		c.sessionID,
+		options,
+	)
+	tvEpisodeRate := TVEpisodeRate{}
+	if err := c.request(
+		ctx,
+		"DeleteTVEpisodeRating",
+		tmdbURL,
+		[]byte{},
+		http.MethodDelete,
+		&tvEpisodeRate,
+	); err != nil {
+		return nil, err
+	}
+	return &tvEpisodeRate, nil
+}
+
 // GetTVEpisodeVideos get the videos that have been added to a TV episode.
 //
 // https://developers.themoviedb.org/3/tv-episodes/get-tv-episode-videos
@@ -340,11 +602,29 @@ func (c *Client) GetTVEpisodeVideos(
 	seasonNumber int,
 	episodeNumber int,
 	urlOptions map[string]string,
+) (*VideoResults, error) {
+	return c.GetTVEpisodeVideosWithContext(
+		context.Background(),
+		id,
+		seasonNumber,
+		episodeNumber,
+		urlOptions,
+	)
+}
+
+// GetTVEpisodeVideosWithContext is like GetTVEpisodeVideos but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetTVEpisodeVideosWithContext(
+	ctx context.Context,
+	id int,
+	seasonNumber int,
+	episodeNumber int,
+	urlOptions map[string]string,
 ) (*VideoResults, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%s%d%s%d%s%d/videos?api_key=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		tvURL,
 		id,
 		tvSeasonURL,
@@ -355,7 +635,7 @@ func (c *Client) GetTVEpisodeVideos(
 		options,
 	)
 	tvEpisodeVideos := VideoResults{}
-	if err := c.get(tmdbURL, &tvEpisodeVideos); err != nil {
+	if err := c.get(ctx, "GetTVEpisodeVideos", tmdbURL, &tvEpisodeVideos); err != nil {
 		return nil, err
 	}
 	return &tvEpisodeVideos, nil
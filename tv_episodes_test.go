@@ -1,5 +1,15 @@
 package tmdb
 
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+
+	json "github.com/goccy/go-json"
+)
+
 func (suite *TMBDTestSuite) TestGetTVEpisodeDetails() {
 	got, err := suite.client.GetTVEpisodeDetails(gotID, 1, 1, nil)
 	suite.Nil(err)
@@ -106,3 +116,97 @@ func (suite *TMBDTestSuite) TestGetTVEpisodeVideosWithOptions() {
 	suite.Nil(err)
 	suite.Equal(int64(63057), got.ID)
 }
+
+// newRatingServer serves the episode rating and the episode and
+// season account states endpoints, keeping the ratings of every
+// session and guest session in memory.
+func (suite *TMBDTestSuite) newRatingServer() *httptest.Server {
+	var mu sync.Mutex
+	ratings := map[string]float32{}
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+		query := r.URL.Query()
+		session := query.Get("session_id") + query.Get("guest_session_id")
+		if session == "" {
+			w.WriteHeader(http.StatusUnauthorized)
+			w.Write([]byte(`{"status_code":3,"status_message":"Authentication failed: You do not have permissions to access the service."}`))
+			return
+		}
+		path := strings.TrimSuffix(strings.TrimSuffix(r.URL.Path, "/rating"), "/account_states")
+		rated := func(key string) string {
+			if value, ok := ratings[session+key]; ok {
+				return fmt.Sprintf(`{"value":%g}`, value)
+			}
+			return "false"
+		}
+		switch {
+		case r.Method == http.MethodPost:
+			body := struct {
+				Value float32 `json:"value"`
+			}{}
+			json.NewDecoder(r.Body).Decode(&body)
+			ratings[session+path] = body.Value
+			w.WriteHeader(http.StatusCreated)
+			w.Write([]byte(`{"status_code":1,"status_message":"Success."}`))
+		case r.Method == http.MethodDelete:
+			delete(ratings, session+path)
+			w.Write([]byte(`{"status_code":13,"status_message":"The item/record was deleted successfully."}`))
+		case strings.Contains(path, "/episode/"):
+			w.Write([]byte(`{"id":63056,"rated":` + rated(path) + `}`))
+		default:
+			w.Write([]byte(`{"id":3624,"results":[` +
+				`{"id":63056,"episode_number":1,"rated":` + rated(path+"/episode/1") + `},` +
+				`{"id":63057,"episode_number":2,"rated":` + rated(path+"/episode/2") + `}]}`))
+		}
+	}))
+}
+
+func (suite *TMBDTestSuite) TestTVEpisodeRating() {
+	ts := suite.newRatingServer()
+	defer ts.Close()
+	c, _ := Init(apiKey)
+	c.SetCustomBaseURL(ts.URL)
+	c.SetSessionID(sessionID)
+	options := map[string]string{"session_id": sessionID}
+	states, err := c.GetTVEpisodeAccountStates(gotID, 1, 1, options)
+	suite.Nil(err)
+	suite.False(states.Rated.IsRated())
+	rate, err := c.PostTVEpisodeRating(gotID, 1, 1, 8.5, nil)
+	suite.Nil(err)
+	suite.Equal(1, rate.StatusCode)
+	states, err = c.GetTVEpisodeAccountStates(gotID, 1, 1, options)
+	suite.Nil(err)
+	suite.True(states.Rated.IsRated())
+	suite.Equal(float32(8.5), states.Rated.Value())
+	rate, err = c.DeleteTVEpisodeRating(gotID, 1, 1, nil)
+	suite.Nil(err)
+	suite.Equal(13, rate.StatusCode)
+	states, err = c.GetTVEpisodeAccountStates(gotID, 1, 1, options)
+	suite.Nil(err)
+	suite.False(states.Rated.IsRated())
+}
+
+func (suite *TMBDTestSuite) TestTVEpisodeRatingGuestSession() {
+	ts := suite.newRatingServer()
+	defer ts.Close()
+	c, _ := Init(apiKey)
+	c.SetCustomBaseURL(ts.URL)
+	options := map[string]string{"guest_session_id": "guest"}
+	_, err := c.PostTVEpisodeRating(gotID, 1, 2, 6, options)
+	suite.Nil(err)
+	states, err := c.GetTVEpisodeAccountStates(gotID, 1, 2, options)
+	suite.Nil(err)
+	suite.Equal(float32(6), states.Rated.Value())
+	_, err = c.DeleteTVEpisodeRating(gotID, 1, 2, options)
+	suite.Nil(err)
+}
+
+func (suite *TMBDTestSuite) TestTVEpisodeRatingFail() {
+	ts := suite.newRatingServer()
+	defer ts.Close()
+	c, _ := Init(apiKey)
+	c.SetCustomBaseURL(ts.URL)
+	_, err := c.PostTVEpisodeRating(gotID, 1, 1, 8.5, nil)
+	suite.Contains(err.Error(), "code: 3")
+}
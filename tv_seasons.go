@@ -1,6 +1,9 @@
 package tmdb
 
-import "fmt"
+import (
+	"context"
+	"fmt"
+)
 
 // TVSeasonDetails is a struct for details JSON response.
 type TVSeasonDetails struct {
@@ -96,11 +99,27 @@ func (c *Client) GetTVSeasonDetails(
 	id int,
 	seasonNumber int,
 	urlOptions map[string]string,
+) (*TVSeasonDetails, error) {
+	return c.GetTVSeasonDetailsWithContext(
+		context.Background(),
+		id,
+		seasonNumber,
+		urlOptions,
+	)
+}
+
+// GetTVSeasonDetailsWithContext is like GetTVSeasonDetails but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetTVSeasonDetailsWithContext(
+	ctx context.Context,
+	id int,
+	seasonNumber int,
+	urlOptions map[string]string,
 ) (*TVSeasonDetails, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%s%d%s%d?api_key=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		tvURL,
 		id,
 		tvSeasonURL,
@@ -109,28 +128,74 @@ func (c *Client) GetTVSeasonDetails(
 		options,
 	)
 	tvSeasonDetails := TVSeasonDetails{}
-	if err := c.get(tmdbURL, &tvSeasonDetails); err != nil {
+	if err := c.get(ctx, "GetTVSeasonDetails", tmdbURL, &tvSeasonDetails); err != nil {
 		return nil, err
 	}
 	return &tvSeasonDetails, nil
 }
 
+// TVSeasonAccountStates type is a struct for account states JSON response.
+type TVSeasonAccountStates struct {
+	ID      int64 `json:"id"`
+	Results []struct {
+		ID            int64      `json:"id"`
+		EpisodeNumber int        `json:"episode_number"`
+		Rated         RatedValue `json:"rated"`
+	} `json:"results"`
+}
+
+// GetTVSeasonAccountStates get your ratings for the episodes of a season.
+//
+// A valid session or guest session ID is required.
+//
+// https://developers.themoviedb.org/3/tv-seasons/get-tv-season-account-states
+func (c *Client) GetTVSeasonAccountStates(
+	id int,
+	seasonNumber int,
+	urlOptions map[string]string,
+) (*TVSeasonAccountStates, error) {
+	return c.GetTVSeasonAccountStatesWithContext(
+		context.Background(),
+		id,
+		seasonNumber,
+		urlOptions,
+	)
+}
+
+// GetTVSeasonAccountStatesWithContext is like GetTVSeasonAccountStates
+// but uses the given context for cancellation and deadlines.
+func (c *Client) GetTVSeasonAccountStatesWithContext(
+	ctx context.Context,
+	id int,
+	seasonNumber int,
+	urlOptions map[string]string,
+) (*TVSeasonAccountStates, error) {
+	options := c.fmtOptions(urlOptions)
+	tmdbURL := fmt.Sprintf(
+		"%s%s%d%s%d/account_states?api_key=%s%s",
+		c.GetBaseURL(),
+		tvURL,
+		id,
+		tvSeasonURL,
+		seasonNumber,
+		c.apiKey,
+		options,
+	)
+	tvSeasonAccountStates := TVSeasonAccountStates{}
+	if err := c.get(
+		ctx,
+		"GetTVSeasonAccountStates",
+		tmdbURL,
+		&tvSeasonAccountStates,
+	); err != nil {
+		return nil, err
+	}
+	return &tvSeasonAccountStates, nil
+}
+
 // TVSeasonChanges is a struct for changes JSON response.
 type TVSeasonChanges struct {
-	Changes []struct {
-		Items []struct {
-			ID        string `json:"id"`
-			Action    string `json:"action"`
-			Time      string `json:"time"`
-			Iso639_1  string `json:"iso_639_1"`
-			Iso3166_1 string `json:"iso_3166_1"`
-			Value     struct {
-				EpisodeID     int64 `json:"episode_id"`
-				EpisodeNumber int   `json:"episode_number"`
-			} `json:"value"`
-		} `json:"items"`
-		Key string `json:"key"`
-	} `json:"changes"`
+	Changes []Change `json:"changes"`
 }
 
 // GetTVSeasonChanges get the changes for a TV season.
@@ -143,18 +208,28 @@ type TVSeasonChanges struct {
 func (c *Client) GetTVSeasonChanges(
 	id int,
 	urlOptions map[string]string,
+) (*TVSeasonChanges, error) {
+	return c.GetTVSeasonChangesWithContext(context.Background(), id, urlOptions)
+}
+
+// GetTVSeasonChangesWithContext is like GetTVSeasonChanges but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetTVSeasonChangesWithContext(
+	ctx context.Context,
+	id int,
+	urlOptions map[string]string,
 ) (*TVSeasonChanges, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%sseason/%d/changes?api_key=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		tvURL,
 		id,
 		c.apiKey,
 		options,
 	)
 	tvSeasonChanges := TVSeasonChanges{}
-	if err := c.get(tmdbURL, &tvSeasonChanges); err != nil {
+	if err := c.get(ctx, "GetTVSeasonChanges", tmdbURL, &tvSeasonChanges); err != nil {
 		return nil, err
 	}
 	return &tvSeasonChanges, nil
@@ -190,11 +265,27 @@ func (c *Client) GetTVSeasonCredits(
 	id int,
 	seasonNumber int,
 	urlOptions map[string]string,
+) (*TVSeasonCredits, error) {
+	return c.GetTVSeasonCreditsWithContext(
+		context.Background(),
+		id,
+		seasonNumber,
+		urlOptions,
+	)
+}
+
+// GetTVSeasonCreditsWithContext is like GetTVSeasonCredits but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetTVSeasonCreditsWithContext(
+	ctx context.Context,
+	id int,
+	seasonNumber int,
+	urlOptions map[string]string,
 ) (*TVSeasonCredits, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%s%d%s%d/credits?api_key=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		tvURL,
 		id,
 		tvSeasonURL,
@@ -203,7 +294,7 @@ func (c *Client) GetTVSeasonCredits(
 		options,
 	)
 	tVSeasonCredits := TVSeasonCredits{}
-	if err := c.get(tmdbURL, &tVSeasonCredits); err != nil {
+	if err := c.get(ctx, "GetTVSeasonCredits", tmdbURL, &tVSeasonCredits); err != nil {
 		return nil, err
 	}
 	return &tVSeasonCredits, nil
@@ -230,11 +321,27 @@ func (c *Client) GetTVSeasonExternalIDs(
 	id int,
 	seasonNumber int,
 	urlOptions map[string]string,
+) (*TVSeasonExternalIDs, error) {
+	return c.GetTVSeasonExternalIDsWithContext(
+		context.Background(),
+		id,
+		seasonNumber,
+		urlOptions,
+	)
+}
+
+// GetTVSeasonExternalIDsWithContext is like GetTVSeasonExternalIDs but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetTVSeasonExternalIDsWithContext(
+	ctx context.Context,
+	id int,
+	seasonNumber int,
+	urlOptions map[string]string,
 ) (*TVSeasonExternalIDs, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%s%d%s%d/external_ids?api_key=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		tvURL,
 		id,
 		tvSeasonURL,
@@ -243,7 +350,7 @@ func (c *Client) GetTVSeasonExternalIDs(
 		options,
 	)
 	tvSeasonExternalIDs := TVSeasonExternalIDs{}
-	if err := c.get(tmdbURL, &tvSeasonExternalIDs); err != nil {
+	if err := c.get(ctx, "GetTVSeasonExternalIDs", tmdbURL, &tvSeasonExternalIDs); err != nil {
 		return nil, err
 	}
 	return &tvSeasonExternalIDs, nil
@@ -273,11 +380,27 @@ func (c *Client) GetTVSeasonImages(
 	id int,
 	seasonNumber int,
 	urlOptions map[string]string,
+) (*TVSeasonImages, error) {
+	return c.GetTVSeasonImagesWithContext(
+		context.Background(),
+		id,
+		seasonNumber,
+		urlOptions,
+	)
+}
+
+// GetTVSeasonImagesWithContext is like GetTVSeasonImages but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetTVSeasonImagesWithContext(
+	ctx context.Context,
+	id int,
+	seasonNumber int,
+	urlOptions map[string]string,
 ) (*TVSeasonImages, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%s%d%s%d/images?api_key=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		tvURL,
 		id,
 		tvSeasonURL,
@@ -286,7 +409,7 @@ func (c *Client) GetTVSeasonImages(
 		options,
 	)
 	tvSeasonImages := TVSeasonImages{}
-	if err := c.get(tmdbURL, &tvSeasonImages); err != nil {
+	if err := c.get(ctx, "GetTVSeasonImages", tmdbURL, &tvSeasonImages); err != nil {
 		return nil, err
 	}
 	return &tvSeasonImages, nil
@@ -299,11 +422,27 @@ func (c *Client) GetTVSeasonVideos(
 	id int,
 	seasonNumber int,
 	urlOptions map[string]string,
+) (*VideoResults, error) {
+	return c.GetTVSeasonVideosWithContext(
+		context.Background(),
+		id,
+		seasonNumber,
+		urlOptions,
+	)
+}
+
+// GetTVSeasonVideosWithContext is like GetTVSeasonVideos but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetTVSeasonVideosWithContext(
+	ctx context.Context,
+	id int,
+	seasonNumber int,
+	urlOptions map[string]string,
 ) (*VideoResults, error) {
 	options := c.fmtOptions(urlOptions)
 	tmdbURL := fmt.Sprintf(
 		"%s%s%d%s%d/videos?api_key=%s%s",
-		baseURL,
+		c.GetBaseURL(),
 		tvURL,
 		id,
 		tvSeasonURL,
@@ -312,7 +451,7 @@ func (c *Client) GetTVSeasonVideos(
 		options,
 	)
 	tvSeasonVideos := VideoResults{}
-	if err := c.get(tmdbURL, &tvSeasonVideos); err != nil {
+	if err := c.get(ctx, "GetTVSeasonVideos", tmdbURL, &tvSeasonVideos); err != nil {
 		return nil, err
 	}
 	return &tvSeasonVideos, nil
@@ -324,10 +463,24 @@ func (c *Client) GetTVSeasonVideos(
 func (c *Client) GetTVSeasonTranslations(
 	id int,
 	seasonNumber int,
+) (*TVSeasonTranslations, error) {
+	return c.GetTVSeasonTranslationsWithContext(
+		context.Background(),
+		id,
+		seasonNumber,
+	)
+}
+
+// GetTVSeasonTranslationsWithContext is like GetTVSeasonTranslations but uses the
+// given context for cancellation and deadlines.
+func (c *Client) GetTVSeasonTranslationsWithContext(
+	ctx context.Context,
+	id int,
+	seasonNumber int,
 ) (*TVSeasonTranslations, error) {
 	tmdbURL := fmt.Sprintf(
 		"%s%s%d%s%d/translations?api_key=%s",
-		baseURL,
+		c.GetBaseURL(),
 		tvURL,
 		id,
 		tvSeasonURL,
@@ -335,7 +488,7 @@ func (c *Client) GetTVSeasonTranslations(
 		c.apiKey,
 	)
 	tvSeasonTranslations := TVSeasonTranslations{}
-	if err := c.get(tmdbURL, &tvSeasonTranslations); err != nil {
+	if err := c.get(ctx, "GetTVSeasonTranslations", tmdbURL, &tvSeasonTranslations); err != nil {
 		return nil, err
 	}
 	return &tvSeasonTranslations, nil
@@ -125,3 +125,19 @@ func (suite *TMBDTestSuite) TestGetTVSeasonTranslationsFail() {
 	_, err := suite.client.GetTVSeasonTranslations(0, 1)
 	suite.Equal("code: 6 | success: false | message: Invalid id: The pre-requisite id is invalid or not found.", err.Error())
 }
+
+func (suite *TMBDTestSuite) TestGetTVSeasonAccountStates() {
+	ts := suite.newRatingServer()
+	defer ts.Close()
+	c, _ := Init(apiKey)
+	c.SetCustomBaseURL(ts.URL)
+	options := map[string]string{"guest_session_id": "guest"}
+	_, err := c.PostTVEpisodeRating(gotID, 1, 2, 9, options)
+	suite.Nil(err)
+	states, err := c.GetTVSeasonAccountStates(gotID, 1, options)
+	suite.Nil(err)
+	suite.Len(states.Results, 2)
+	suite.False(states.Results[0].Rated.IsRated())
+	suite.True(states.Results[1].Rated.IsRated())
+	suite.Equal(float32(9), states.Results[1].Rated.Value())
+}
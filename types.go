@@ -1,5 +1,12 @@
 package tmdb
 
+import (
+	"bytes"
+	"fmt"
+
+	json "github.com/goccy/go-json"
+)
+
 // BelongsToCollection represents information about a collection to which a movie belongs,
 // including its unique identifier, name, poster image path, and backdrop image path.
 type BelongsToCollection struct {
@@ -156,3 +163,58 @@ type AlternativeTitle struct {
 	Title     string `json:"title"`
 	Type      string `json:"type"`
 }
+
+// RatedValue represents the rating of an account state, which TMDb returns
+// either as false when the item is not rated or as an object like {"value": 7.5}.
+// The rated lists return the rating as a plain number, which is decoded as well.
+type RatedValue struct {
+	rated bool
+	value float32
+}
+
+// NewRatedValue returns a RatedValue rated with value.
+func NewRatedValue(value float32) RatedValue {
+	return RatedValue{rated: true, value: value}
+}
+
+// IsRated reports whether the item is rated.
+func (r RatedValue) IsRated() bool {
+	return r.rated
+}
+
+// Value returns the rating, or zero when the item is not rated.
+func (r RatedValue) Value() float32 {
+	return r.value
+}
+
+// UnmarshalJSON decodes either false, null, 7.5 or {"value": 7.5}.
+func (r *RatedValue) UnmarshalJSON(data []byte) error {
+	data = bytes.TrimSpace(data)
+	if bytes.Equal(data, []byte("false")) || bytes.Equal(data, []byte("null")) {
+		*r = RatedValue{}
+		return nil
+	}
+	var value float32
+	if err := json.Unmarshal(data, &value); err == nil {
+		*r = NewRatedValue(value)
+		return nil
+	}
+	var rated struct {
+		Value float32 `json:"value"`
+	}
+	if err := json.Unmarshal(data, &rated); err != nil {
+		return fmt.Errorf("could not decode rated value: %s", data)
+	}
+	*r = NewRatedValue(rated.Value)
+	return nil
+}
+
+// MarshalJSON encodes the rating the same way TMDb does.
+func (r RatedValue) MarshalJSON() ([]byte, error) {
+	if !r.rated {
+		return []byte("false"), nil
+	}
+	return json.Marshal(struct {
+		Value float32 `json:"value"`
+	}{r.value})
+}
@@ -0,0 +1,44 @@
+package tmdb
+
+import json "github.com/goccy/go-json"
+
+func (suite *TMBDTestSuite) TestRatedValue() {
+	var states struct {
+		Rated RatedValue `json:"rated"`
+	}
+	suite.Nil(json.Unmarshal([]byte(`{"rated":{"value":7.5}}`), &states))
+	suite.True(states.Rated.IsRated())
+	suite.Equal(float32(7.5), states.Rated.Value())
+	suite.Nil(json.Unmarshal([]byte(`{"rated":false}`), &states))
+	suite.False(states.Rated.IsRated())
+	suite.Equal(float32(0), states.Rated.Value())
+	suite.Nil(json.Unmarshal([]byte(`{"rated":null}`), &states))
+	suite.False(states.Rated.IsRated())
+	suite.Nil(json.Unmarshal([]byte(`{"rated":8}`), &states))
+	suite.Equal(float32(8), states.Rated.Value())
+	suite.NotNil(json.Unmarshal([]byte(`{"rated":"yes"}`), &states))
+}
+
+func (suite *TMBDTestSuite) TestRatedValueMarshal() {
+	data, err := json.Marshal(NewRatedValue(7.5))
+	suite.Nil(err)
+	suite.Equal(`{"value":7.5}`, string(data))
+	data, err = json.Marshal(RatedValue{})
+	suite.Nil(err)
+	suite.Equal(`false`, string(data))
+}
+
+func (suite *TMBDTestSuite) TestRatedValueAccountStates() {
+	movie := MovieAccountStates{}
+	suite.Nil(json.Unmarshal([]byte(`{"id":1,"favorite":true,"rated":{"value":9},"watchlist":false}`), &movie))
+	suite.Equal(float32(9), movie.Rated.Value())
+	tv := TVAccountStates{}
+	suite.Nil(json.Unmarshal([]byte(`{"id":1,"favorite":true,"rated":false,"watchlist":false}`), &tv))
+	suite.False(tv.Rated.IsRated())
+	guest := GuestSessionRatedMovies{}
+	suite.Nil(json.Unmarshal([]byte(`{"page":1,"results":[{"id":1,"rating":6.5}]}`), &guest))
+	suite.Equal(float32(6.5), guest.Results[0].Rating.Value())
+	favorites := AccountFavoriteMovies{}
+	suite.Nil(json.Unmarshal([]byte(`{"page":1,"results":[{"id":1}]}`), &favorites))
+	suite.False(favorites.Results[0].Rating.IsRated())
+}